@@ -0,0 +1,605 @@
+package agents
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+	"golang.org/x/net/websocket"
+)
+
+// Chrome gets this long to start and print the address of its DevTools
+// endpoint.
+const chromeStartTimeout = 30 * time.Second
+
+// cdpEventTimeout is how long an event waits for a tab with a full event
+// buffer to read events, before it is dropped. Events are read for all tabs
+// from the same connection, so waiting holds up the events of the others.
+const cdpEventTimeout = 2 * time.Second
+
+// Screenshots of long pages are sent base64 encoded in a single message,
+// which can be larger than the default limit of the websocket package.
+const maxCDPMessageSize = 256 << 20
+
+var devToolsListening = regexp.MustCompile(`DevTools listening on (ws://\S+)`)
+
+var errChromeClosed = errors.New("Chrome instance closed")
+
+// chromeBrowser is a Chrome instance controlled over the DevTools protocol.
+// Every screenshot is taken in a tab of its own browser context, so an
+// instance can be reused without pages sharing cookies, storage or cache.
+type chromeBrowser struct {
+	cmd     *exec.Cmd
+	conn    *websocket.Conn
+	userDir string
+	exited  chan struct{}
+	stderr  string
+	out     *core.Logger
+
+	// Size of the windows pages are opened in when Chrome doesn't run
+	// headless, where new windows don't take the size of --window-size
+	windowWidth  int
+	windowHeight int
+
+	// Set with --stealth
+	stealth *chromeStealth
+
+	sync.Mutex
+	nextID   int64
+	pending  map[int64]chan *cdpMessage
+	sessions map[string]chan *cdpMessage
+	stalled  map[string]bool
+	closed   bool
+	closing  bool
+	crash    bool
+}
+
+// cdpMessage is a command, response or event of the DevTools protocol.
+// Commands to a tab are sent with the session ID it was attached with.
+type cdpMessage struct {
+	ID        int64           `json:"id,omitempty"`
+	SessionID string          `json:"sessionId,omitempty"`
+	Method    string          `json:"method,omitempty"`
+	Params    interface{}     `json:"params,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	Error     *cdpError       `json:"error,omitempty"`
+}
+
+type cdpError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// launchChrome starts Chrome with the given arguments and connects to its
+// DevTools endpoint. The user data directory must not be shared with other
+// instances. Variables in env are added to the environment of Chrome.
+func launchChrome(runner core.CommandRunner, out *core.Logger, path string, args []string, env []string, userDir string) (*chromeBrowser, error) {
+	args = append(args,
+		"--user-data-dir="+userDir,
+		"--remote-debugging-port=0",
+		"--remote-allow-origins=http://127.0.0.1",
+		"about:blank",
+	)
+	b := &chromeBrowser{
+		cmd:      runner.CommandContext(context.Background(), path, args...),
+		userDir:  userDir,
+		exited:   make(chan struct{}),
+		out:      out,
+		pending:  make(map[int64]chan *cdpMessage),
+		sessions: make(map[string]chan *cdpMessage),
+		stalled:  make(map[string]bool),
+	}
+	if len(env) > 0 {
+		b.cmd.Env = append(os.Environ(), env...)
+	}
+	setProcAttr(b.cmd)
+	stderr, err := b.cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := b.cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	endpoint := make(chan string, 1)
+	go func() {
+		// Chrome keeps writing to stderr, which is drained so it
+		// doesn't block
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if match := devToolsListening.FindStringSubmatch(line); match != nil {
+				endpoint <- match[1]
+			} else if line != "" {
+				b.Lock()
+				b.stderr = line
+				b.Unlock()
+			}
+		}
+		b.cmd.Wait()
+		b.Lock()
+		b.crash = !b.closing
+		b.Unlock()
+		close(b.exited)
+		b.shutdown()
+	}()
+
+	select {
+	case u := <-endpoint:
+		conn, err := websocket.Dial(u, "", "http://127.0.0.1")
+		if err != nil {
+			b.close()
+			return nil, fmt.Errorf("unable to connect to Chrome DevTools at %s: %v", u, err)
+		}
+		conn.MaxPayloadBytes = maxCDPMessageSize
+		b.Lock()
+		b.conn = conn
+		b.Unlock()
+		go b.receive()
+		return b, nil
+	case <-b.exited:
+		return nil, fmt.Errorf("Chrome exited on start: %s", b.lastError())
+	case <-time.After(chromeStartTimeout):
+		b.close()
+		return nil, fmt.Errorf("Chrome did not start within %s", chromeStartTimeout)
+	}
+}
+
+// receive reads messages from Chrome until the connection is closed, and
+// hands responses to the commands waiting for them and events to the tabs
+// they belong to.
+func (b *chromeBrowser) receive() {
+	for {
+		var msg cdpMessage
+		if err := websocket.JSON.Receive(b.conn, &msg); err != nil {
+			b.shutdown()
+			return
+		}
+
+		b.Lock()
+		var events chan *cdpMessage
+		if msg.ID != 0 {
+			if ch, ok := b.pending[msg.ID]; ok {
+				delete(b.pending, msg.ID)
+				ch <- &msg
+			}
+		} else {
+			events = b.sessions[msg.SessionID]
+		}
+		b.Unlock()
+		if events != nil {
+			b.deliver(events, &msg)
+		}
+	}
+}
+
+// deliver hands an event to the tab it belongs to. If the event buffer of
+// the tab is full, it waits up to cdpEventTimeout for the tab to read events
+// and drops the event after that. Once a tab has stalled like this, events
+// for it no longer wait until it reads events again.
+func (b *chromeBrowser) deliver(events chan *cdpMessage, msg *cdpMessage) {
+	select {
+	case events <- msg:
+		b.setStalled(msg.SessionID, false)
+		return
+	default:
+	}
+
+	b.Lock()
+	stalled := b.stalled[msg.SessionID]
+	b.Unlock()
+	if !stalled {
+		timer := time.NewTimer(cdpEventTimeout)
+		defer timer.Stop()
+		select {
+		case events <- msg:
+			return
+		case <-timer.C:
+		}
+	}
+	b.setStalled(msg.SessionID, true)
+	b.out.Debug("Dropped DevTools event %s for tab %s, which did not read its events within %s\n", msg.Method, msg.SessionID, cdpEventTimeout)
+}
+
+func (b *chromeBrowser) setStalled(sessionID string, stalled bool) {
+	b.Lock()
+	defer b.Unlock()
+	if stalled {
+		b.stalled[sessionID] = true
+	} else {
+		delete(b.stalled, sessionID)
+	}
+}
+
+// call sends a command, to a tab if sessionID is set, and decodes the
+// result into result unless it is nil.
+func (b *chromeBrowser) call(ctx context.Context, sessionID string, method string, params interface{}, result interface{}) error {
+	b.Lock()
+	if b.closed || b.conn == nil {
+		b.Unlock()
+		return errChromeClosed
+	}
+	b.nextID++
+	id := b.nextID
+	ch := make(chan *cdpMessage, 1)
+	b.pending[id] = ch
+	conn := b.conn
+	b.Unlock()
+
+	if params == nil {
+		params = struct{}{}
+	}
+	if err := websocket.JSON.Send(conn, cdpMessage{ID: id, SessionID: sessionID, Method: method, Params: params}); err != nil {
+		b.forget(id)
+		return err
+	}
+
+	select {
+	case msg, ok := <-ch:
+		if !ok {
+			return errChromeClosed
+		}
+		if msg.Error != nil {
+			return fmt.Errorf("%s: %s", method, msg.Error.Message)
+		}
+		if result != nil {
+			return json.Unmarshal(msg.Result, result)
+		}
+		return nil
+	case <-ctx.Done():
+		b.forget(id)
+		return ctx.Err()
+	}
+}
+
+func (b *chromeBrowser) forget(id int64) {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.pending, id)
+}
+
+// events returns a channel receiving the events of a tab, until
+// stopEvents is called.
+func (b *chromeBrowser) events(sessionID string) chan *cdpMessage {
+	b.Lock()
+	defer b.Unlock()
+	ch := make(chan *cdpMessage, 1000)
+	b.sessions[sessionID] = ch
+	return ch
+}
+
+func (b *chromeBrowser) stopEvents(sessionID string) {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.sessions, sessionID)
+	delete(b.stalled, sessionID)
+}
+
+// chromeCapture is the result of rendering a page: the screenshot, the URL
+// and title of the page when it was taken and what the page reported on the
+// console while it rendered.
+type chromeCapture struct {
+	data    []byte
+	url     string
+	title   string
+	console *pageConsole
+}
+
+// chromeRequest is a request for a page other than the GET request Chrome
+// navigates with, set with --probe-method and --probe-body.
+type chromeRequest struct {
+	method      string
+	body        string
+	contentType string
+}
+
+// screenshot opens url in a new tab of a new browser context, waits for the
+// page to load and takes a screenshot in the given format (png or jpeg). The
+// page is requested with request instead of GET, unless it is nil.
+func (b *chromeBrowser) screenshot(ctx context.Context, url string, userAgent string, format string, request *chromeRequest) (*chromeCapture, error) {
+	var browserContext struct {
+		BrowserContextID string `json:"browserContextId"`
+	}
+	if err := b.call(ctx, "", "Target.createBrowserContext", nil, &browserContext); err != nil {
+		return nil, err
+	}
+	defer func() {
+		// A context that can't be disposed of leaves its tab behind, so
+		// the instance isn't reused
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := b.call(ctx, "", "Target.disposeBrowserContext", map[string]interface{}{"browserContextId": browserContext.BrowserContextID}, nil); err != nil {
+			b.close()
+		}
+	}()
+
+	var target struct {
+		TargetID string `json:"targetId"`
+	}
+	targetParams := map[string]interface{}{"url": "about:blank", "browserContextId": browserContext.BrowserContextID}
+	if b.windowWidth > 0 && b.windowHeight > 0 {
+		targetParams["newWindow"] = true
+		targetParams["width"] = b.windowWidth
+		targetParams["height"] = b.windowHeight
+	}
+	if err := b.call(ctx, "", "Target.createTarget", targetParams, &target); err != nil {
+		return nil, err
+	}
+	var attached struct {
+		SessionID string `json:"sessionId"`
+	}
+	if err := b.call(ctx, "", "Target.attachToTarget", map[string]interface{}{"targetId": target.TargetID, "flatten": true}, &attached); err != nil {
+		return nil, err
+	}
+	session := attached.SessionID
+	events := b.events(session)
+	defer b.stopEvents(session)
+
+	// Pages can detect Runtime.enable by the way it inspects console
+	// messages, so it is left out with --stealth at the cost of console
+	// errors and exceptions
+	domains := []string{"Page.enable", "Log.enable", "Network.enable"}
+	if b.stealth == nil {
+		domains = append(domains, "Runtime.enable")
+	}
+	for _, domain := range domains {
+		if err := b.call(ctx, session, domain, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+	capture := &chromeCapture{console: newPageConsole()}
+	userAgentOverride := map[string]interface{}{"userAgent": userAgent}
+	if b.stealth != nil {
+		if err := b.call(ctx, session, "Page.addScriptToEvaluateOnNewDocument", map[string]interface{}{"source": stealthScript}, nil); err != nil {
+			return nil, err
+		}
+		if b.stealth.userAgentOverride != nil {
+			userAgentOverride = b.stealth.userAgentOverride
+		}
+	}
+	if err := b.call(ctx, session, "Emulation.setUserAgentOverride", userAgentOverride, nil); err != nil {
+		return nil, err
+	}
+	if request != nil {
+		// Chrome navigates with GET, so requests for documents are paused
+		// and the one for the page is continued with the method and body
+		// of request
+		patterns := []map[string]string{{"urlPattern": "*", "resourceType": "Document", "requestStage": "Request"}}
+		if err := b.call(ctx, session, "Fetch.enable", map[string]interface{}{"patterns": patterns}, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// Events are handled while Chrome navigates, as navigating waits for
+	// paused requests to be continued
+	loaded, continued := false, false
+	handle := func(msg *cdpMessage) {
+		switch msg.Method {
+		case "Page.loadEventFired":
+			loaded = true
+		case "Fetch.requestPaused":
+			if continued {
+				b.continueRequest(ctx, session, msg, nil)
+			} else {
+				b.continueRequest(ctx, session, msg, request)
+				continued = true
+			}
+		default:
+			capture.console.record(msg)
+		}
+	}
+	var navigation struct {
+		ErrorText string `json:"errorText"`
+	}
+	navigated := make(chan error, 1)
+	go func() {
+		navigated <- b.call(ctx, session, "Page.navigate", map[string]interface{}{"url": url}, &navigation)
+	}()
+	if err := handleEvents(ctx, events, navigated, handle); err != nil {
+		return nil, err
+	}
+	if navigation.ErrorText != "" {
+		return nil, errors.New(navigation.ErrorText)
+	}
+	if !loaded {
+		if err := waitForEvent(ctx, events, "Page.loadEventFired", handle); err != nil {
+			return nil, err
+		}
+	}
+
+	// The page may have navigated elsewhere by now, which the navigation
+	// history tells without running a script in the page
+	var history struct {
+		CurrentIndex int `json:"currentIndex"`
+		Entries      []struct {
+			URL   string `json:"url"`
+			Title string `json:"title"`
+		} `json:"entries"`
+	}
+	if err := b.call(ctx, session, "Page.getNavigationHistory", nil, &history); err != nil {
+		return nil, err
+	}
+	if history.CurrentIndex >= 0 && history.CurrentIndex < len(history.Entries) {
+		capture.url = history.Entries[history.CurrentIndex].URL
+		capture.title = history.Entries[history.CurrentIndex].Title
+	}
+
+	var screenshot struct {
+		Data string `json:"data"`
+	}
+	if err := b.call(ctx, session, "Page.captureScreenshot", map[string]interface{}{"format": format}, &screenshot); err != nil {
+		return nil, err
+	}
+	data, err := base64.StdEncoding.DecodeString(screenshot.Data)
+	if err != nil {
+		return nil, err
+	}
+	capture.data = data
+
+	// Events received while the screenshot was taken
+	for {
+		select {
+		case msg := <-events:
+			capture.console.record(msg)
+		default:
+			return capture, nil
+		}
+	}
+}
+
+// continueRequest continues a request paused after Fetch.enable, with the
+// method and body of request unless it is nil.
+func (b *chromeBrowser) continueRequest(ctx context.Context, session string, msg *cdpMessage, request *chromeRequest) {
+	var event struct {
+		RequestID string `json:"requestId"`
+		Request   struct {
+			Headers map[string]string `json:"headers"`
+		} `json:"request"`
+	}
+	if decodeParams(msg, &event) != nil {
+		return
+	}
+
+	params := map[string]interface{}{"requestId": event.RequestID}
+	if request != nil {
+		var headers []map[string]string
+		for name, value := range event.Request.Headers {
+			if !strings.EqualFold(name, "Content-Type") {
+				headers = append(headers, map[string]string{"name": name, "value": value})
+			}
+		}
+		if request.body != "" {
+			headers = append(headers, map[string]string{"name": "Content-Type", "value": request.contentType})
+			params["postData"] = base64.StdEncoding.EncodeToString([]byte(request.body))
+		}
+		params["method"] = request.method
+		params["headers"] = headers
+	}
+	// A request that can't be continued fails the page by timing out
+	b.call(ctx, session, "Fetch.continueRequest", params, nil)
+}
+
+// handleEvents hands the events of a tab to handle until a command sent in
+// the meantime is done.
+func handleEvents(ctx context.Context, events chan *cdpMessage, done chan error, handle func(*cdpMessage)) error {
+	for {
+		select {
+		case msg := <-events:
+			handle(msg)
+		case err := <-done:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// waitForEvent waits for an event of a tab with the given method, and hands
+// the events before it to handle.
+func waitForEvent(ctx context.Context, events chan *cdpMessage, method string, handle func(*cdpMessage)) error {
+	for {
+		select {
+		case msg := <-events:
+			if msg.Method == method {
+				return nil
+			}
+			handle(msg)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ping checks that the instance still responds to commands.
+func (b *chromeBrowser) ping(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return b.call(ctx, "", "Browser.getVersion", nil, nil)
+}
+
+// crashed reports whether the instance exited without being closed. It
+// blocks until the process has exited.
+func (b *chromeBrowser) crashed() bool {
+	<-b.exited
+	b.Lock()
+	defer b.Unlock()
+	return b.crash
+}
+
+// alive reports whether the instance can take screenshots.
+func (b *chromeBrowser) alive() bool {
+	b.Lock()
+	defer b.Unlock()
+	return !b.closed
+}
+
+func (b *chromeBrowser) lastError() string {
+	b.Lock()
+	defer b.Unlock()
+	if b.stderr == "" {
+		return "no output"
+	}
+	return b.stderr
+}
+
+// shutdown marks the instance as closed and fails the commands waiting
+// for a response.
+func (b *chromeBrowser) shutdown() {
+	b.Lock()
+	defer b.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for id, ch := range b.pending {
+		close(ch)
+		delete(b.pending, id)
+	}
+	if b.conn != nil {
+		b.conn.Close()
+	}
+}
+
+// close asks Chrome to exit, kills it if it doesn't and removes its user
+// data directory.
+func (b *chromeBrowser) close() {
+	// An instance whose connection already dropped crashed before it was
+	// closed
+	b.Lock()
+	if !b.closed {
+		b.closing = true
+	}
+	// An instance that failed to start has no connection to ask it to
+	// exit with, so it is killed right away
+	connected := b.conn != nil
+	b.Unlock()
+	if connected && b.alive() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		b.call(ctx, "", "Browser.close", nil, nil)
+		cancel()
+	}
+	b.shutdown()
+	wait := 5 * time.Second
+	if !connected {
+		wait = 0
+	}
+	select {
+	case <-b.exited:
+	case <-time.After(wait):
+		if b.cmd.Process != nil {
+			b.cmd.Process.Kill()
+		}
+		<-b.exited
+	}
+	os.RemoveAll(b.userDir)
+}
@@ -0,0 +1,203 @@
+package agents
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// Messages of each kind kept per page, so a page logging an error per
+// animation frame doesn't flood its notes.
+const maxConsoleMessages = 5
+
+// pageConsole collects what a page reports while it renders for a
+// screenshot: console errors and uncaught exceptions, mixed content warnings
+// and resources that Chrome blocked.
+type pageConsole struct {
+	errors       consoleMessages
+	mixedContent consoleMessages
+	blocked      consoleMessages
+	requests     map[string]string
+}
+
+// consoleMessages are the distinct messages of a kind, up to
+// maxConsoleMessages, and the number of messages left out.
+type consoleMessages struct {
+	messages []string
+	more     int
+}
+
+func newPageConsole() *pageConsole {
+	return &pageConsole{requests: make(map[string]string)}
+}
+
+type consoleAPICalled struct {
+	Type string `json:"type"`
+	Args []struct {
+		Value       interface{} `json:"value"`
+		Description string      `json:"description"`
+	} `json:"args"`
+}
+
+type exceptionThrown struct {
+	ExceptionDetails struct {
+		Text      string `json:"text"`
+		Exception struct {
+			Description string `json:"description"`
+		} `json:"exception"`
+	} `json:"exceptionDetails"`
+}
+
+type logEntryAdded struct {
+	Entry struct {
+		Level string `json:"level"`
+		Text  string `json:"text"`
+		URL   string `json:"url"`
+	} `json:"entry"`
+}
+
+type requestWillBeSent struct {
+	RequestID string `json:"requestId"`
+	Request   struct {
+		URL string `json:"url"`
+	} `json:"request"`
+}
+
+type loadingFailed struct {
+	RequestID       string `json:"requestId"`
+	ErrorText       string `json:"errorText"`
+	BlockedReason   string `json:"blockedReason"`
+	CorsErrorStatus struct {
+		CorsError string `json:"corsError"`
+	} `json:"corsErrorStatus"`
+}
+
+// record adds an event of the Runtime, Log or Network domain to the
+// console. Other events are ignored.
+func (c *pageConsole) record(msg *cdpMessage) {
+	switch msg.Method {
+	case "Runtime.consoleAPICalled":
+		var event consoleAPICalled
+		if decodeParams(msg, &event) != nil || event.Type != "error" {
+			return
+		}
+		var args []string
+		for _, arg := range event.Args {
+			if arg.Value != nil {
+				args = append(args, fmt.Sprint(arg.Value))
+			} else if arg.Description != "" {
+				args = append(args, arg.Description)
+			}
+		}
+		c.errors.add(strings.Join(args, " "))
+	case "Runtime.exceptionThrown":
+		var event exceptionThrown
+		if decodeParams(msg, &event) != nil {
+			return
+		}
+		text := event.ExceptionDetails.Exception.Description
+		if text == "" {
+			text = event.ExceptionDetails.Text
+		}
+		c.errors.add("Uncaught " + strings.TrimPrefix(text, "Uncaught "))
+	case "Log.entryAdded":
+		var event logEntryAdded
+		if decodeParams(msg, &event) != nil {
+			return
+		}
+		entry := event.Entry
+		if strings.HasPrefix(entry.Text, "Mixed Content:") {
+			c.mixedContent.add(strings.TrimSpace(strings.TrimPrefix(entry.Text, "Mixed Content:")))
+		} else if entry.Level == "error" {
+			if entry.URL != "" && !strings.Contains(entry.Text, entry.URL) {
+				c.errors.add(fmt.Sprintf("%s (%s)", entry.Text, entry.URL))
+			} else {
+				c.errors.add(entry.Text)
+			}
+		}
+	case "Network.requestWillBeSent":
+		var event requestWillBeSent
+		if decodeParams(msg, &event) == nil {
+			c.requests[event.RequestID] = event.Request.URL
+		}
+	case "Network.loadingFailed":
+		var event loadingFailed
+		if decodeParams(msg, &event) != nil {
+			return
+		}
+		reason := event.BlockedReason
+		if reason == "" && event.CorsErrorStatus.CorsError != "" {
+			reason = "cors: " + event.CorsErrorStatus.CorsError
+		}
+		if reason == "" && strings.Contains(event.ErrorText, "BLOCKED") {
+			reason = event.ErrorText
+		}
+		// Blocked mixed content is reported on the console as well
+		if reason != "" && reason != "mixed-content" {
+			c.blocked.add(fmt.Sprintf("%s (%s)", c.requests[event.RequestID], reason))
+		}
+	}
+}
+
+// add adds a message, unless it was added before or there are enough
+// messages already.
+func (m *consoleMessages) add(text string) {
+	text = strings.TrimSpace(firstConsoleLine(text))
+	if text == "" {
+		return
+	}
+	for _, message := range m.messages {
+		if message == text {
+			return
+		}
+	}
+	if len(m.messages) >= maxConsoleMessages {
+		m.more++
+		return
+	}
+	m.messages = append(m.messages, truncate(text, 300))
+}
+
+// notes returns the notes to add to a page for what it reported.
+func (c *pageConsole) notes() []core.Note {
+	var notes []core.Note
+	for _, kind := range []struct {
+		messages consoleMessages
+		text     string
+		severity string
+	}{
+		{c.errors, "Browser console errors while rendering", core.SeverityInfo},
+		{c.mixedContent, "Mixed content loaded over HTTP on an HTTPS page", core.SeverityLow},
+		{c.blocked, "Resources blocked by the browser while rendering", core.SeverityInfo},
+	} {
+		if len(kind.messages.messages) == 0 {
+			continue
+		}
+		text := fmt.Sprintf("%s: %s", kind.text, strings.Join(kind.messages.messages, "; "))
+		if kind.messages.more > 0 {
+			text += fmt.Sprintf(" (and %d more)", kind.messages.more)
+		}
+		notes = append(notes, core.NewNote(text, kind.severity))
+	}
+	return notes
+}
+
+// firstConsoleLine returns the first line of a message, which leaves out
+// the stack trace of exceptions.
+func firstConsoleLine(text string) string {
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		return text[:i]
+	}
+	return text
+}
+
+// decodeParams decodes the parameters of an event received from Chrome.
+func decodeParams(msg *cdpMessage, v interface{}) error {
+	params, err := json.Marshal(msg.Params)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(params, v)
+}
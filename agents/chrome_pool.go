@@ -0,0 +1,232 @@
+package agents
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// Idle instances are checked this often, and have this long to respond.
+const (
+	chromeHealthInterval = 10 * time.Second
+	chromeHealthTimeout  = 5 * time.Second
+)
+
+// chromePool runs up to a fixed number of Chrome instances for screenshots,
+// independent of the number of threads. Instances are started when the
+// session starts and reused for one screenshot at a time; callers wait in
+// line for an instance when all of them are busy. Idle instances are health
+// checked, and instances that crash or stop responding are replaced.
+type chromePool struct {
+	session *core.Session
+	launch  func() (*chromeBrowser, error)
+	size    int
+	slots   chan struct{}
+	done    chan struct{}
+
+	sync.Mutex
+	idle     []*chromeBrowser
+	live     int
+	restarts int
+	closed   bool
+}
+
+func newChromePool(session *core.Session, size int, launch func() (*chromeBrowser, error)) *chromePool {
+	return &chromePool{
+		session: session,
+		launch:  launch,
+		size:    size,
+		slots:   make(chan struct{}, size),
+		done:    make(chan struct{}),
+	}
+}
+
+// warm starts all instances in the background and starts health checking
+// them.
+func (p *chromePool) warm() {
+	for i := 0; i < p.size; i++ {
+		go func() {
+			p.slots <- struct{}{}
+			b, err := p.start()
+			if err != nil {
+				<-p.slots
+				p.session.Out.Error("Unable to start Chrome for screenshots: %v\n", err)
+				return
+			}
+			p.put(b)
+		}()
+	}
+	go p.healthCheck()
+}
+
+// start launches an instance and restarts it if it crashes while the pool
+// is open.
+func (p *chromePool) start() (*chromeBrowser, error) {
+	p.Lock()
+	p.live++
+	p.Unlock()
+	b, err := p.launch()
+	if err != nil {
+		p.Lock()
+		p.live--
+		p.Unlock()
+		return nil, err
+	}
+	go func() {
+		crashed := b.crashed()
+		p.Lock()
+		p.live--
+		p.Unlock()
+		if crashed {
+			p.session.Stats.IncrementBrowserCrashes()
+			p.session.Out.Debug("[agent:url_screenshotter] Chrome instance crashed: %s\n", b.lastError())
+			p.restart()
+		}
+	}()
+	return b, nil
+}
+
+// restart starts a replacement for an instance that crashed or stopped
+// responding. Replacements that keep crashing aren't restarted over and
+// over; instances are then started when a screenshot needs one.
+func (p *chromePool) restart() {
+	p.slots <- struct{}{}
+	p.removeDead()
+
+	// A screenshot may have started a replacement already
+	p.Lock()
+	if p.closed || p.live >= p.size || p.restarts >= 3*p.size {
+		p.Unlock()
+		<-p.slots
+		return
+	}
+	p.restarts++
+	p.Unlock()
+
+	b, err := p.start()
+	if err != nil {
+		<-p.slots
+		p.session.Out.Debug("[agent:url_screenshotter] Unable to restart Chrome: %v\n", err)
+		return
+	}
+	p.session.Stats.IncrementBrowserRestarts()
+	p.put(b)
+}
+
+// get waits for a free slot and returns an idle instance, or launches a new
+// one if none is idle.
+func (p *chromePool) get() (*chromeBrowser, error) {
+	p.slots <- struct{}{}
+
+	p.removeDead()
+	p.Lock()
+	if n := len(p.idle); n > 0 {
+		b := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.Unlock()
+		return b, nil
+	}
+	p.Unlock()
+
+	b, err := p.start()
+	if err != nil {
+		<-p.slots
+		return nil, err
+	}
+	return b, nil
+}
+
+// put returns an instance to the pool after a screenshot.
+func (p *chromePool) put(b *chromeBrowser) {
+	p.Lock()
+	if b.alive() && !p.closed {
+		p.idle = append(p.idle, b)
+		b = nil
+	}
+	p.Unlock()
+	if b != nil {
+		b.close()
+	}
+	<-p.slots
+}
+
+// removeDead drops idle instances that are no longer alive.
+func (p *chromePool) removeDead() {
+	p.Lock()
+	defer p.Unlock()
+	alive := p.idle[:0]
+	for _, b := range p.idle {
+		if b.alive() {
+			alive = append(alive, b)
+		}
+	}
+	p.idle = alive
+}
+
+// healthCheck periodically checks that idle instances respond, and
+// replaces those that don't. Busy instances are left alone, as a page can
+// keep Chrome busy for a while.
+func (p *chromePool) healthCheck() {
+	ticker := time.NewTicker(chromeHealthInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+		}
+		for i := 0; i < p.size; i++ {
+			if !p.checkIdle() {
+				break
+			}
+		}
+	}
+}
+
+// checkIdle checks the instance that has been idle the longest, and
+// reports whether there was one to check.
+func (p *chromePool) checkIdle() bool {
+	select {
+	case p.slots <- struct{}{}:
+	default:
+		return false
+	}
+	p.Lock()
+	if len(p.idle) == 0 {
+		p.Unlock()
+		<-p.slots
+		return false
+	}
+	b := p.idle[0]
+	p.idle = p.idle[1:]
+	p.Unlock()
+
+	// Instances that crashed are restarted by start once they have exited,
+	// those that hang are closed and restarted here
+	if err := b.ping(chromeHealthTimeout); err != nil && b.alive() {
+		p.session.Out.Debug("[agent:url_screenshotter] Chrome instance stopped responding: %v\n", err)
+		p.session.Stats.IncrementBrowserCrashes()
+		b.close()
+		<-p.slots
+		go p.restart()
+		return true
+	}
+	p.put(b)
+	return true
+}
+
+// close stops health checks and closes the idle instances. Instances that
+// are put back later are closed as well.
+func (p *chromePool) close() {
+	p.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.closed = true
+	p.Unlock()
+	close(p.done)
+
+	for _, b := range idle {
+		b.close()
+	}
+}
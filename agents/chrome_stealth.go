@@ -0,0 +1,162 @@
+package agents
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// stealthArgs are added to the arguments of Chrome with --stealth. They stop
+// Chrome from announcing that it is controlled by automation software.
+var stealthArgs = []string{
+	"--disable-blink-features=AutomationControlled",
+	"--lang=en-US",
+}
+
+// stealthScript runs in every page before the scripts of the page with
+// --stealth, and patches the properties bot detection scripts commonly
+// check to tell headless Chrome from a regular browser.
+const stealthScript = `(() => {
+  const patch = (obj, prop, value) => {
+    try {
+      Object.defineProperty(obj, prop, { get: () => value, configurable: true });
+    } catch (e) {}
+  };
+
+  // Browsers controlled over DevTools report navigator.webdriver as true
+  patch(Navigator.prototype, 'webdriver', false);
+
+  // Headless Chrome has no plugins, where regular Chrome has its PDF viewer
+  if (navigator.plugins.length === 0) {
+    const mimeType = { type: 'application/pdf', suffixes: 'pdf', description: 'Portable Document Format' };
+    const names = ['PDF Viewer', 'Chrome PDF Viewer', 'Chromium PDF Viewer', 'Microsoft Edge PDF Viewer', 'WebKit built-in PDF'];
+    const plugins = names.map((name) => ({ name: name, filename: 'internal-pdf-viewer', description: 'Portable Document Format', length: 1, 0: mimeType }));
+    plugins.item = (i) => plugins[i] || null;
+    plugins.namedItem = (name) => plugins.find((p) => p.name === name) || null;
+    plugins.refresh = () => {};
+    const mimeTypes = [mimeType];
+    mimeTypes.item = (i) => mimeTypes[i] || null;
+    mimeTypes.namedItem = (type) => mimeTypes.find((m) => m.type === type) || null;
+    patch(Navigator.prototype, 'plugins', plugins);
+    patch(Navigator.prototype, 'mimeTypes', mimeTypes);
+  }
+
+  if (!navigator.languages || navigator.languages.length === 0) {
+    patch(Navigator.prototype, 'languages', ['en-US', 'en']);
+  }
+
+  // window.chrome is only set up in regular Chrome
+  if (!window.chrome) {
+    window.chrome = { app: { isInstalled: false }, runtime: {}, csi: () => ({}), loadTimes: () => ({}) };
+  }
+
+  // Headless Chrome denies notifications while reporting that it would ask
+  if (navigator.permissions && typeof Notification !== 'undefined') {
+    const query = navigator.permissions.query.bind(navigator.permissions);
+    navigator.permissions.query = (descriptor) =>
+      descriptor && descriptor.name === 'notifications'
+        ? Promise.resolve({ state: Notification.permission === 'default' ? 'prompt' : Notification.permission, onchange: null })
+        : query(descriptor);
+  }
+
+  // Headless Chrome has no window around the page
+  if (window.outerWidth === 0 && window.outerHeight === 0) {
+    patch(window, 'outerWidth', window.innerWidth);
+    patch(window, 'outerHeight', window.innerHeight + 85);
+  }
+
+  // Headless Chrome renders WebGL with SwiftShader, which gives itself away
+  // in the renderer of the debug info extension
+  for (const context of [window.WebGLRenderingContext, window.WebGL2RenderingContext]) {
+    if (!context) {
+      continue;
+    }
+    const getParameter = context.prototype.getParameter;
+    context.prototype.getParameter = function (parameter) {
+      if (parameter === 37445) {
+        return 'Intel Inc.';
+      }
+      if (parameter === 37446) {
+        return 'Intel Iris OpenGL Engine';
+      }
+      return getParameter.call(this, parameter);
+    };
+  }
+})();`
+
+// chromeStealth holds the user agent override of an instance with
+// --stealth: the user agent of the instance itself without "HeadlessChrome",
+// so it matches the features of the browser, along with matching client
+// hints. Without an override, pages get a random user agent as usual.
+type chromeStealth struct {
+	userAgentOverride map[string]interface{}
+}
+
+// enableStealth sets up an instance to hide that it runs headless in the
+// pages it opens. The script is injected even when the user agent of the
+// instance can't be determined.
+func (b *chromeBrowser) enableStealth() error {
+	b.stealth = &chromeStealth{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var version struct {
+		Product   string `json:"product"`
+		UserAgent string `json:"userAgent"`
+	}
+	if err := b.call(ctx, "", "Browser.getVersion", nil, &version); err != nil {
+		return err
+	}
+	if version.UserAgent == "" {
+		return errors.New("Chrome did not report its user agent")
+	}
+	b.stealth.userAgentOverride = stealthUserAgentOverride(version.Product, version.UserAgent)
+	return nil
+}
+
+// stealthUserAgentOverride returns the parameters of
+// Emulation.setUserAgentOverride for an instance with the given product
+// (e.g. HeadlessChrome/120.0.6099.109) and user agent.
+func stealthUserAgentOverride(product string, userAgent string) map[string]interface{} {
+	fullVersion := product[strings.Index(product, "/")+1:]
+	majorVersion := strings.Split(fullVersion, ".")[0]
+
+	architecture := "x86"
+	if strings.HasPrefix(runtime.GOARCH, "arm") {
+		architecture = "arm"
+	}
+
+	platform, navigatorPlatform := "Linux", "Linux x86_64"
+	switch runtime.GOOS {
+	case "windows":
+		platform, navigatorPlatform = "Windows", "Win32"
+	case "darwin":
+		platform, navigatorPlatform = "macOS", "MacIntel"
+	}
+
+	return map[string]interface{}{
+		"userAgent":      strings.Replace(userAgent, "HeadlessChrome", "Chrome", 1),
+		"acceptLanguage": "en-US,en;q=0.9",
+		"platform":       navigatorPlatform,
+		"userAgentMetadata": map[string]interface{}{
+			"brands": []map[string]string{
+				{"brand": "Not_A Brand", "version": "8"},
+				{"brand": "Chromium", "version": majorVersion},
+				{"brand": "Google Chrome", "version": majorVersion},
+			},
+			"fullVersionList": []map[string]string{
+				{"brand": "Not_A Brand", "version": "8.0.0.0"},
+				{"brand": "Chromium", "version": fullVersion},
+				{"brand": "Google Chrome", "version": fullVersion},
+			},
+			"fullVersion":     fullVersion,
+			"platform":        platform,
+			"platformVersion": "",
+			"architecture":    architecture,
+			"model":           "",
+			"mobile":          false,
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package agents
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+)
+
+func TestDeliverDropsEventsOfStalledTabs(t *testing.T) {
+	b := &chromeBrowser{out: &core.Logger{}, stalled: make(map[string]bool)}
+	events := make(chan *cdpMessage, 1)
+	event := func(method string) *cdpMessage {
+		return &cdpMessage{SessionID: "tab", Method: method}
+	}
+
+	b.deliver(events, event("Page.frameStartedLoading"))
+	start := time.Now()
+	b.deliver(events, event("Network.requestWillBeSent"))
+	if waited := time.Since(start); waited < cdpEventTimeout {
+		t.Errorf("event dropped after %s; want to wait %s for the tab", waited, cdpEventTimeout)
+	}
+	if !b.stalled["tab"] {
+		t.Fatal("tab not marked as stalled after dropping an event")
+	}
+
+	// Events of a stalled tab are dropped without waiting again
+	start = time.Now()
+	b.deliver(events, event("Network.responseReceived"))
+	if waited := time.Since(start); waited >= cdpEventTimeout {
+		t.Errorf("waited %s for a stalled tab", waited)
+	}
+
+	if msg := <-events; msg.Method != "Page.frameStartedLoading" {
+		t.Errorf("tab got %s; want the event delivered before it stalled", msg.Method)
+	}
+	b.deliver(events, event("Page.loadEventFired"))
+	if msg := <-events; msg.Method != "Page.loadEventFired" {
+		t.Errorf("tab got %s; want the event delivered after it read its events", msg.Method)
+	}
+	if b.stalled["tab"] {
+		t.Error("tab still marked as stalled after it read its events")
+	}
+}
+
+// fakeChromeRunner runs a shell script instead of Chrome.
+type fakeChromeRunner struct {
+	script string
+}
+
+func (r fakeChromeRunner) CommandContext(ctx context.Context, name string, arg ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, "/bin/sh", "-c", r.script)
+}
+
+func TestLaunchChromeFailsWhenDevToolsCannotBeDialed(t *testing.T) {
+	// Nothing listens on port 1, so connecting to the endpoint fails and the
+	// instance is closed before it has a connection
+	runner := fakeChromeRunner{script: "echo 'DevTools listening on ws://127.0.0.1:1/devtools/browser/test' >&2; exec sleep 60"}
+	start := time.Now()
+	b, err := launchChrome(runner, &core.Logger{}, "chrome", nil, nil, t.TempDir())
+	if err == nil {
+		b.close()
+		t.Fatal("launchChrome() succeeded; want an error")
+	}
+	if !strings.Contains(err.Error(), "unable to connect to Chrome DevTools") {
+		t.Errorf("launchChrome() = %v; want an error about connecting to DevTools", err)
+	}
+	if waited := time.Since(start); waited > 5*time.Second {
+		t.Errorf("launchChrome() took %s to give up on an instance it could not connect to", waited)
+	}
+}
+
+func TestCallWithoutConnection(t *testing.T) {
+	b := &chromeBrowser{pending: make(map[int64]chan *cdpMessage)}
+	if err := b.call(context.Background(), "", "Browser.close", nil, nil); err != errChromeClosed {
+		t.Errorf("call() = %v; want %v", err, errChromeClosed)
+	}
+}
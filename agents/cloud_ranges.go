@@ -0,0 +1,70 @@
+package agents
+
+import "net"
+
+// cloudRange is an IP range from which a cloud provider hands out addresses
+// to customers, like AWS Elastic IPs. Addresses that are released go back
+// into the pool and can be allocated by anyone.
+type cloudRange struct {
+	provider string
+	network  *net.IPNet
+}
+
+// cloudRanges holds the largest blocks of the big cloud providers. The list
+// is not exhaustive, but covers most of the addresses handed out to
+// customers.
+var cloudRanges = parseCloudRanges(map[string][]string{
+	"AWS": {
+		"3.0.0.0/9", "13.48.0.0/13", "15.160.0.0/12", "18.128.0.0/9",
+		"34.192.0.0/10", "35.152.0.0/13", "44.192.0.0/10", "50.16.0.0/14",
+		"52.0.0.0/11", "52.32.0.0/11", "52.64.0.0/12", "54.64.0.0/11",
+		"54.144.0.0/12", "54.160.0.0/11", "54.208.0.0/13", "54.216.0.0/14",
+		"54.220.0.0/15", "54.224.0.0/11", "99.80.0.0/15",
+	},
+	"Google Cloud": {
+		"34.64.0.0/10", "34.128.0.0/10", "35.184.0.0/13", "35.192.0.0/12",
+		"35.208.0.0/12", "35.224.0.0/12", "35.240.0.0/13", "104.154.0.0/15",
+		"104.196.0.0/14", "130.211.0.0/16",
+	},
+	"Microsoft Azure": {
+		"4.144.0.0/12", "13.64.0.0/11", "20.0.0.0/11", "20.32.0.0/11",
+		"20.64.0.0/10", "20.128.0.0/10", "20.192.0.0/10", "40.64.0.0/10",
+		"51.104.0.0/15", "52.136.0.0/13", "52.224.0.0/11", "104.40.0.0/13",
+		"137.116.0.0/15", "168.61.0.0/16", "191.232.0.0/13",
+	},
+	"DigitalOcean": {
+		"104.131.0.0/16", "134.209.0.0/16", "138.197.0.0/16", "157.245.0.0/16",
+		"159.65.0.0/16", "159.89.0.0/16", "161.35.0.0/16", "165.227.0.0/16",
+		"167.99.0.0/16", "167.172.0.0/16", "178.62.0.0/16", "188.166.0.0/16",
+		"206.189.0.0/16",
+	},
+})
+
+func parseCloudRanges(ranges map[string][]string) []cloudRange {
+	var parsed []cloudRange
+	for provider, cidrs := range ranges {
+		for _, cidr := range cidrs {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				panic(err)
+			}
+			parsed = append(parsed, cloudRange{provider: provider, network: network})
+		}
+	}
+	return parsed
+}
+
+// cloudProvider returns the name of the cloud provider the IP address belongs
+// to, or an empty string if it isn't in any of the known ranges.
+func cloudProvider(addr string) string {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return ""
+	}
+	for _, r := range cloudRanges {
+		if r.network.Contains(ip) {
+			return r.provider
+		}
+	}
+	return ""
+}
@@ -0,0 +1,90 @@
+package agents
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+	"golang.org/x/net/dns/dnsmessage"
+	"golang.org/x/net/publicsuffix"
+)
+
+// dnsClient sends single DNS queries and returns the full responses. The
+// resolver in the net package hides response codes and does not let us ask
+// for arbitrary record types or ask a specific nameserver, which is needed to
+// find dangling records.
+type dnsClient struct {
+	session *core.Session
+	server  string
+	timeout time.Duration
+}
+
+func newDNSClient(s *core.Session) *dnsClient {
+	server, _ := core.SystemDNSServer()
+	return &dnsClient{
+		session: s,
+		server:  server,
+		timeout: time.Duration(*s.Options.HTTPTimeout) * time.Millisecond,
+	}
+}
+
+// Query asks the system resolver for records of the given type.
+func (c *dnsClient) Query(name string, qtype dnsmessage.Type) (*dnsmessage.Message, error) {
+	return c.QueryServer(c.server, name, qtype, true)
+}
+
+// QueryServer sends a query to the nameserver at the given address. Queries
+// to authoritative nameservers should not ask for recursion.
+func (c *dnsClient) QueryServer(server string, name string, qtype dnsmessage.Type, recursive bool) (*dnsmessage.Message, error) {
+	return c.session.QueryDNS(server, name, qtype, recursive, c.timeout)
+}
+
+// Exists reports whether a name exists. Only a NXDOMAIN response counts as
+// not existing; errors and other failures are returned as errors so they
+// aren't mistaken for dangling records.
+func (c *dnsClient) Exists(name string) (bool, error) {
+	response, err := c.Query(name, dnsmessage.TypeA)
+	if err != nil {
+		return false, err
+	}
+	switch response.Header.RCode {
+	case dnsmessage.RCodeSuccess:
+		return true, nil
+	case dnsmessage.RCodeNameError:
+		return false, nil
+	}
+	return false, fmt.Errorf("query for %s failed with %v", name, response.Header.RCode)
+}
+
+// Registered reports whether the registered domain of a name, like
+// example.com for www.example.com, exists in its top-level domain. Names
+// under private suffixes like herokuapp.com are always reported as
+// registered, as the services behind them are covered by takeover
+// detection.
+func (c *dnsClient) Registered(name string) (bool, string, error) {
+	name = strings.TrimSuffix(name, ".")
+	if _, icann := publicsuffix.PublicSuffix(name); !icann {
+		return true, "", nil
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(name)
+	if err != nil {
+		return true, "", nil
+	}
+	response, err := c.Query(domain, dnsmessage.TypeNS)
+	if err != nil {
+		return false, domain, err
+	}
+	switch response.Header.RCode {
+	case dnsmessage.RCodeSuccess:
+		return true, domain, nil
+	case dnsmessage.RCodeNameError:
+		return false, domain, nil
+	}
+	return false, domain, fmt.Errorf("query for %s failed with %v", domain, response.Header.RCode)
+}
+
+// dnsName returns the name without the trailing dot.
+func dnsName(name dnsmessage.Name) string {
+	return strings.TrimSuffix(name.String(), ".")
+}
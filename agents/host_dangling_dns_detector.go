@@ -0,0 +1,345 @@
+package agents
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mk990/aquatone/core"
+	"golang.org/x/net/dns/dnsmessage"
+	"golang.org/x/net/publicsuffix"
+)
+
+// maxCNAMEChain is the number of CNAME records that are followed before
+// giving up on a chain.
+const maxCNAMEChain = 8
+
+// HostDanglingDNSDetector looks for DNS records of hosts that point to
+// things that no longer exist: CNAMEs into expired domains, MX and NS records
+// pointing to missing hosts or nameservers that don't serve the zone, and A
+// records into cloud provider ranges where nothing answers anymore.
+type HostDanglingDNSDetector struct {
+	session *core.Session
+	dns     *dnsClient
+	seen    sync.Map
+	mutex   sync.Mutex
+	addrs   map[string][]string
+}
+
+func NewHostDanglingDNSDetector() *HostDanglingDNSDetector {
+	return &HostDanglingDNSDetector{addrs: make(map[string][]string)}
+}
+
+func (a *HostDanglingDNSDetector) ID() string {
+	return "agent:host_dangling_dns_detector"
+}
+
+func (a *HostDanglingDNSDetector) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.TargetHost, a.OnHost, false)
+	s.EventBus.SubscribeAsync(core.URL, a.OnURL, false)
+	s.EventBus.SubscribeAsync(core.SessionEnd, a.OnSessionEnd, false)
+	a.session = s
+	a.dns = newDNSClient(s)
+	return nil
+}
+
+// OnHost checks hosts that are port scanned. Their addresses are kept to
+// look for dangling A records when the scan is done.
+func (a *HostDanglingDNSDetector) OnHost(host string) {
+	a.session.Out.Debug("[%s] Received new host: %s\n", a.ID(), host)
+	a.check(host, true)
+}
+
+// OnURL checks the hosts of URLs given as targets or found by the port
+// scanner.
+func (a *HostDanglingDNSDetector) OnURL(u string) {
+	a.session.Out.Debug("[%s] Received new url: %s\n", a.ID(), u)
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return
+	}
+	a.check(parsed.Hostname(), false)
+}
+
+// OnSessionEnd reports scanned hosts whose addresses are all in cloud
+// provider ranges but didn't answer on any port. Addresses like that have
+// often been released back to the provider, and anyone who gets one
+// allocated controls the host.
+func (a *HostDanglingDNSDetector) OnSessionEnd() {
+	a.session.Out.Debug("[%s] Received SessionEnd event\n", a.ID())
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	for host, addrs := range a.addrs {
+		if len(addrs) == 0 || a.responded(host) {
+			continue
+		}
+		inCloud := true
+		for _, addr := range addrs {
+			if cloudProvider(addr) == "" {
+				inCloud = false
+				break
+			}
+		}
+		if !inCloud {
+			continue
+		}
+		for _, addr := range addrs {
+			a.report(core.DNSFinding{
+				Hostname: host,
+				Type:     core.DNSFindingDanglingA,
+				Record:   "A " + addr,
+				Detail:   fmt.Sprintf("%s is in a %s range and no ports answered, so the address may have been released and could be allocated by someone else", addr, cloudProvider(addr)),
+			})
+		}
+	}
+}
+
+func (a *HostDanglingDNSDetector) check(host string, scanned bool) {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if net.ParseIP(host) != nil || !strings.Contains(host, ".") {
+		return
+	}
+	_, loaded := a.seen.LoadOrStore(host, true)
+	if loaded && !scanned {
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(host string) {
+		defer a.session.WaitGroup.Done()
+		defer a.session.TrackAgent(a.ID())()
+		if scanned {
+			if addrs, err := a.session.LookupHost(host); err == nil {
+				a.mutex.Lock()
+				a.addrs[host] = addrs
+				a.mutex.Unlock()
+			}
+		}
+		if !loaded {
+			a.checkCNAME(host)
+			a.checkMX(host)
+			a.checkNS(host)
+		}
+	}(host)
+}
+
+// checkCNAME follows the CNAME chain of the host and reports targets in
+// domains outside of the host's own domain that are no longer registered.
+func (a *HostDanglingDNSDetector) checkCNAME(host string) {
+	hostDomain, _ := publicsuffix.EffectiveTLDPlusOne(host)
+	name := host
+	for i := 0; i < maxCNAMEChain; i++ {
+		response, err := a.dns.Query(name, dnsmessage.TypeCNAME)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error looking up CNAME of %s: %v\n", a.ID(), name, err)
+			return
+		}
+		target := ""
+		for _, answer := range response.Answers {
+			if cname, ok := answer.Body.(*dnsmessage.CNAMEResource); ok && strings.EqualFold(dnsName(answer.Header.Name), name) {
+				target = dnsName(cname.CNAME)
+			}
+		}
+		if target == "" {
+			return
+		}
+
+		if domain, _ := publicsuffix.EffectiveTLDPlusOne(target); domain != hostDomain {
+			registered, domain, err := a.dns.Registered(target)
+			if err != nil {
+				a.session.Out.Debug("[%s] Error checking registration of %s: %v\n", a.ID(), target, err)
+			} else if !registered {
+				a.report(core.DNSFinding{
+					Hostname: host,
+					Type:     core.DNSFindingExpiredCNAME,
+					Record:   "CNAME " + target,
+					Detail:   fmt.Sprintf("CNAME points to %s in the domain %s, which is not registered and can be bought by anyone", target, domain),
+				})
+				return
+			}
+		}
+		name = target
+	}
+}
+
+// checkMX reports mail exchangers of the host that don't exist or are in
+// domains that are no longer registered.
+func (a *HostDanglingDNSDetector) checkMX(host string) {
+	response, err := a.dns.Query(host, dnsmessage.TypeMX)
+	if err != nil {
+		a.session.Out.Debug("[%s] Error looking up MX of %s: %v\n", a.ID(), host, err)
+		return
+	}
+	for _, answer := range response.Answers {
+		mx, ok := answer.Body.(*dnsmessage.MXResource)
+		if !ok || !strings.EqualFold(dnsName(answer.Header.Name), host) {
+			continue
+		}
+		// A null MX of "." means the host doesn't accept mail
+		exchange := dnsName(mx.MX)
+		if exchange == "" {
+			continue
+		}
+
+		if registered, domain, err := a.dns.Registered(exchange); err == nil && !registered {
+			a.report(core.DNSFinding{
+				Hostname: host,
+				Type:     core.DNSFindingDanglingMX,
+				Record:   "MX " + exchange,
+				Detail:   fmt.Sprintf("mail is delivered to %s in the domain %s, which is not registered and can be bought by anyone", exchange, domain),
+			})
+			continue
+		}
+		if exists, err := a.dns.Exists(exchange); err == nil && !exists {
+			a.report(core.DNSFinding{
+				Hostname: host,
+				Type:     core.DNSFindingDanglingMX,
+				Record:   "MX " + exchange,
+				Detail:   fmt.Sprintf("mail is delivered to %s, which does not exist", exchange),
+			})
+		}
+	}
+}
+
+// checkNS reports nameservers the host is delegated to that are in domains
+// that are no longer registered, and delegations where none of the
+// nameservers serve the zone. Zones like that can often be claimed by
+// creating them at the DNS provider the nameservers belong to.
+func (a *HostDanglingDNSDetector) checkNS(host string) {
+	servers, err := a.delegation(host)
+	if err != nil {
+		a.session.Out.Debug("[%s] Error looking up NS of %s: %v\n", a.ID(), host, err)
+		return
+	}
+	if len(servers) == 0 {
+		return
+	}
+
+	unregistered := 0
+	var lame []string
+	for _, ns := range servers {
+		if registered, domain, err := a.dns.Registered(ns); err == nil && !registered {
+			unregistered++
+			a.report(core.DNSFinding{
+				Hostname: host,
+				Type:     core.DNSFindingDanglingNS,
+				Record:   "NS " + ns,
+				Detail:   fmt.Sprintf("zone is delegated to %s in the domain %s, which is not registered and can be bought by anyone", ns, domain),
+			})
+			continue
+		}
+		if a.lame(host, ns) {
+			lame = append(lame, ns)
+		}
+	}
+
+	if len(lame) > 0 && unregistered+len(lame) == len(servers) {
+		a.report(core.DNSFinding{
+			Hostname: host,
+			Type:     core.DNSFindingDanglingNS,
+			Record:   "NS " + strings.Join(lame, " "),
+			Detail:   fmt.Sprintf("zone is delegated to %s, but none of them serve it", strings.Join(lame, ", ")),
+		})
+	}
+}
+
+// delegation returns the nameservers the host is delegated to, if it is the
+// apex of a zone. Resolvers fail to look up zones whose nameservers don't
+// serve them, so in that case the nameservers of the parent zone are asked
+// for the delegation instead.
+func (a *HostDanglingDNSDetector) delegation(host string) ([]string, error) {
+	response, err := a.dns.Query(host, dnsmessage.TypeNS)
+	if err != nil {
+		return nil, err
+	}
+	if servers := nsRecords(response.Answers, host); len(servers) > 0 {
+		return servers, nil
+	}
+	if response.Header.RCode != dnsmessage.RCodeServerFailure {
+		return nil, nil
+	}
+
+	for parent := parentName(host); parent != ""; parent = parentName(parent) {
+		response, err := a.dns.Query(parent, dnsmessage.TypeNS)
+		if err != nil {
+			return nil, err
+		}
+		parentServers := nsRecords(response.Answers, parent)
+		if len(parentServers) == 0 {
+			continue
+		}
+		addrs, err := a.session.LookupHost(parentServers[0])
+		if err != nil {
+			return nil, err
+		}
+		response, err = a.dns.QueryServer(net.JoinHostPort(addrs[0], "53"), host, dnsmessage.TypeNS, false)
+		if err != nil {
+			return nil, err
+		}
+		return nsRecords(append(response.Answers, response.Authorities...), host), nil
+	}
+	return nil, nil
+}
+
+// lame reports whether the nameserver doesn't exist or refuses to answer for
+// the zone. Timeouts and other errors are not taken as proof.
+func (a *HostDanglingDNSDetector) lame(zone string, ns string) bool {
+	addrs, err := a.session.LookupHost(ns)
+	if err != nil {
+		dnsErr, ok := err.(*net.DNSError)
+		return ok && dnsErr.IsNotFound
+	}
+	response, err := a.dns.QueryServer(net.JoinHostPort(addrs[0], "53"), zone, dnsmessage.TypeSOA, false)
+	if err != nil {
+		a.session.Out.Debug("[%s] Error asking %s for SOA of %s: %v\n", a.ID(), ns, zone, err)
+		return false
+	}
+	rcode := response.Header.RCode
+	return rcode == dnsmessage.RCodeRefused || rcode == dnsmessage.RCodeServerFailure
+}
+
+// responded reports whether any port of the host was open or any page was
+// found for it.
+func (a *HostDanglingDNSDetector) responded(host string) bool {
+	for _, port := range a.session.Ports {
+		if len(a.session.GetPortAddrs(net.JoinHostPort(host, strconv.Itoa(port)))) > 0 {
+			return true
+		}
+	}
+	a.session.Lock()
+	defer a.session.Unlock()
+	for _, page := range a.session.Pages {
+		if strings.EqualFold(page.Hostname, host) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *HostDanglingDNSDetector) report(finding core.DNSFinding) {
+	a.session.AddDNSFinding(finding)
+	a.session.Out.Warn("%s: dangling %s record: %s\n", finding.Hostname, strings.Fields(finding.Record)[0], finding.Detail)
+}
+
+// nsRecords returns the nameservers in the NS records for the name.
+func nsRecords(resources []dnsmessage.Resource, name string) []string {
+	var servers []string
+	for _, resource := range resources {
+		if ns, ok := resource.Body.(*dnsmessage.NSResource); ok && strings.EqualFold(dnsName(resource.Header.Name), name) {
+			servers = append(servers, dnsName(ns.NS))
+		}
+	}
+	return servers
+}
+
+// parentName returns the name without its first label.
+func parentName(name string) string {
+	if i := strings.Index(name, "."); i != -1 {
+		return name[i+1:]
+	}
+	return ""
+}
@@ -0,0 +1,108 @@
+package agents
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+)
+
+const (
+	// interferenceWindow is the number of most recent connection attempts
+	// compared against the rest of the scan.
+	interferenceWindow = 100
+	// interferenceRatio is the share of timeouts and RSTs in the recent
+	// window needed before the network is considered to interfere.
+	interferenceRatio = 0.8
+	// interferenceIncrease is how much higher the recent share must be
+	// compared to the scan so far, so targets that simply drop packets on
+	// closed ports do not trigger a slowdown.
+	interferenceIncrease = 0.5
+	initialSlowdownDelay = 250 * time.Millisecond
+	maxSlowdownDelay     = 5 * time.Second
+)
+
+// interferenceDetector watches the outcome of connection attempts for a
+// sudden rise in timeouts and RST packets, which usually means that
+// rate limiting or an IDS started interfering with the scan.
+type interferenceDetector struct {
+	sync.Mutex
+	recent      []bool
+	next        int
+	total       int
+	interfered  int
+	slowdown    bool
+	delay       time.Duration
+	lastTrigger int
+}
+
+func newInterferenceDetector() *interferenceDetector {
+	return &interferenceDetector{recent: make([]bool, 0, interferenceWindow)}
+}
+
+// Record registers the failure reason of a connection attempt, or an empty
+// string for a successful connection. It returns true when the slowdown is
+// engaged or increased because of this attempt.
+func (d *interferenceDetector) Record(reason string) bool {
+	d.Lock()
+	defer d.Unlock()
+
+	interfered := reason == core.ReasonTimeout || reason == core.ReasonReset || reason == core.ReasonRefused
+	if len(d.recent) < interferenceWindow {
+		d.recent = append(d.recent, interfered)
+	} else {
+		d.recent[d.next] = interfered
+		d.next = (d.next + 1) % interferenceWindow
+	}
+	d.total++
+	if interfered {
+		d.interfered++
+	}
+
+	// Only compare once there is history from before the recent window,
+	// and give a previous slowdown a full window to take effect.
+	if d.total < 2*interferenceWindow || d.total-d.lastTrigger < interferenceWindow {
+		return false
+	}
+
+	recentCount := 0
+	for _, r := range d.recent {
+		if r {
+			recentCount++
+		}
+	}
+	recentRatio := float64(recentCount) / float64(len(d.recent))
+	earlierRatio := float64(d.interfered-recentCount) / float64(d.total-len(d.recent))
+	if recentRatio < interferenceRatio || recentRatio-earlierRatio < interferenceIncrease {
+		return false
+	}
+
+	d.lastTrigger = d.total
+	if !d.slowdown {
+		d.slowdown = true
+		d.delay = initialSlowdownDelay
+		return true
+	}
+	if d.delay < maxSlowdownDelay {
+		d.delay *= 2
+		if d.delay > maxSlowdownDelay {
+			d.delay = maxSlowdownDelay
+		}
+		return true
+	}
+	return false
+}
+
+// Slowdown reports whether the slowdown mode is engaged.
+func (d *interferenceDetector) Slowdown() bool {
+	d.Lock()
+	defer d.Unlock()
+	return d.slowdown
+}
+
+// Delay returns how long to wait before each connection attempt.
+func (d *interferenceDetector) Delay() time.Duration {
+	d.Lock()
+	defer d.Unlock()
+	return d.delay
+}
@@ -0,0 +1,405 @@
+package agents
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// jarmProbe describes one of the ten client hellos sent to compute a JARM
+// fingerprint. The probes and packet layout follow the reference
+// implementation at https://github.com/salesforce/jarm.
+type jarmProbe struct {
+	version        string
+	ciphers        string
+	cipherOrder    string
+	grease         bool
+	rareALPN       bool
+	support        string
+	extensionOrder string
+}
+
+var jarmProbes = []jarmProbe{
+	{"TLS_1.2", "ALL", "FORWARD", false, false, "1.2_SUPPORT", "REVERSE"},
+	{"TLS_1.2", "ALL", "REVERSE", false, false, "1.2_SUPPORT", "FORWARD"},
+	{"TLS_1.2", "ALL", "TOP_HALF", false, false, "NO_SUPPORT", "FORWARD"},
+	{"TLS_1.2", "ALL", "BOTTOM_HALF", false, true, "NO_SUPPORT", "FORWARD"},
+	{"TLS_1.2", "ALL", "MIDDLE_OUT", true, true, "NO_SUPPORT", "REVERSE"},
+	{"TLS_1.1", "ALL", "FORWARD", false, false, "NO_SUPPORT", "FORWARD"},
+	{"TLS_1.3", "ALL", "FORWARD", false, false, "1.3_SUPPORT", "REVERSE"},
+	{"TLS_1.3", "ALL", "REVERSE", false, false, "1.3_SUPPORT", "FORWARD"},
+	{"TLS_1.3", "NO1.3", "FORWARD", false, false, "1.3_SUPPORT", "FORWARD"},
+	{"TLS_1.3", "ALL", "MIDDLE_OUT", true, false, "1.3_SUPPORT", "REVERSE"},
+}
+
+var jarmCiphers = []uint16{
+	0x0016, 0x0033, 0x0067, 0xc09e, 0xc0a2, 0x009e, 0x0039, 0x006b, 0xc09f, 0xc0a3,
+	0x009f, 0x0045, 0x00be, 0x0088, 0x00c4, 0x009a, 0xc008, 0xc009, 0xc023, 0xc0ac,
+	0xc0ae, 0xc02b, 0xc00a, 0xc024, 0xc0ad, 0xc0af, 0xc02c, 0xc072, 0xc073, 0xcca9,
+	0x1302, 0x1301, 0xcc14, 0xc007, 0xc012, 0xc013, 0xc027, 0xc02f, 0xc014, 0xc028,
+	0xc030, 0xc060, 0xc061, 0xc076, 0xc077, 0xcca8, 0x1305, 0x1304, 0x1303, 0xcc13,
+	0xc011, 0x000a, 0x002f, 0x003c, 0xc09c, 0xc0a0, 0x009c, 0x0035, 0x003d, 0xc09d,
+	0xc0a1, 0x009d, 0x0041, 0x00ba, 0x0084, 0x00c0, 0x0007, 0x0004, 0x0005,
+}
+
+// jarmCipherIndex is the sorted cipher list used to encode the selected
+// cipher of each probe in the fingerprint.
+var jarmCipherIndex = []uint16{
+	0x0004, 0x0005, 0x0007, 0x000a, 0x0016, 0x002f, 0x0033, 0x0035, 0x0039, 0x003c,
+	0x003d, 0x0041, 0x0045, 0x0067, 0x006b, 0x0084, 0x0088, 0x009a, 0x009c, 0x009d,
+	0x009e, 0x009f, 0x00ba, 0x00be, 0x00c0, 0x00c4, 0xc007, 0xc008, 0xc009, 0xc00a,
+	0xc011, 0xc012, 0xc013, 0xc014, 0xc023, 0xc024, 0xc027, 0xc028, 0xc02b, 0xc02c,
+	0xc02f, 0xc030, 0xc060, 0xc061, 0xc072, 0xc073, 0xc076, 0xc077, 0xc09c, 0xc09d,
+	0xc09e, 0xc09f, 0xc0a0, 0xc0a1, 0xc0a2, 0xc0a3, 0xc0ac, 0xc0ad, 0xc0ae, 0xc0af,
+	0xcc13, 0xcc14, 0xcca8, 0xcca9, 0x1301, 0x1302, 0x1303, 0x1304, 0x1305,
+}
+
+var jarmALPNs = []string{"http/0.9", "http/1.0", "http/1.1", "spdy/1", "spdy/2", "spdy/3", "h2", "h2c", "hq"}
+var jarmRareALPNs = []string{"http/0.9", "http/1.0", "spdy/1", "spdy/2", "spdy/3", "h2c", "hq"}
+
+// emptyJARM is the fingerprint of a server that did not answer any probe.
+var emptyJARM = strings.Repeat("0", 62)
+
+// knownJARMs maps JARM fingerprints of well known command and control
+// servers to their names. Legitimate servers built on the same TLS stack
+// share these fingerprints, so a match is a lead rather than a verdict.
+var knownJARMs = map[string]string{
+	"07d14d16d21d21d07c42d41d00041d24a458a375eef0c576d23a7bab9a9fb1": "Cobalt Strike",
+	"07d14d16d21d21d00042d43d000000aa99ce74e2c6d013c745aa52b5cc042d": "Metasploit",
+	"29d21b20d29d29d21c41d21b21b41d494e0df9532e75299f15ba73156cee38": "Merlin",
+}
+
+// JARM computes the JARM fingerprint of the TLS server at address. The
+// hostname is sent as server name and dial is used to open the ten
+// connections.
+func JARM(address string, hostname string, timeout time.Duration, dial func(network, address string) (net.Conn, error)) string {
+	var raw []string
+	for _, probe := range jarmProbes {
+		raw = append(raw, jarmSendProbe(address, hostname, timeout, dial, probe))
+	}
+	return jarmHash(raw)
+}
+
+func jarmSendProbe(address string, hostname string, timeout time.Duration, dial func(network, address string) (net.Conn, error), probe jarmProbe) string {
+	conn, err := dial("tcp", address)
+	if err != nil {
+		return "|||"
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+
+	if _, err := conn.Write(jarmClientHello(hostname, probe)); err != nil {
+		return "|||"
+	}
+
+	buf := make([]byte, 1484)
+	n := 0
+	for n < len(buf) {
+		read, err := conn.Read(buf[n:])
+		n += read
+		if err != nil {
+			break
+		}
+		// Stop once the first TLS record has been read completely
+		if n >= 5 && n >= 5+int(binary.BigEndian.Uint16(buf[3:5])) {
+			break
+		}
+	}
+	return jarmReadServerHello(buf[:n])
+}
+
+func jarmClientHello(hostname string, probe jarmProbe) []byte {
+	var recordVersion, helloVersion []byte
+	switch probe.version {
+	case "TLS_1.3":
+		recordVersion, helloVersion = []byte{0x03, 0x01}, []byte{0x03, 0x03}
+	case "TLS_1.1":
+		recordVersion, helloVersion = []byte{0x03, 0x02}, []byte{0x03, 0x02}
+	default:
+		recordVersion, helloVersion = []byte{0x03, 0x03}, []byte{0x03, 0x03}
+	}
+
+	hello := append([]byte{}, helloVersion...)
+	hello = append(hello, jarmRandom(32)...)
+	hello = append(hello, 32)
+	hello = append(hello, jarmRandom(32)...)
+
+	ciphers := jarmCipherBytes(probe)
+	hello = binary.BigEndian.AppendUint16(hello, uint16(len(ciphers)))
+	hello = append(hello, ciphers...)
+	// One compression method: null
+	hello = append(hello, 0x01, 0x00)
+	hello = append(hello, jarmExtensions(hostname, probe)...)
+
+	handshake := []byte{0x01, 0x00}
+	handshake = binary.BigEndian.AppendUint16(handshake, uint16(len(hello)))
+	handshake = append(handshake, hello...)
+
+	packet := append([]byte{0x16}, recordVersion...)
+	packet = binary.BigEndian.AppendUint16(packet, uint16(len(handshake)))
+	return append(packet, handshake...)
+}
+
+func jarmCipherBytes(probe jarmProbe) []byte {
+	var ciphers []uint16
+	for _, c := range jarmCiphers {
+		if probe.ciphers == "NO1.3" && c >= 0x1301 && c <= 0x1305 {
+			continue
+		}
+		ciphers = append(ciphers, c)
+	}
+	if probe.cipherOrder != "FORWARD" {
+		ciphers = jarmMung(ciphers, probe.cipherOrder)
+	}
+	if probe.grease {
+		ciphers = append([]uint16{jarmGrease()}, ciphers...)
+	}
+
+	var b []byte
+	for _, c := range ciphers {
+		b = binary.BigEndian.AppendUint16(b, c)
+	}
+	return b
+}
+
+// jarmMung reorders a list the way the reference implementation does.
+func jarmMung[T any](list []T, order string) []T {
+	var output []T
+	n := len(list)
+	switch order {
+	case "REVERSE":
+		for i := n - 1; i >= 0; i-- {
+			output = append(output, list[i])
+		}
+	case "BOTTOM_HALF":
+		if n%2 == 1 {
+			output = append(output, list[n/2+1:]...)
+		} else {
+			output = append(output, list[n/2:]...)
+		}
+	case "TOP_HALF":
+		if n%2 == 1 {
+			output = append(output, list[n/2])
+		}
+		output = append(output, jarmMung(jarmMung(list, "REVERSE"), "BOTTOM_HALF")...)
+	case "MIDDLE_OUT":
+		middle := n / 2
+		if n%2 == 1 {
+			output = append(output, list[middle])
+			for i := 1; i <= middle; i++ {
+				output = append(output, list[middle+i], list[middle-i])
+			}
+		} else {
+			for i := 1; i <= middle; i++ {
+				output = append(output, list[middle-1+i], list[middle-i])
+			}
+		}
+	}
+	return output
+}
+
+func jarmExtensions(hostname string, probe jarmProbe) []byte {
+	var ext []byte
+	if probe.grease {
+		ext = binary.BigEndian.AppendUint16(ext, jarmGrease())
+		ext = append(ext, 0x00, 0x00)
+	}
+
+	// server_name
+	ext = append(ext, 0x00, 0x00)
+	ext = binary.BigEndian.AppendUint16(ext, uint16(len(hostname)+5))
+	ext = binary.BigEndian.AppendUint16(ext, uint16(len(hostname)+3))
+	ext = append(ext, 0x00)
+	ext = binary.BigEndian.AppendUint16(ext, uint16(len(hostname)))
+	ext = append(ext, hostname...)
+
+	ext = append(ext, 0x00, 0x17, 0x00, 0x00)                                                             // extended_master_secret
+	ext = append(ext, 0x00, 0x01, 0x00, 0x01, 0x01)                                                       // max_fragment_length
+	ext = append(ext, 0xff, 0x01, 0x00, 0x01, 0x00)                                                       // renegotiation_info
+	ext = append(ext, 0x00, 0x0a, 0x00, 0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00, 0x18, 0x00, 0x19) // supported_groups
+	ext = append(ext, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00)                                                 // ec_point_formats
+	ext = append(ext, 0x00, 0x23, 0x00, 0x00)                                                             // session_ticket
+
+	// application_layer_protocol_negotiation
+	alpns := jarmALPNs
+	if probe.rareALPN {
+		alpns = jarmRareALPNs
+	}
+	if probe.extensionOrder != "FORWARD" {
+		alpns = jarmMung(alpns, probe.extensionOrder)
+	}
+	var alpnList []byte
+	for _, alpn := range alpns {
+		alpnList = append(alpnList, byte(len(alpn)))
+		alpnList = append(alpnList, alpn...)
+	}
+	ext = append(ext, 0x00, 0x10)
+	ext = binary.BigEndian.AppendUint16(ext, uint16(len(alpnList)+2))
+	ext = binary.BigEndian.AppendUint16(ext, uint16(len(alpnList)))
+	ext = append(ext, alpnList...)
+
+	// signature_algorithms
+	ext = append(ext, 0x00, 0x0d, 0x00, 0x14, 0x00, 0x12, 0x04, 0x03, 0x08, 0x04, 0x04, 0x01,
+		0x05, 0x03, 0x08, 0x05, 0x05, 0x01, 0x08, 0x06, 0x06, 0x01, 0x02, 0x01)
+
+	// key_share
+	var share []byte
+	if probe.grease {
+		share = binary.BigEndian.AppendUint16(share, jarmGrease())
+		share = append(share, 0x00, 0x01, 0x00)
+	}
+	share = append(share, 0x00, 0x1d, 0x00, 0x20)
+	share = append(share, jarmRandom(32)...)
+	ext = append(ext, 0x00, 0x33)
+	ext = binary.BigEndian.AppendUint16(ext, uint16(len(share)+2))
+	ext = binary.BigEndian.AppendUint16(ext, uint16(len(share)))
+	ext = append(ext, share...)
+
+	ext = append(ext, 0x00, 0x2d, 0x00, 0x02, 0x01, 0x01) // psk_key_exchange_modes
+
+	// supported_versions
+	if probe.version == "TLS_1.3" || probe.support == "1.2_SUPPORT" {
+		versions := []uint16{0x0301, 0x0302, 0x0303}
+		if probe.support != "1.2_SUPPORT" {
+			versions = append(versions, 0x0304)
+		}
+		if probe.extensionOrder != "FORWARD" {
+			versions = jarmMung(versions, probe.extensionOrder)
+		}
+		var list []byte
+		if probe.grease {
+			list = binary.BigEndian.AppendUint16(list, jarmGrease())
+		}
+		for _, v := range versions {
+			list = binary.BigEndian.AppendUint16(list, v)
+		}
+		ext = append(ext, 0x00, 0x2b)
+		ext = binary.BigEndian.AppendUint16(ext, uint16(len(list)+1))
+		ext = append(ext, byte(len(list)))
+		ext = append(ext, list...)
+	}
+
+	return append(binary.BigEndian.AppendUint16(nil, uint16(len(ext))), ext...)
+}
+
+// jarmReadServerHello extracts the selected cipher, version, ALPN and
+// extension types from a server hello as "cipher|version|alpn|extensions".
+func jarmReadServerHello(data []byte) string {
+	if len(data) < 44 || data[0] != 0x16 || data[5] != 0x02 {
+		return "|||"
+	}
+
+	serverHelloLength := int(binary.BigEndian.Uint16(data[3:5]))
+	counter := int(data[43])
+	if len(data) < counter+46 {
+		return "|||"
+	}
+	cipher := hex.EncodeToString(data[counter+44 : counter+46])
+	version := hex.EncodeToString(data[9:11])
+	return fmt.Sprintf("%s|%s|%s", cipher, version, jarmExtensionInfo(data, counter, serverHelloLength))
+}
+
+func jarmExtensionInfo(data []byte, counter int, serverHelloLength int) string {
+	if len(data) < counter+49 || data[counter+47] == 11 {
+		return "|"
+	}
+	if len(data) >= counter+53 && string(data[counter+50:counter+53]) == "\x0e\xac\x0b" {
+		return "|"
+	}
+	if len(data) >= 85 && string(data[82:85]) == "\x0f\xf0\x0b" {
+		return "|"
+	}
+	if counter+42 >= serverHelloLength {
+		return "|"
+	}
+
+	count := 49 + counter
+	length := int(binary.BigEndian.Uint16(data[counter+47 : counter+49]))
+	maximum := length + count - 1
+	var types []string
+	alpn := ""
+	for count < maximum {
+		if count+4 > len(data) {
+			return "|"
+		}
+		extType := data[count : count+2]
+		extLength := int(binary.BigEndian.Uint16(data[count+2 : count+4]))
+		if count+4+extLength > len(data) {
+			return "|"
+		}
+		value := data[count+4 : count+4+extLength]
+		if string(extType) == "\x00\x10" && alpn == "" && len(value) > 3 {
+			alpn = string(value[3:])
+		}
+		types = append(types, hex.EncodeToString(extType))
+		count += extLength + 4
+	}
+	return alpn + "|" + strings.Join(types, "-")
+}
+
+func jarmHash(raw []string) string {
+	allEmpty := true
+	for _, r := range raw {
+		if r != "|||" {
+			allEmpty = false
+			break
+		}
+	}
+	if allEmpty {
+		return emptyJARM
+	}
+
+	var fuzzy, alpnsAndExtensions strings.Builder
+	for _, handshake := range raw {
+		components := strings.Split(handshake, "|")
+		fuzzy.WriteString(jarmCipherByte(components[0]))
+		fuzzy.WriteString(jarmVersionByte(components[1]))
+		alpnsAndExtensions.WriteString(components[2])
+		alpnsAndExtensions.WriteString(components[3])
+	}
+	sum := sha256.Sum256([]byte(alpnsAndExtensions.String()))
+	fuzzy.WriteString(hex.EncodeToString(sum[:])[:32])
+	return fuzzy.String()
+}
+
+func jarmCipherByte(cipher string) string {
+	if cipher == "" {
+		return "00"
+	}
+	count := 1
+	for _, c := range jarmCipherIndex {
+		if cipher == fmt.Sprintf("%04x", c) {
+			break
+		}
+		count++
+	}
+	return fmt.Sprintf("%02x", count)
+}
+
+func jarmVersionByte(version string) string {
+	if len(version) < 4 {
+		return "0"
+	}
+	i := int(version[3] - '0')
+	if i < 0 || i > 5 {
+		return "0"
+	}
+	return string("abcdef"[i])
+}
+
+func jarmGrease() uint16 {
+	b := jarmRandom(1)
+	n := uint16(b[0]%16)<<4 | 0x0a
+	return n<<8 | n
+}
+
+func jarmRandom(n int) []byte {
+	b := make([]byte, n)
+	rand.Read(b)
+	return b
+}
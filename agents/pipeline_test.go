@@ -0,0 +1,255 @@
+package agents
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+)
+
+const testPageHTML = `<!DOCTYPE html>
+<html>
+<head><title>  Acme Admin Login </title></head>
+<body>
+<form method="post" action="/login">
+<input type="text" name="username">
+<input type="password" name="password">
+</form>
+</body>
+</html>`
+
+// newTestSession returns a started session that writes to a temporary
+// directory, as NewSession returns it for a scan run with args. Chrome is
+// never started by the agents the tests use, so any existing file does for
+// its path.
+func newTestSession(t *testing.T, args ...string) *core.Session {
+	t.Helper()
+	outDir := t.TempDir()
+	chrome := filepath.Join(outDir, "chrome")
+	if err := os.WriteFile(chrome, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	osArgs := os.Args
+	defer func() { os.Args = osArgs }()
+	os.Args = append([]string{"aquatone", "--out", outDir, "--chrome-path", chrome, "--silent", "--threads", "4"}, args...)
+
+	sess, err := core.NewSession()
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+	return sess
+}
+
+// waitForAgents waits until the agents have processed the given number of
+// items each. Agents publish events for each other from their goroutines,
+// so waiting on the event bus and wait group alone can return before the
+// last of them got its event.
+func waitForAgents(t *testing.T, sess *core.Session, items map[string]int) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		processed := make(map[string]int)
+		for _, timing := range sess.SortedAgentTimings() {
+			processed[timing.Agent] = timing.Items
+		}
+		done := true
+		for agent, n := range items {
+			if processed[agent] < n {
+				done = false
+			}
+		}
+		if done {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("agents processed %v items; want %v", processed, items)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	sess.EventBus.WaitAsync()
+	sess.WaitGroup.Wait()
+}
+
+// recordingServer is a test server that records the User-Agent headers of
+// the requests it gets.
+type recordingServer struct {
+	*httptest.Server
+	sync.Mutex
+	userAgents []string
+}
+
+func newRecordingServer(t *testing.T) *recordingServer {
+	s := &recordingServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Lock()
+		s.userAgents = append(s.userAgents, r.UserAgent())
+		s.Unlock()
+		w.Header().Set("Server", "nginx/1.25.3")
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(testPageHTML))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestPipelineRequestsAndAnalyzesPages(t *testing.T) {
+	server := newRecordingServer(t)
+	sess := newTestSession(t)
+	NewURLRequester().Register(sess)
+	NewURLPageTitleExtractor().Register(sess)
+	NewURLFormExtractor().Register(sess)
+
+	url := server.URL + "/"
+	sess.EventBus.Publish(core.URL, url)
+	waitForAgents(t, sess, map[string]int{NewURLRequester().ID(): 1, NewURLPageTitleExtractor().ID(): 1, NewURLFormExtractor().ID(): 1})
+
+	page := sess.GetPage(url)
+	if page == nil {
+		t.Fatalf("no page for %s in session", url)
+	}
+	if page.Status != "200 OK" {
+		t.Errorf("Status = %q; want %q", page.Status, "200 OK")
+	}
+	if page.PageTitle != "Acme Admin Login" {
+		t.Errorf("PageTitle = %q; want %q", page.PageTitle, "Acme Admin Login")
+	}
+	if !page.HasHeader("Server") {
+		t.Errorf("Server header missing from %v", page.Headers)
+	}
+	if len(page.Forms) != 1 || !page.Forms[0].HasPassword {
+		t.Errorf("Forms = %+v; want one login form", page.Forms)
+	}
+	if sess.Stats.RequestSuccessful != 1 || sess.Stats.ResponseCode2xx != 1 {
+		t.Errorf("Stats = %+v; want one successful 2xx request", sess.Stats)
+	}
+
+	body, err := sess.ReadFile(page.BodyPath)
+	if err != nil {
+		t.Fatalf("body of page not saved: %v", err)
+	}
+	if string(body) != testPageHTML {
+		t.Errorf("saved body = %q; want %q", body, testPageHTML)
+	}
+	headers, err := sess.ReadFile(page.HeadersPath)
+	if err != nil {
+		t.Fatalf("headers of page not saved: %v", err)
+	}
+	if !strings.Contains(string(headers), "Server: nginx/1.25.3") {
+		t.Errorf("saved headers = %q; want the Server header", headers)
+	}
+	request, err := sess.ReadFile(page.RequestPath)
+	if err != nil {
+		t.Fatalf("request of page not saved: %v", err)
+	}
+	for _, want := range []string{"GET / HTTP/1.1\r\n", "Host: " + server.Listener.Addr().String() + "\r\n", "User-Agent: " + server.userAgents[0] + "\r\n"} {
+		if !strings.Contains(string(request), want) {
+			t.Errorf("saved request = %q; want it to contain %q as sent", request, want)
+		}
+	}
+}
+
+func TestPipelineWritesSessionAndReport(t *testing.T) {
+	server := newRecordingServer(t)
+	sess := newTestSession(t)
+	NewURLRequester().Register(sess)
+	NewURLPageTitleExtractor().Register(sess)
+
+	url := server.URL + "/"
+	sess.EventBus.Publish(core.URL, url)
+	waitForAgents(t, sess, map[string]int{NewURLRequester().ID(): 1, NewURLPageTitleExtractor().ID(): 1})
+	sess.End()
+
+	if err := sess.SaveToFile(sess.SessionFilename()); err != nil {
+		t.Fatalf("SaveToFile() failed: %v", err)
+	}
+	loaded, err := core.LoadSession(sess.GetFilePath(sess.SessionFilename()))
+	if err != nil {
+		t.Fatalf("LoadSession() failed: %v", err)
+	}
+	// Loaded sessions have no options to normalize URLs with
+	page := loaded.Pages[url]
+	if page == nil || page.PageTitle != "Acme Admin Login" {
+		t.Fatalf("page %s not in written session: %+v", url, loaded.Pages)
+	}
+
+	template, err := sess.Asset("static/report_template.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report bytes.Buffer
+	if err := core.NewReport(sess, string(template)).Render(&report); err != nil {
+		t.Fatalf("Render() failed: %v", err)
+	}
+	for _, want := range []string{url, "Acme Admin Login"} {
+		if !strings.Contains(report.String(), want) {
+			t.Errorf("report does not contain %q", want)
+		}
+	}
+}
+
+// redirectDialer connects to addr whatever address is dialed.
+type redirectDialer struct {
+	addr   string
+	dialed []string
+	sync.Mutex
+}
+
+func (d *redirectDialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	d.Lock()
+	d.dialed = append(d.dialed, address)
+	d.Unlock()
+	return (&net.Dialer{}).DialContext(ctx, network, d.addr)
+}
+
+func TestPipelineUsesSessionDialer(t *testing.T) {
+	server := newRecordingServer(t)
+	sess := newTestSession(t)
+	dialer := &redirectDialer{addr: server.Listener.Addr().String()}
+	sess.Dialer = dialer
+	NewURLRequester().Register(sess)
+
+	url := "http://intranet.example.test/"
+	sess.EventBus.Publish(core.URL, url)
+	waitForAgents(t, sess, map[string]int{NewURLRequester().ID(): 1})
+
+	if page := sess.GetPage(url); page == nil || page.Status != "200 OK" {
+		t.Fatalf("page %s not requested through the session dialer: %+v", url, page)
+	}
+	if len(dialer.dialed) == 0 || dialer.dialed[0] != "intranet.example.test:80" {
+		t.Errorf("dialed %v; want intranet.example.test:80", dialer.dialed)
+	}
+}
+
+func TestSeedMakesUserAgentsReproducible(t *testing.T) {
+	userAgents := func() []string {
+		server := newRecordingServer(t)
+		sess := newTestSession(t, "--seed", "42")
+		NewURLRequester().Register(sess)
+		// One at a time, so the requests pick user agents in order
+		for i, path := range []string{"/a", "/b", "/c"} {
+			sess.EventBus.Publish(core.URL, server.URL+path)
+			waitForAgents(t, sess, map[string]int{NewURLRequester().ID(): i + 1})
+		}
+		return server.userAgents
+	}
+
+	first, second := userAgents(), userAgents()
+	if len(first) != 3 {
+		t.Fatalf("got %d requests; want 3", len(first))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("user agent %d differs between scans with the same seed: %q and %q", i, first[i], second[i])
+		}
+	}
+}
@@ -0,0 +1,15 @@
+//go:build linux
+
+package agents
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcAttr makes the kernel kill processes started for screenshots, like
+// Chrome and Xvfb, when Aquatone exits, so they don't outlive a scan that is
+// interrupted.
+func setProcAttr(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Pdeathsig: syscall.SIGKILL}
+}
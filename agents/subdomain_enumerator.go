@@ -0,0 +1,66 @@
+package agents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// crtshTimeout is the timeout for certificate transparency lookups, which
+// take a lot longer than requests to targets for popular domains.
+const crtshTimeout = 60 * time.Second
+
+// EnumerateSubdomains looks up the names under a domain in certificate
+// transparency logs through crt.sh, for expanding wildcard entries like
+// *.example.com in the input with --expand-wildcards. Wildcard names in
+// certificates are returned as is.
+func EnumerateSubdomains(s *core.Session, domain string) ([]string, error) {
+	request := Gorequest(s)
+	request.Transport.Dial = func(network, address string) (net.Conn, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), crtshTimeout)
+		defer cancel()
+		conn, err := s.Dialer.DialContext(ctx, network, address)
+		if err != nil {
+			return nil, err
+		}
+		conn.SetDeadline(s.Clock.Now().Add(crtshTimeout))
+		return conn, nil
+	}
+
+	resp, body, errs := request.Timeout(crtshTimeout).
+		Get("https://crt.sh/?output=json&q="+url.QueryEscape("%."+domain)).
+		Set("User-Agent", RandomUserAgent(s)).EndBytes()
+	if errs != nil {
+		return nil, errs[0]
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("crt.sh responded with %s", resp.Status)
+	}
+
+	var entries []struct {
+		NameValue string `json:"name_value"`
+	}
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		for _, name := range strings.Fields(entry.NameValue) {
+			name = strings.ToLower(name)
+			if seen[name] || !strings.HasSuffix(name, "."+domain) {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
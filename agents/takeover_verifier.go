@@ -0,0 +1,143 @@
+package agents
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// takeoverVerdict is the outcome of verifying a takeover fingerprint match
+// with --verify-takeover.
+type takeoverVerdict int
+
+const (
+	// takeoverUnverified means there is no way to verify the match for the
+	// service, or the verification could not be completed.
+	takeoverUnverified takeoverVerdict = iota
+	// takeoverConfirmed means the resource the hostname points to can be
+	// claimed.
+	takeoverConfirmed
+	// takeoverRefuted means the resource exists, so the fingerprint match is
+	// most likely a false positive.
+	takeoverRefuted
+)
+
+// takeoverVerifier performs the service specific steps to verify that the
+// resource a page's hostname points to can be claimed, and returns the
+// verdict with a short reason.
+type takeoverVerifier func(p *core.Page, cname string) (takeoverVerdict, string)
+
+// reportTakeover tags a page that matched the takeover fingerprint of a
+// service and records the finding for the takeovers section of the report.
+// fingerprint is the part of the response body that matched. With
+// --verify-takeover, the match is verified first: confirmed takeovers get an
+// extra Takeover Verified tag and refuted ones are tagged as a possible
+// takeover with a warning instead. verify can be nil for services without a
+// way to verify.
+func (a *URLTakeoverDetector) reportTakeover(p *core.Page, service string, link string, cname string, fingerprint string, verify takeoverVerifier) {
+	verdict, reason := takeoverUnverified, ""
+	if *a.session.Options.VerifyTakeover {
+		verdict, reason = a.verifyTakeover(p, cname, verify)
+		a.session.Out.Debug("[%s] Takeover verification for %s on %s: %v (%s)\n", a.ID(), p.URL, service, verdict, reason)
+	}
+
+	evidence := fingerprint
+	if evidence == "" {
+		evidence = "empty response body"
+	}
+	takeover := core.Takeover{
+		Service:      service,
+		CNAME:        strings.TrimSuffix(cname, "."),
+		Evidence:     evidence,
+		Confidence:   core.TakeoverUnverified,
+		Verification: reason,
+		Link:         link,
+	}
+
+	switch verdict {
+	case takeoverConfirmed:
+		takeover.Confidence = core.TakeoverConfirmed
+		p.AddTag("Domain Takeover", "danger", link)
+		p.AddTag("Takeover Verified", "danger", link)
+		a.session.Out.Warn("%s: vulnerable to takeover on %s (verified: %s)\n", p.URL, service, reason)
+	case takeoverRefuted:
+		takeover.Confidence = core.TakeoverRefuted
+		p.AddTag("Possible Domain Takeover", "warning", link)
+		a.session.Out.Info("%s: matched takeover fingerprint of %s, but verification failed: %s\n", p.URL, service, reason)
+	default:
+		p.AddTag("Domain Takeover", "danger", link)
+		a.session.Out.Warn("%s: vulnerable to takeover on %s\n", p.URL, service)
+	}
+	p.SetTakeover(takeover)
+}
+
+// verifyTakeover checks whether the CNAME target of the page's hostname no
+// longer exists, which can be claimed on any service, and otherwise runs the
+// service specific verifier.
+func (a *URLTakeoverDetector) verifyTakeover(p *core.Page, cname string, verify takeoverVerifier) (takeoverVerdict, string) {
+	target := strings.TrimSuffix(cname, ".")
+	if target != "" && !strings.EqualFold(target, p.ParsedURL().Hostname()) {
+		if _, err := a.session.LookupHost(cname); err != nil {
+			if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.IsNotFound {
+				return takeoverConfirmed, fmt.Sprintf("CNAME target %s does not exist", target)
+			}
+		}
+	}
+
+	if verify == nil {
+		return takeoverUnverified, "no verification available"
+	}
+	return verify(p, cname)
+}
+
+// verifyAmazonS3 asks the S3 API whether the bucket for the hostname exists.
+// Buckets used for website hosting with a custom domain must be named after
+// the domain.
+func (a *URLTakeoverDetector) verifyAmazonS3(p *core.Page, cname string) (takeoverVerdict, string) {
+	bucket := p.ParsedURL().Hostname()
+	resp, body, errs := Gorequest(a.session).Get("https://s3.amazonaws.com/"+bucket).
+		Set("User-Agent", RandomUserAgent(a.session)).End()
+	if errs != nil {
+		return takeoverUnverified, fmt.Sprintf("S3 API request failed: %v", errs[0])
+	}
+	if strings.Contains(body, "NoSuchBucket") {
+		return takeoverConfirmed, fmt.Sprintf("bucket %s does not exist", bucket)
+	}
+	return takeoverRefuted, fmt.Sprintf("bucket %s exists (S3 API responded with %s)", bucket, resp.Status)
+}
+
+// verifyServiceName returns a verifier for services where the hostname points
+// to a subdomain of the service named after the account, like
+// example.ghost.io. The subdomain is requested directly, and if it shows the
+// same fingerprint the name is unclaimed and can be registered. CNAME targets
+// outside of the given suffix can't be verified.
+func (a *URLTakeoverDetector) verifyServiceName(suffix string, fingerprint string) takeoverVerifier {
+	return func(p *core.Page, cname string) (takeoverVerdict, string) {
+		if !strings.HasSuffix(cname, suffix) {
+			return takeoverUnverified, fmt.Sprintf("CNAME target %s is not a %s name", strings.TrimSuffix(cname, "."), strings.Trim(suffix, "."))
+		}
+		name := strings.TrimSuffix(cname, ".")
+		_, body, errs := Gorequest(a.session).Get("https://"+name+"/").
+			Set("User-Agent", RandomUserAgent(a.session)).End()
+		if errs != nil {
+			return takeoverUnverified, fmt.Sprintf("request to %s failed: %v", name, errs[0])
+		}
+		if strings.Contains(body, fingerprint) {
+			return takeoverConfirmed, fmt.Sprintf("%s is unclaimed", name)
+		}
+		return takeoverRefuted, fmt.Sprintf("%s is in use", name)
+	}
+}
+
+func (v takeoverVerdict) String() string {
+	switch v {
+	case takeoverConfirmed:
+		return "confirmed"
+	case takeoverRefuted:
+		return "refuted"
+	default:
+		return "unverified"
+	}
+}
@@ -4,16 +4,27 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/mk990/aquatone/core"
 )
 
+// happyEyeballsDelay is how long to wait for a connection attempt before
+// also trying the next resolved address of a host.
+const happyEyeballsDelay = 250 * time.Millisecond
+
+// maxBannerSize limits how much of the banner of a service is read.
+const maxBannerSize = 256
+
 // TCPPortScanner is responsible for scanning TCP ports on discovered hosts
 type TCPPortScanner struct {
-	session    *core.Session
-	scanWorker chan struct{} // semaphore for limiting concurrent scans
+	session      *core.Session
+	scanWorker   chan struct{} // semaphore for limiting concurrent scans
+	interference *interferenceDetector
 }
 
 // NewTCPPortScanner creates a new TCP port scanner agent
@@ -28,7 +39,7 @@ func (d *TCPPortScanner) ID() string {
 
 // Register registers the agent with the session
 func (a *TCPPortScanner) Register(s *core.Session) error {
-	s.EventBus.SubscribeAsync(core.Host, a.OnHost, false)
+	s.EventBus.SubscribeAsync(core.TargetHost, a.OnHost, false)
 	a.session = s
 	
 	// Initialize worker pool with configurable size
@@ -38,6 +49,8 @@ func (a *TCPPortScanner) Register(s *core.Session) error {
 		concurrentScans = *a.session.Options.Threads
 	}
 	a.scanWorker = make(chan struct{}, concurrentScans)
+	a.interference = newInterferenceDetector()
+	a.session.Out.Debug("[%s] Connect timeout %v, read timeout %v, %d concurrent scans\n", a.ID(), a.connectTimeout(), a.readTimeout(), concurrentScans)
 	
 	return nil
 }
@@ -47,52 +60,99 @@ func (a *TCPPortScanner) OnHost(host string) {
 	a.session.Out.Debug("[%s] Received new host: %s\n", a.ID(), host)
 	
 	// Resolve the host first to ensure it exists and to get IP addresses
-	ips, err := net.LookupHost(host)
+	ips, err := a.session.LookupHost(host)
+	if err == nil {
+		a.session.WaitGroup.Add()
+		go func() {
+			defer a.session.WaitGroup.Done()
+			recordHostIPs(a.session, host, ips)
+		}()
+	}
+	if err != nil && a.session.Tunneled() {
+		// Hosts of internal networks behind the jump host are resolved by it
+		a.session.Out.Debug("[%s] Leaving resolution of %s to the jump host: %v\n", a.ID(), host, err)
+		a.session.AddHostNote(host, "Resolved by the jump host")
+		ips, err = []string{host}, nil
+	}
 	if err != nil {
 		a.session.Out.Error("[%s] Failed to resolve host %s: %v\n", a.ID(), host, err)
+		a.session.AddFailure(host, a.ID(), core.ReasonDNS, err)
+		a.session.AddHostNote(host, fmt.Sprintf("Failed to resolve: %v", err))
 		return
 	}
 	
 	a.session.Out.Debug("[%s] Successfully resolved %s to %v\n", a.ID(), host, ips)
+	ips = happyEyeballsOrder(ips)
 	
 	var wg sync.WaitGroup
 	for _, port := range a.session.Ports {
+		release := a.session.AcquireHostSlot(host)
 		a.session.WaitGroup.Add()
 		wg.Add(1)
 		
 		go func(port int, host string) {
 			defer a.session.WaitGroup.Done()
 			defer wg.Done()
+			defer release()
 			
 			// Acquire worker slot
 			a.scanWorker <- struct{}{}
 			defer func() { <-a.scanWorker }()
+			defer a.session.TrackAgent(a.ID())()
 			
-			// Create context with timeout
-			timeout := time.Duration(*a.session.Options.ScanTimeout) * time.Millisecond
-			if timeout < 5*time.Second {
-				// Ensure minimum timeout is reasonably long
-				timeout = 5 * time.Second
+			if a.session.HostQuarantined(host) {
+				a.session.Out.Debug("[%s] Skipping port %d of quarantined host %s\n", a.ID(), port, host)
+				return
 			}
-			ctx, cancel := context.WithTimeout(context.Background(), timeout)
-			defer cancel()
 			
-			// Try multiple times for reliability
+			// Try multiple times for reliability, and harder when the network
+			// appears to be interfering with the scan
+			maxAttempts := 2
+			if a.interference.Slowdown() {
+				maxAttempts = 4
+			}
 			success := false
-			for attempts := 0; attempts < 2 && !success; attempts++ {
+			var addrs []string
+			var banner string
+			for attempts := 0; attempts < maxAttempts && !success && !a.session.HostQuarantined(host); attempts++ {
 				if attempts > 0 {
 					a.session.Out.Debug("[%s] Retrying port %d on %s (attempt %d)\n", a.ID(), port, host, attempts+1)
-					time.Sleep(500 * time.Millisecond) // Short delay between retries
+					time.Sleep(time.Duration(attempts) * 500 * time.Millisecond) // Back off between retries
 				}
+				time.Sleep(a.interference.Delay())
 				
-				if a.scanPort(ctx, port, host) {
+				ctx, cancel := context.WithTimeout(context.Background(), a.connectTimeout())
+				answered, received, err := a.scanPort(ctx, port, host, ips)
+				cancel()
+				if err == nil {
+					addrs, banner = answered, received
 					success = true
 				}
+				if a.session.RecordHostResult(host, core.ClassifyError(err)) {
+					a.session.Out.Warn("%s: quarantined after %d consecutive timeouts or resets, skipping its remaining ports\n", host, *a.session.Options.QuarantineAfter)
+					a.session.AddHostNote(host, fmt.Sprintf("Quarantined after %d consecutive timeouts or resets", *a.session.Options.QuarantineAfter))
+				}
+				if a.interference.Record(core.ClassifyError(err)) {
+					a.session.Out.Warn("Widespread timeouts and connection resets detected, possibly rate limiting or IDS interference. Slowing down port scans (%v delay per connection)\n", a.interference.Delay())
+				}
 			}
 			
 			if success {
 				a.session.Stats.IncrementPortOpen()
-				a.session.Out.Info("%s: port %s %s\n", host, Green(fmt.Sprintf("%d", port)), Green("open"))
+				a.session.SetPortAddrs(host, port, addrs)
+				a.session.AddHostPort(host, port)
+				if banner != "" {
+					a.session.Out.Debug("[%s] Port %d on %s sent banner %q\n", a.ID(), port, host, banner)
+					a.session.AddHostService(host, core.HostService{Port: port, Name: serviceFromBanner(banner), Banner: banner})
+				}
+				if len(ips) > 1 || ips[0] != host {
+					a.session.Out.Info("%s: port %s %s (%s)\n", host, Green(fmt.Sprintf("%d", port)), Green("open"), strings.Join(addrs, ", "))
+					if len(addrs) < len(ips) {
+						a.session.Out.Debug("[%s] Port %d is only open on %d of %d addresses of %s\n", a.ID(), port, len(addrs), len(ips), host)
+					}
+				} else {
+					a.session.Out.Info("%s: port %s %s\n", host, Green(fmt.Sprintf("%d", port)), Green("open"))
+				}
 				a.session.EventBus.Publish(core.TCPPort, port, host)
 			} else {
 				a.session.Stats.IncrementPortClosed()
@@ -108,47 +168,156 @@ func (a *TCPPortScanner) OnHost(host string) {
 	}()
 }
 
-// scanPort attempts to connect to a specific port on a host with context-based timeout
-func (a *TCPPortScanner) scanPort(ctx context.Context, port int, host string) bool {
-	// Increase the default timeout for the connection
-	timeout := time.Duration(*a.session.Options.ScanTimeout) * time.Millisecond
-	if timeout < 5*time.Second {
-		// Ensure minimum timeout is reasonably long for internet connections
-		timeout = 5 * time.Second
+// connectTimeout returns the timeout for connecting to a port, given with
+// --connect-timeout.
+func (a *TCPPortScanner) connectTimeout() time.Duration {
+	return time.Duration(*a.session.Options.ConnectTimeout) * time.Millisecond
+}
+
+// readTimeout returns how long to wait for data on a port that accepted the
+// connection, given with --port-read-timeout.
+func (a *TCPPortScanner) readTimeout() time.Duration {
+	return time.Duration(*a.session.Options.PortReadTimeout) * time.Millisecond
+}
+
+// scanPort attempts to connect to a specific port on a host with context-based timeout.
+// The resolved addresses of the host are dialed individually, staggered happy eyeballs
+// style, and every address is tried so load balanced hostnames where only some
+// backends expose the port are reported accurately. It returns the addresses that
+// accepted the connection, and the banner the service sent, if any.
+func (a *TCPPortScanner) scanPort(ctx context.Context, port int, host string, addrs []string) ([]string, string, error) {
+	timeout := a.connectTimeout()
+	type dialResult struct {
+		addr   string
+		banner string
+		err    error
 	}
-	
-	// Create a dialer with configurable options
-	dialer := &net.Dialer{
-		Timeout:   timeout,
-		KeepAlive: 0, // No keep-alive for port scans
-		DualStack: true, // Enable both IPv4 and IPv6
+	results := make(chan dialResult, len(addrs))
+	next, pending := 0, 0
+	dialNext := func() {
+		addr := addrs[next]
+		next++
+		pending++
+		target := net.JoinHostPort(addr, strconv.Itoa(port))
+		a.session.Out.Debug("[%s] Attempting to connect to %s (%s) with timeout %v\n", a.ID(), target, host, timeout)
+		go func() {
+			conn, err := a.session.Dialer.DialContext(ctx, "tcp", target)
+			var banner string
+			if err == nil {
+				// Try to read to confirm the connection is truly established
+				// Some firewalls might allow the initial handshake but drop subsequent packets
+				// Services that speak first, unlike HTTP, send their banner
+				if readTimeout := a.readTimeout(); readTimeout > 0 {
+					buf := make([]byte, maxBannerSize)
+					conn.SetReadDeadline(a.session.Clock.Now().Add(readTimeout))
+					n, _ := conn.Read(buf)
+					banner = cleanBanner(buf[:n])
+				}
+				// It's OK if we can't read (connection refused, etc), we just need to verify the connection
+				conn.Close()
+			}
+			results <- dialResult{addr, banner, err}
+		}()
 	}
 	
-	// Use DialContext for better timeout control
-	target := fmt.Sprintf("%s:%d", host, port)
-	a.session.Out.Debug("[%s] Attempting to connect to %s with timeout %v\n", a.ID(), target, timeout)
+	dialNext()
+	stagger := time.NewTicker(happyEyeballsDelay)
+	defer stagger.Stop()
 	
-	conn, err := dialer.DialContext(ctx, "tcp", target)
-	if err != nil {
-		// Check if it's a timeout error
-		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-			a.session.Out.Debug("[%s] Timeout scanning port %d on %s\n", a.ID(), port, host)
-		} else {
-			a.session.Out.Debug("[%s] Error scanning port %d on %s: %v\n", a.ID(), port, host, err)
+	var answered []string
+	var banner string
+	var lastErr error
+	for pending > 0 {
+		select {
+		case <-stagger.C:
+			if next < len(addrs) {
+				dialNext()
+			}
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				answered = append(answered, r.addr)
+				if banner == "" {
+					banner = r.banner
+				}
+			} else {
+				// Check if it's a timeout error
+				if netErr, ok := r.err.(net.Error); ok && netErr.Timeout() {
+					a.session.Out.Debug("[%s] Timeout scanning port %d on %s (%s)\n", a.ID(), port, host, r.addr)
+				} else {
+					a.session.Out.Debug("[%s] Error scanning port %d on %s (%s): %v\n", a.ID(), port, host, r.addr, r.err)
+				}
+				lastErr = r.err
+			}
+			// Move on to the next address right away instead of waiting
+			if next < len(addrs) {
+				dialNext()
+			}
 		}
-		return false
 	}
 	
-	if conn != nil {
-		defer conn.Close()
-		// Try to read a byte to confirm the connection is truly established
-		// Some firewalls might allow the initial handshake but drop subsequent packets
-		one := make([]byte, 1)
-		conn.SetReadDeadline(time.Now().Add(1 * time.Second))
-		_, err = conn.Read(one)
-		// It's OK if we can't read (connection refused, etc), we just need to verify the connection
-		return true
+	if len(answered) > 0 {
+		return answered, banner, nil
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no addresses to connect to for %s", host)
+	}
+	return nil, "", lastErr
+}
+
+// cleanBanner returns the first line of a banner, or an empty string if it
+// isn't text, like the handshake of a database server.
+func cleanBanner(data []byte) string {
+	line := strings.TrimSpace(strings.SplitN(string(data), "\n", 2)[0])
+	for _, r := range line {
+		if r == utf8.RuneError || (r < ' ' && r != '\t') {
+			return ""
+		}
+	}
+	return line
+}
+
+// serviceFromBanner returns the name of the service that sent a banner, or
+// an empty string if it isn't recognized.
+func serviceFromBanner(banner string) string {
+	upper := strings.ToUpper(banner)
+	switch {
+	case strings.HasPrefix(upper, "SSH-"):
+		return "ssh"
+	case strings.HasPrefix(upper, "220") && strings.Contains(upper, "SMTP"):
+		return "smtp"
+	case strings.HasPrefix(upper, "220") && strings.Contains(upper, "FTP"):
+		return "ftp"
+	case strings.HasPrefix(upper, "+OK"):
+		return "pop3"
+	case strings.HasPrefix(upper, "* OK"):
+		return "imap"
+	case strings.HasPrefix(upper, "RFB "):
+		return "vnc"
+	}
+	return ""
+}
+
+// happyEyeballsOrder interleaves IPv6 and IPv4 addresses, starting with IPv6,
+// as recommended by RFC 8305.
+func happyEyeballsOrder(addrs []string) []string {
+	var v4, v6 []string
+	for _, addr := range addrs {
+		if ip := net.ParseIP(addr); ip != nil && ip.To4() == nil {
+			v6 = append(v6, addr)
+		} else {
+			v4 = append(v4, addr)
+		}
 	}
 	
-	return false
+	ordered := make([]string, 0, len(addrs))
+	for i := 0; i < len(v4) || i < len(v6); i++ {
+		if i < len(v6) {
+			ordered = append(ordered, v6[i])
+		}
+		if i < len(v4) {
+			ordered = append(ordered, v4[i])
+		}
+	}
+	return ordered
 }
@@ -0,0 +1,96 @@
+package agents
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/mk990/aquatone/core"
+	utls "github.com/refraction-networking/utls"
+)
+
+// tlsFingerprints maps the names accepted by --tls-fingerprint, except for
+// Go's own, to the client hellos that are presented to servers.
+var tlsFingerprints = map[string]utls.ClientHelloID{
+	core.TLSFingerprintRandom: utls.HelloRandomizedNoALPN,
+	"chrome":                  utls.HelloChrome_Auto,
+	"edge":                    utls.HelloEdge_Auto,
+	"firefox":                 utls.HelloFirefox_Auto,
+	"ios":                     utls.HelloIOS_Auto,
+	"safari":                  utls.HelloSafari_Auto,
+}
+
+// fingerprintConn exposes the connection state of a uTLS connection as a
+// crypto/tls connection state, so responses still carry TLS details such as
+// the peer certificates.
+type fingerprintConn struct {
+	*utls.UConn
+}
+
+func (c fingerprintConn) ConnectionState() tls.ConnectionState {
+	state := c.UConn.ConnectionState()
+	return tls.ConnectionState{
+		Version:            state.Version,
+		HandshakeComplete:  state.HandshakeComplete,
+		DidResume:          state.DidResume,
+		CipherSuite:        state.CipherSuite,
+		NegotiatedProtocol: state.NegotiatedProtocol,
+		ServerName:         state.ServerName,
+		PeerCertificates:   state.PeerCertificates,
+		VerifiedChains:     state.VerifiedChains,
+	}
+}
+
+// fingerprintDialTLS returns a TLS dial function for the transport that
+// presents the named client TLS fingerprint instead of Go's own. Plain
+// connections are made with the transport's current Dial function.
+func fingerprintDialTLS(t *http.Transport, name string) func(network, addr string) (net.Conn, error) {
+	helloID := tlsFingerprints[name]
+	return func(network, addr string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		var conn net.Conn
+		if t.Dial != nil {
+			conn, err = t.Dial(network, addr)
+		} else {
+			conn, err = net.Dial(network, addr)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		config := &utls.Config{ServerName: host, InsecureSkipVerify: true}
+		var uconn *utls.UConn
+		if name == core.TLSFingerprintRandom {
+			uconn = utls.UClient(conn, config, helloID)
+		} else {
+			spec, err := utls.UTLSIdToSpec(helloID)
+			if err != nil {
+				conn.Close()
+				return nil, fmt.Errorf("unable to build %s TLS fingerprint: %v", name, err)
+			}
+			// Browsers offer HTTP/2, which the transport cannot speak over a
+			// custom TLS connection, so only HTTP/1.1 is negotiated.
+			for _, ext := range spec.Extensions {
+				if alpn, ok := ext.(*utls.ALPNExtension); ok {
+					alpn.AlpnProtocols = []string{"http/1.1"}
+				}
+			}
+			uconn = utls.UClient(conn, config, utls.HelloCustom)
+			if err := uconn.ApplyPreset(&spec); err != nil {
+				conn.Close()
+				return nil, fmt.Errorf("unable to apply %s TLS fingerprint: %v", name, err)
+			}
+		}
+
+		if err := uconn.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return fingerprintConn{uconn}, nil
+	}
+}
@@ -0,0 +1,18 @@
+package agents
+
+import (
+	"testing"
+
+	"github.com/mk990/aquatone/core"
+)
+
+func TestTLSFingerprintsHaveClientHellos(t *testing.T) {
+	for _, name := range core.TLSFingerprintNames {
+		if _, ok := tlsFingerprints[name]; !ok && name != core.TLSFingerprintGo {
+			t.Errorf("no client hello for --tls-fingerprint %s", name)
+		}
+	}
+	if len(tlsFingerprints) != len(core.TLSFingerprintNames)-1 {
+		t.Errorf("client hellos for %d fingerprints; want %d", len(tlsFingerprints), len(core.TLSFingerprintNames)-1)
+	}
+}
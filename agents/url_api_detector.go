@@ -0,0 +1,144 @@
+package agents
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// URLAPIDetector probes the base URL of every web server for OpenAPI
+// (Swagger) documents and GraphQL endpoints, saves the documents and GraphQL
+// schemas it finds to the api folder and lists their operations on the page
+// the base URL was found with.
+type URLAPIDetector struct {
+	session *core.Session
+	bases   sync.Map
+}
+
+func NewURLAPIDetector() *URLAPIDetector {
+	return &URLAPIDetector{}
+}
+
+func (a *URLAPIDetector) ID() string {
+	return "agent:url_api_detector"
+}
+
+func (a *URLAPIDetector) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLAPIDetector) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+	base := BaseURL(page.URL)
+	if _, probed := a.bases.LoadOrStore(base, true); probed || base == "" {
+		return
+	}
+
+	release := a.session.AcquireHostSlot(page.Hostname)
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer release()
+		defer a.session.TrackAgent(a.ID())()
+		a.probeOpenAPI(page, base)
+		a.probeGraphQL(page, base)
+	}(page)
+}
+
+// probeOpenAPI requests the common paths of OpenAPI documents. Paths that
+// serve the same document are only recorded once.
+func (a *URLAPIDetector) probeOpenAPI(page *core.Page, base string) {
+	seen := make(map[[32]byte]bool)
+	for _, path := range core.OpenAPIPaths {
+		docURL := base + path
+		resp, body, errs := PinnedGorequest(a.session).Get(docURL).
+			Set("User-Agent", RandomUserAgent(a.session)).
+			Set("Accept", "application/json").EndBytes()
+		if errs != nil {
+			a.session.Out.Debug("[%s] Error requesting %s: %v\n", a.ID(), docURL, errs[0])
+			continue
+		}
+		if resp.StatusCode != 200 {
+			continue
+		}
+		body, _ = DecodeBody(resp.Header.Get("Content-Encoding"), body)
+		doc, ok := core.ParseOpenAPI(body)
+		hash := sha256.Sum256(body)
+		if !ok || seen[hash] {
+			continue
+		}
+		seen[hash] = true
+		doc.URL = docURL
+		doc.Path = a.saveDocument(page, doc, body)
+		page.AddAPIDocument(*doc)
+		page.AddTag("OpenAPI", "info", docURL)
+		a.session.Out.Info("%s: %s\n", docURL, Green(fmt.Sprintf("%s document with %d operations", doc.Spec, len(doc.Operations))))
+	}
+}
+
+// probeGraphQL sends an introspection query to the common paths of GraphQL
+// endpoints, and stops at the first one that answers.
+func (a *URLAPIDetector) probeGraphQL(page *core.Page, base string) {
+	for _, path := range core.GraphQLPaths {
+		endpoint := base + path
+		resp, body, errs := PinnedGorequest(a.session).Post(endpoint).
+			Set("User-Agent", RandomUserAgent(a.session)).
+			Set("Accept", "application/json").
+			Type("json").
+			Send(core.GraphQLIntrospectionQuery).EndBytes()
+		if errs != nil {
+			a.session.Out.Debug("[%s] Error requesting %s: %v\n", a.ID(), endpoint, errs[0])
+			continue
+		}
+		if resp.StatusCode != 200 && resp.StatusCode != 400 {
+			continue
+		}
+		body, _ = DecodeBody(resp.Header.Get("Content-Encoding"), body)
+
+		if doc, ok := core.ParseGraphQLIntrospection(body); ok {
+			doc.URL = endpoint
+			doc.Path = a.saveDocument(page, doc, body)
+			page.AddAPIDocument(*doc)
+			page.AddTag("GraphQL Introspection", "warning", endpoint)
+			page.AddNote(fmt.Sprintf("GraphQL introspection is enabled on %s, exposing %d operations", endpoint, len(doc.Operations)), core.SeverityMedium)
+			a.session.Out.Info("%s: %s\n", endpoint, Yellow(fmt.Sprintf("GraphQL introspection enabled with %d operations", len(doc.Operations))))
+			return
+		}
+		if core.IsGraphQLError(body) {
+			page.AddAPIDocument(core.APIDocument{Type: core.APIGraphQL, URL: endpoint, Spec: "GraphQL"})
+			page.AddTag("GraphQL", "info", endpoint)
+			page.AddNote(fmt.Sprintf("GraphQL endpoint %s rejected the introspection query", endpoint), core.SeverityInfo)
+			return
+		}
+	}
+}
+
+// saveDocument writes an API document to the api folder and returns its
+// path relative to the output directory.
+func (a *URLAPIDetector) saveDocument(page *core.Page, doc *core.APIDocument, body []byte) string {
+	name := strings.NewReplacer("/", "_", ".", "_").Replace(strings.TrimPrefix(doc.URL, BaseURL(doc.URL)+"/"))
+	filepath := fmt.Sprintf("api/%s.%s.json", page.BaseFilename(), name)
+	if err := os.MkdirAll(a.session.GetFilePath("api"), 0755); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		return ""
+	}
+	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), body, 0644); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write API document %s to %s\n", doc.URL, a.session.GetFilePath(filepath))
+		return ""
+	}
+	return filepath
+}
@@ -0,0 +1,210 @@
+package agents
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mk990/aquatone/core"
+)
+
+// URLAssetHasher hashes the favicon and the main stylesheet and script of
+// pages, so unrelated hostnames serving identical assets can be grouped.
+type URLAssetHasher struct {
+	session *core.Session
+	results sync.Map
+}
+
+type assetResult struct {
+	once        sync.Once
+	sha256      string
+	faviconHash int32
+	ok          bool
+}
+
+func NewURLAssetHasher() *URLAssetHasher {
+	return &URLAssetHasher{}
+}
+
+func (a *URLAssetHasher) ID() string {
+	return "agent:url_asset_hasher"
+}
+
+func (a *URLAssetHasher) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLAssetHasher) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	release := a.session.AcquireHostSlot(page.Hostname)
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer release()
+		defer a.session.TrackAgent(a.ID())()
+		for _, asset := range a.findAssets(page) {
+			result := a.hashAsset(asset.URL)
+			if !result.ok {
+				continue
+			}
+			asset.SHA256 = result.sha256
+			if asset.Type == core.AssetFavicon {
+				asset.FaviconHash = result.faviconHash
+			}
+			page.AddAsset(asset)
+		}
+	}(page)
+}
+
+// findAssets returns the favicon and the first stylesheet and script that
+// are served from the same host as the page, or the page its client-side
+// redirects lead to. Assets from other hosts are usually shared CDN files
+// that say nothing about the backend.
+func (a *URLAssetHasher) findAssets(page *core.Page) []core.StaticAsset {
+	base, err := url.Parse(page.DestinationURL())
+	if err != nil {
+		base = page.ParsedURL()
+	}
+	favicon := "/favicon.ico"
+	var stylesheet, script string
+
+	body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+	if err == nil {
+		if doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body)); err == nil {
+			doc.Find("link[href]").EachWithBreak(func(i int, s *goquery.Selection) bool {
+				for _, rel := range strings.Fields(strings.ToLower(s.AttrOr("rel", ""))) {
+					if rel == "icon" {
+						favicon = s.AttrOr("href", favicon)
+						return false
+					}
+				}
+				return true
+			})
+			stylesheet = doc.Find("link[rel='stylesheet'][href]").First().AttrOr("href", "")
+			script = doc.Find("script[src]").First().AttrOr("src", "")
+		}
+	}
+
+	var assets []core.StaticAsset
+	for _, candidate := range []struct {
+		assetType string
+		ref       string
+	}{
+		{core.AssetFavicon, favicon},
+		{core.AssetStylesheet, stylesheet},
+		{core.AssetScript, script},
+	} {
+		if candidate.ref == "" {
+			continue
+		}
+		ref, err := url.Parse(strings.TrimSpace(candidate.ref))
+		if err != nil {
+			continue
+		}
+		u := base.ResolveReference(ref)
+		if u.Host != base.Host || (u.Scheme != "http" && u.Scheme != "https") {
+			continue
+		}
+		u.Fragment = ""
+		assets = append(assets, core.StaticAsset{Type: candidate.assetType, URL: u.String()})
+	}
+	return assets
+}
+
+// hashAsset fetches an asset once and returns its hashes.
+func (a *URLAssetHasher) hashAsset(assetURL string) *assetResult {
+	result, _ := a.results.LoadOrStore(assetURL, &assetResult{})
+	r := result.(*assetResult)
+	r.once.Do(func() {
+		resp, body, errs := PinnedGorequest(a.session).Get(assetURL).
+			Set("User-Agent", RandomUserAgent(a.session)).
+			Set("Accept-Encoding", "gzip, deflate, br").EndBytes()
+		if errs != nil {
+			a.session.Out.Debug("[%s] Error fetching asset %s: %v\n", a.ID(), assetURL, errs[0])
+			return
+		}
+		if resp.StatusCode != 200 || len(body) == 0 {
+			a.session.Out.Debug("[%s] Skipping asset %s with status %s\n", a.ID(), assetURL, resp.Status)
+			return
+		}
+		body, err := DecodeBody(resp.Header.Get("Content-Encoding"), body)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error decoding asset %s: %v\n", a.ID(), assetURL, err)
+			return
+		}
+		r.sha256 = fmt.Sprintf("%x", sha256.Sum256(body))
+		r.faviconHash = FaviconHash(body)
+		r.ok = true
+	})
+	return r
+}
+
+// FaviconHash returns the favicon hash used by Shodan's http.favicon.hash
+// filter: the signed 32-bit MurmurHash3 of the base64 encoded favicon with
+// a line break after every 76 characters.
+func FaviconHash(favicon []byte) int32 {
+	encoded := base64.StdEncoding.EncodeToString(favicon)
+	var b strings.Builder
+	for len(encoded) > 76 {
+		b.WriteString(encoded[:76])
+		b.WriteString("\n")
+		encoded = encoded[76:]
+	}
+	b.WriteString(encoded)
+	b.WriteString("\n")
+	return int32(murmur3([]byte(b.String()), 0))
+}
+
+func murmur3(data []byte, seed uint32) uint32 {
+	const c1, c2 = 0xcc9e2d51, 0x1b873593
+	h := seed
+	n := len(data) / 4
+	for i := 0; i < n; i++ {
+		k := uint32(data[i*4]) | uint32(data[i*4+1])<<8 | uint32(data[i*4+2])<<16 | uint32(data[i*4+3])<<24
+		k *= c1
+		k = k<<15 | k>>17
+		k *= c2
+		h ^= k
+		h = h<<13 | h>>19
+		h = h*5 + 0xe6546b64
+	}
+
+	var k uint32
+	tail := data[n*4:]
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		k *= c1
+		k = k<<15 | k>>17
+		k *= c2
+		h ^= k
+	}
+
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
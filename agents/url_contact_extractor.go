@@ -0,0 +1,148 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mk990/aquatone/core"
+)
+
+// maxContactTags limits how many contacts are tagged on a single page.
+const maxContactTags = 10
+
+var (
+	emailAddress = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+	// Retina image names like logo@2x.png look like email addresses
+	fileExtensions = map[string]struct{}{
+		"png": {}, "jpg": {}, "jpeg": {}, "gif": {}, "svg": {}, "webp": {}, "css": {}, "js": {},
+	}
+	socialHosts = map[string]string{
+		"twitter.com":   core.ContactTwitter,
+		"x.com":         core.ContactTwitter,
+		"linkedin.com":  core.ContactLinkedIn,
+		"github.com":    core.ContactGitHub,
+		"facebook.com":  core.ContactFacebook,
+		"instagram.com": core.ContactInstagram,
+	}
+	// Paths on social sites that are not profiles
+	socialIgnoredPaths = map[string]struct{}{
+		"share": {}, "sharer": {}, "sharer.php": {}, "intent": {}, "home": {}, "login": {}, "search": {},
+		"hashtag": {}, "shareArticle": {}, "explore": {}, "about": {}, "privacy": {}, "tos": {}, "p": {},
+	}
+)
+
+type URLContactExtractor struct {
+	session *core.Session
+}
+
+func NewURLContactExtractor() *URLContactExtractor {
+	return &URLContactExtractor{}
+}
+
+func (a *URLContactExtractor) ID() string {
+	return "agent:url_contact_extractor"
+}
+
+func (a *URLContactExtractor) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLContactExtractor) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer a.session.TrackAgent(a.ID())()
+		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		contacts := a.findEmails(body)
+		if doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body)); err == nil {
+			contacts = append(contacts, a.findSocialHandles(doc)...)
+		}
+
+		seen := make(map[core.Contact]struct{})
+		for _, contact := range contacts {
+			if _, ok := seen[contact]; ok {
+				continue
+			}
+			seen[contact] = struct{}{}
+			page.AddContact(contact)
+			if len(seen) <= maxContactTags {
+				page.AddTag(contact.String(), "info", contact.Link())
+			}
+		}
+	}(page)
+}
+
+func (a *URLContactExtractor) findEmails(body []byte) []core.Contact {
+	var contacts []core.Contact
+	for _, match := range emailAddress.FindAll(body, -1) {
+		email := strings.ToLower(strings.Trim(string(match), "."))
+		tld := email[strings.LastIndex(email, ".")+1:]
+		if _, ok := fileExtensions[tld]; ok {
+			continue
+		}
+		contacts = append(contacts, core.Contact{Type: core.ContactEmail, Value: email})
+	}
+	return contacts
+}
+
+func (a *URLContactExtractor) findSocialHandles(doc *goquery.Document) []core.Contact {
+	var contacts []core.Contact
+	doc.Find("a[href]").Each(func(i int, s *goquery.Selection) {
+		u, err := url.Parse(strings.TrimSpace(s.AttrOr("href", "")))
+		if err != nil {
+			return
+		}
+		if u.Scheme == "mailto" {
+			email := strings.ToLower(strings.SplitN(u.Opaque, "?", 2)[0])
+			if emailAddress.MatchString(email) {
+				contacts = append(contacts, core.Contact{Type: core.ContactEmail, Value: email})
+			}
+			return
+		}
+
+		contactType, ok := socialHosts[strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")]
+		if !ok {
+			return
+		}
+		segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+		if len(segments) == 0 {
+			return
+		}
+		if _, ignored := socialIgnoredPaths[segments[0]]; ignored {
+			return
+		}
+
+		handle := segments[0]
+		switch contactType {
+		case core.ContactLinkedIn:
+			// LinkedIn profiles live under /in/ and /company/
+			if len(segments) < 2 || (segments[0] != "in" && segments[0] != "company") {
+				return
+			}
+			handle = segments[0] + "/" + segments[1]
+		case core.ContactTwitter, core.ContactInstagram:
+			handle = "@" + strings.TrimPrefix(handle, "@")
+		}
+		contacts = append(contacts, core.Contact{Type: contactType, Value: handle})
+	})
+	return contacts
+}
@@ -0,0 +1,99 @@
+package agents
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// URLDefaultPageDetector tags pages whose body hashes match known default,
+// install and debug pages, which recognizes them even when their title was
+// changed.
+type URLDefaultPageDetector struct {
+	session *core.Session
+	pages   map[string]core.DefaultPage
+}
+
+func NewURLDefaultPageDetector() *URLDefaultPageDetector {
+	return &URLDefaultPageDetector{}
+}
+
+func (a *URLDefaultPageDetector) ID() string {
+	return "agent:url_default_page_detector"
+}
+
+func (a *URLDefaultPageDetector) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+	a.loadPages()
+
+	return nil
+}
+
+// loadPages loads the built-in list of known default pages and the list
+// given with --default-page-list, which has one SHA-256 hash per line
+// followed by a comma and a name. Hashes are either the bodyHash of a page
+// in a session file or a hash from core.DefaultPageHashes.
+func (a *URLDefaultPageDetector) loadPages() {
+	a.pages = make(map[string]core.DefaultPage)
+	for hash, page := range core.KnownDefaultPages {
+		a.pages[hash] = page
+	}
+
+	if *a.session.Options.DefaultPageList == "" {
+		return
+	}
+	f, err := os.Open(*a.session.Options.DefaultPageList)
+	if err != nil {
+		a.session.Out.FatalWithCode(core.ExitInvalidOptions, "Can't read default page list %s: %v\n", *a.session.Options.DefaultPageList, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ",", 2)
+		name := "Known Default Page"
+		if len(parts) == 2 && strings.TrimSpace(parts[1]) != "" {
+			name = strings.TrimSpace(parts[1])
+		}
+		a.pages[strings.ToLower(strings.TrimSpace(parts[0]))] = core.DefaultPage{Name: name, Type: "warning"}
+	}
+}
+
+func (a *URLDefaultPageDetector) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer a.session.TrackAgent(a.ID())()
+		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		tagged := make(map[string]bool)
+		for _, hash := range append([]string{page.BodyHash}, core.DefaultPageHashes(body)...) {
+			match, ok := a.pages[hash]
+			if !ok || tagged[match.Name] {
+				continue
+			}
+			tagged[match.Name] = true
+			page.AddTag(match.Name, match.Type, "")
+			a.session.Out.Debug("[%s] %s matches %s\n", a.ID(), page.URL, match.Name)
+		}
+	}(page)
+}
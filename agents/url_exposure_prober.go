@@ -0,0 +1,153 @@
+package agents
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mk990/aquatone/core"
+	"github.com/parnurzeal/gorequest"
+)
+
+// URLExposureProber probes the base URL of every web server for files that
+// shouldn't be public, like Git and SVN metadata, .env files and backups,
+// and records the ones it finds on the page the base URL was found with.
+type URLExposureProber struct {
+	session *core.Session
+	paths   []string
+	bases   sync.Map
+}
+
+func NewURLExposureProber() *URLExposureProber {
+	return &URLExposureProber{}
+}
+
+func (a *URLExposureProber) ID() string {
+	return "agent:url_exposure_prober"
+}
+
+func (a *URLExposureProber) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+	a.loadPaths()
+
+	return nil
+}
+
+// loadPaths loads the list of paths given with --exposure-list, which has
+// one path per line, or the built-in list.
+func (a *URLExposureProber) loadPaths() {
+	if *a.session.Options.ExposureList == "" {
+		a.paths = core.ExposurePaths
+		return
+	}
+	f, err := os.Open(*a.session.Options.ExposureList)
+	if err != nil {
+		a.session.Out.FatalWithCode(core.ExitInvalidOptions, "Can't read exposure list %s: %v\n", *a.session.Options.ExposureList, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.HasPrefix(line, "/") {
+			line = "/" + line
+		}
+		a.paths = append(a.paths, line)
+	}
+}
+
+func (a *URLExposureProber) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+	base := BaseURL(page.URL)
+	if _, probed := a.bases.LoadOrStore(base, true); probed || base == "" {
+		return
+	}
+
+	release := a.session.AcquireHostSlot(page.Hostname)
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer release()
+		defer a.session.TrackAgent(a.ID())()
+		// Files that can't be recognized by their content are only
+		// reported by servers that don't answer every path
+		resp, body, errs := a.get(base + "/.aquatone-" + page.UUID)
+		catchAll := errs == nil && resp.StatusCode < 300 && len(body) > 0
+
+		for _, path := range a.paths {
+			a.probe(page, base+path, path, catchAll)
+		}
+	}(page)
+}
+
+func (a *URLExposureProber) probe(page *core.Page, u string, path string, catchAll bool) {
+	resp, body, errs := a.get(u)
+	if errs != nil {
+		a.session.Out.Debug("[%s] Error requesting %s: %v\n", a.ID(), u, errs[0])
+		return
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		return
+	}
+	size := responseSize(resp, body)
+
+	// Some servers send .gz files with a gzip Content-Encoding, so the
+	// body is checked as sent before it is decoded
+	kind, evidence, ok := core.CheckExposure(path, body, size, catchAll)
+	if !ok && resp.Header.Get("Content-Encoding") != "" {
+		if decoded, err := DecodeBody(resp.Header.Get("Content-Encoding"), body); err == nil {
+			kind, evidence, ok = core.CheckExposure(path, decoded, size, catchAll)
+		}
+	}
+	if !ok {
+		return
+	}
+	page.AddExposure(core.Exposure{Kind: kind, URL: u, Size: size, Evidence: evidence})
+	page.AddTag(kind, "danger", u)
+	note := fmt.Sprintf("%s at %s", kind, u)
+	if evidence != "" {
+		note += ": " + evidence
+	}
+	page.AddNote(note, core.ExposureSeverities[kind])
+	a.session.Out.Warn("%s: %s\n", u, Red(kind))
+}
+
+// get requests the start of a file. Redirects aren't followed, as they
+// usually lead to a login or error page rather than the file.
+func (a *URLExposureProber) get(u string) (gorequest.Response, []byte, []error) {
+	return PinnedGorequest(a.session).Get(u).
+		RedirectPolicy(func(req gorequest.Request, via []gorequest.Request) error {
+			return http.ErrUseLastResponse
+		}).
+		Set("User-Agent", RandomUserAgent(a.session)).
+		Set("Range", fmt.Sprintf("bytes=0-%d", core.MaxExposureRead-1)).EndBytes()
+}
+
+// responseSize returns the full size of a file from the Content-Range of a
+// partial response, or the Content-Length of a full one.
+func responseSize(resp gorequest.Response, body []byte) int64 {
+	if contentRange := resp.Header.Get("Content-Range"); contentRange != "" {
+		if i := strings.LastIndex(contentRange, "/"); i != -1 {
+			if size, err := strconv.ParseInt(contentRange[i+1:], 10, 64); err == nil {
+				return size
+			}
+		}
+	}
+	if resp.ContentLength >= 0 {
+		return resp.ContentLength
+	}
+	return int64(len(body))
+}
@@ -0,0 +1,96 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mk990/aquatone/core"
+)
+
+var csrfTokenName = regexp.MustCompile(`(?i)csrf|xsrf|authenticity_token|__requestverificationtoken|nonce`)
+
+type URLFormExtractor struct {
+	session *core.Session
+}
+
+func NewURLFormExtractor() *URLFormExtractor {
+	return &URLFormExtractor{}
+}
+
+func (a *URLFormExtractor) ID() string {
+	return "agent:url_form_extractor"
+}
+
+func (a *URLFormExtractor) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLFormExtractor) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer a.session.TrackAgent(a.ID())()
+		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error when parsing HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		loginForm, fileUpload := false, false
+		doc.Find("form").Each(func(i int, s *goquery.Selection) {
+			form := core.Form{
+				Method: strings.ToUpper(strings.TrimSpace(s.AttrOr("method", "GET"))),
+				Action: strings.TrimSpace(s.AttrOr("action", "")),
+			}
+			s.Find("input, textarea, select").Each(func(i int, input *goquery.Selection) {
+				inputType := strings.ToLower(strings.TrimSpace(input.AttrOr("type", "")))
+				if inputType == "" {
+					inputType = goquery.NodeName(input)
+					if inputType == "input" {
+						inputType = "text"
+					}
+				}
+				name := input.AttrOr("name", input.AttrOr("id", ""))
+				form.Inputs = append(form.Inputs, core.FormInput{Name: name, Type: inputType})
+
+				switch {
+				case inputType == "password":
+					form.HasPassword = true
+				case inputType == "file":
+					form.HasFileUpload = true
+				case inputType == "hidden" && csrfTokenName.MatchString(name):
+					form.HasCSRFToken = true
+				}
+			})
+			loginForm = loginForm || form.HasPassword
+			fileUpload = fileUpload || form.HasFileUpload
+			page.AddForm(form)
+		})
+
+		if loginForm {
+			page.AddTag("Login Form", "info", "")
+		}
+		if fileUpload {
+			page.AddTag("File Upload", "info", "")
+		}
+	}(page)
+}
@@ -0,0 +1,205 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mk990/aquatone/core"
+)
+
+// Pages nested deeper than this in frames of other pages aren't searched
+// for frames themselves.
+const maxFrameDepth = 3
+
+// An iframe fills the page when the rest of the page has less text than
+// this, or when it is sized to the full width and height.
+const maxFramedPageText = 200
+
+var fullFrameSize = regexp.MustCompile(`^\s*100(%|vh|vw)\s*$`)
+
+// URLFrameExtractor finds the documents of pages that are just a frameset
+// or a full-page iframe, and requests and screenshots them as pages of
+// their own linked to the page framing them, as the screenshot of the outer
+// page often comes out empty.
+type URLFrameExtractor struct {
+	session *core.Session
+	parents sync.Map
+}
+
+func NewURLFrameExtractor() *URLFrameExtractor {
+	return &URLFrameExtractor{}
+}
+
+func (a *URLFrameExtractor) ID() string {
+	return "agent:url_frame_extractor"
+}
+
+func (a *URLFrameExtractor) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLFrameExtractor) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer a.session.TrackAgent(a.ID())()
+		if parent, ok := a.parents.Load(page.URL); ok {
+			page.FrameOf = parent.(string)
+		}
+		if a.frameDepth(page) >= maxFrameDepth {
+			return
+		}
+
+		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error when parsing HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		for _, frameURL := range a.findFrames(page, doc) {
+			a.addFrame(page, frameURL)
+		}
+	}(page)
+}
+
+// frameDepth returns how many pages frame the page, one inside the other.
+func (a *URLFrameExtractor) frameDepth(page *core.Page) int {
+	depth := 0
+	for parent := page.FrameOf; parent != "" && depth < maxFrameDepth; depth++ {
+		p := a.session.GetPage(parent)
+		if p == nil {
+			break
+		}
+		parent = p.FrameOf
+	}
+	return depth
+}
+
+// findFrames returns the URLs of the frames of a frameset, or of the iframes
+// that fill the page.
+func (a *URLFrameExtractor) findFrames(page *core.Page, doc *goquery.Document) []string {
+	base, err := url.Parse(page.DestinationURL())
+	if err != nil {
+		return nil
+	}
+
+	frames := doc.Find("frameset frame[src]")
+	if frames.Length() == 0 {
+		iframes := doc.Find("iframe[src]")
+		if iframes.Length() == 0 {
+			return nil
+		}
+		text := doc.Find("body").Clone()
+		text.Find("script, style, noscript, iframe").Remove()
+		sparse := iframes.Length() == 1 && len(strings.TrimSpace(text.Text())) < maxFramedPageText
+		frames = iframes.FilterFunction(func(_ int, iframe *goquery.Selection) bool {
+			if isHiddenFrame(iframe) {
+				return false
+			}
+			return sparse || isFullPageFrame(iframe)
+		})
+	}
+
+	var urls []string
+	seen := make(map[string]bool)
+	frames.Each(func(_ int, frame *goquery.Selection) {
+		ref, err := url.Parse(strings.TrimSpace(frame.AttrOr("src", "")))
+		if err != nil {
+			return
+		}
+		u := base.ResolveReference(ref)
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return
+		}
+		u.Fragment = ""
+		if frameURL := u.String(); frameURL != page.URL && !seen[frameURL] {
+			seen[frameURL] = true
+			urls = append(urls, frameURL)
+		}
+	})
+	return urls
+}
+
+// addFrame links the page of a frame to the page framing it, and requests it
+// unless it is already scanned. Frames that are requested are linked back
+// to the page framing them once they respond.
+func (a *URLFrameExtractor) addFrame(page *core.Page, frameURL string) {
+	if normalized, err := a.session.NormalizeURL(frameURL); err == nil {
+		frameURL = normalized
+	}
+	page.AddFrame(frameURL)
+	a.session.Out.Debug("[%s] Found frame %s on %s\n", a.ID(), frameURL, page.URL)
+
+	if frame := a.session.GetPage(frameURL); frame != nil {
+		if frame.FrameOf == "" && frame.URL != page.FrameOf {
+			frame.FrameOf = page.URL
+		}
+		return
+	}
+	if _, requested := a.parents.LoadOrStore(frameURL, page.URL); requested {
+		return
+	}
+	if !a.session.AllowURL() {
+		a.session.Out.Debug("[%s] Skipping %s beyond --max-urls\n", a.ID(), frameURL)
+		return
+	}
+	a.session.EventBus.Publish(core.URL, frameURL)
+}
+
+// isFullPageFrame reports whether an iframe is sized to fill the page with
+// its width and height attributes or style.
+func isFullPageFrame(iframe *goquery.Selection) bool {
+	width, height := iframe.AttrOr("width", ""), iframe.AttrOr("height", "")
+	for _, declaration := range strings.Split(iframe.AttrOr("style", ""), ";") {
+		parts := strings.SplitN(declaration, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(parts[0])) {
+		case "width":
+			width = parts[1]
+		case "height":
+			height = parts[1]
+		}
+	}
+	return fullFrameSize.MatchString(width) && fullFrameSize.MatchString(height)
+}
+
+// isHiddenFrame reports whether an iframe is hidden or tiny, like the
+// iframes of tracking and analytics scripts.
+func isHiddenFrame(iframe *goquery.Selection) bool {
+	if _, hidden := iframe.Attr("hidden"); hidden {
+		return true
+	}
+	style := strings.ToLower(strings.ReplaceAll(iframe.AttrOr("style", ""), " ", ""))
+	if strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden") {
+		return true
+	}
+	for _, size := range []string{iframe.AttrOr("width", ""), iframe.AttrOr("height", "")} {
+		if size = strings.TrimSuffix(strings.TrimSpace(size), "px"); size == "0" || size == "1" {
+			return true
+		}
+	}
+	return false
+}
@@ -2,7 +2,7 @@ package agents
 
 import (
 	"fmt"
-	"net"
+	"strconv"
 
 	"github.com/mk990/aquatone/core"
 )
@@ -34,16 +34,26 @@ func (a *URLHostnameResolver) OnURLResponsive(url string) {
 		return
 	}
 
-	if page.IsIPHost() {
-		a.session.Out.Debug("[%s] Skipping hostname resolving on IP host: %s\n", a.ID(), url)
-		page.Addrs = []string{page.ParsedURL().Hostname()}
-		return
+	hostname := page.ParsedURL().Hostname()
+	if port, err := strconv.Atoi(page.ParsedURL().Port()); err == nil {
+		a.session.AddHostPort(hostname, port)
+	} else if page.ParsedURL().Scheme == "https" {
+		a.session.AddHostPort(hostname, 443)
+	} else {
+		a.session.AddHostPort(hostname, 80)
 	}
 
 	a.session.WaitGroup.Add()
 	go func(page *core.Page) {
 		defer a.session.WaitGroup.Done()
-		addrs, err := net.LookupHost(fmt.Sprintf("%s.", page.ParsedURL().Hostname()))
+		defer a.session.TrackAgent(a.ID())()
+		if page.IsIPHost() {
+			a.session.Out.Debug("[%s] Skipping hostname resolving on IP host: %s\n", a.ID(), url)
+			page.Addrs = []string{hostname}
+			recordHostIPs(a.session, hostname, page.Addrs)
+			return
+		}
+		addrs, err := a.session.LookupHost(fmt.Sprintf("%s.", hostname))
 		if err != nil {
 			a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 			a.session.Out.Error("Failed to resolve hostname for %s\n", page.URL)
@@ -51,5 +61,6 @@ func (a *URLHostnameResolver) OnURLResponsive(url string) {
 		}
 
 		page.Addrs = addrs
+		recordHostIPs(a.session, hostname, addrs)
 	}(page)
 }
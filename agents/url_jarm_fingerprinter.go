@@ -0,0 +1,124 @@
+package agents
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+)
+
+type URLJARMFingerprinter struct {
+	session      *core.Session
+	fingerprints map[string]string
+	results      sync.Map
+}
+
+type jarmResult struct {
+	once sync.Once
+	jarm string
+}
+
+func NewURLJARMFingerprinter() *URLJARMFingerprinter {
+	return &URLJARMFingerprinter{}
+}
+
+func (a *URLJARMFingerprinter) ID() string {
+	return "agent:url_jarm_fingerprinter"
+}
+
+func (a *URLJARMFingerprinter) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+	a.loadFingerprints()
+
+	return nil
+}
+
+// loadFingerprints loads the built-in list of known JARM fingerprints and
+// the list given with --jarm-list, which has one fingerprint per line
+// optionally followed by a comma and a name.
+func (a *URLJARMFingerprinter) loadFingerprints() {
+	a.fingerprints = make(map[string]string)
+	for jarm, name := range knownJARMs {
+		a.fingerprints[jarm] = name
+	}
+
+	if *a.session.Options.JARMList == "" {
+		return
+	}
+	f, err := os.Open(*a.session.Options.JARMList)
+	if err != nil {
+		a.session.Out.FatalWithCode(core.ExitInvalidOptions, "Can't read JARM fingerprint list %s: %v\n", *a.session.Options.JARMList, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ",", 2)
+		name := "Known JARM"
+		if len(parts) == 2 && strings.TrimSpace(parts[1]) != "" {
+			name = strings.TrimSpace(parts[1])
+		}
+		a.fingerprints[strings.ToLower(strings.TrimSpace(parts[0]))] = name
+	}
+}
+
+func (a *URLJARMFingerprinter) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	u := page.ParsedURL()
+	if u.Scheme != "https" {
+		return
+	}
+
+	release := a.session.AcquireHostSlot(page.Hostname)
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer release()
+		defer a.session.TrackAgent(a.ID())()
+		port := u.Port()
+		if port == "" {
+			port = "443"
+		}
+		address := net.JoinHostPort(u.Hostname(), port)
+
+		// Every URL on the same service shares the fingerprint, so it is
+		// only computed once per host and port.
+		result, _ := a.results.LoadOrStore(address, &jarmResult{})
+		r := result.(*jarmResult)
+		r.once.Do(func() {
+			timeout := time.Duration(*a.session.Options.HTTPTimeout) * time.Millisecond
+			dial := func(network, address string) (net.Conn, error) {
+				ctx, cancel := context.WithTimeout(context.Background(), timeout)
+				defer cancel()
+				return a.session.Dialer.DialContext(ctx, network, a.session.DialAddress(address))
+			}
+			r.jarm = JARM(address, u.Hostname(), timeout, dial)
+			a.session.Out.Debug("[%s] JARM fingerprint of %s is %s\n", a.ID(), address, r.jarm)
+		})
+
+		if r.jarm == emptyJARM {
+			return
+		}
+		page.JARM = r.jarm
+		if name, ok := a.fingerprints[r.jarm]; ok {
+			page.AddTag("JARM: "+name, "warning", "")
+			page.AddNote("TLS server fingerprint matches known JARM of "+name, core.SeverityMedium)
+		}
+	}(page)
+}
@@ -0,0 +1,226 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mk990/aquatone/core"
+	"golang.org/x/net/html"
+)
+
+// maxCommentNotes limits how many leaking HTML comments are noted per page.
+const maxCommentNotes = 5
+
+// maxSourcePaths limits how many source file paths from stack traces and
+// debug pages are listed in a note.
+const maxSourcePaths = 10
+
+var (
+	commentVersion = regexp.MustCompile(`(?i)\b(?:version|ver|release|build|v)[\s:=]*\d+(?:\.\d+){1,3}\b|\b[a-z][\w\-]+[ /]v?\d+\.\d+(?:\.\d+){0,2}\b`)
+	stackTraces    = []struct {
+		language string
+		pattern  *regexp.Regexp
+	}{
+		{"Java", regexp.MustCompile(`\bat [\w$.]+\([\w$]+\.java:\d+\)`)},
+		{"Python", regexp.MustCompile(`Traceback \(most recent call last\):`)},
+		{"PHP", regexp.MustCompile(`(?i)(?:Fatal error|Parse error|Warning|Notice)(?:</b>)?:\s.+ on line (?:<b>)?\d+|PHP Stack trace:|Stack trace:\s*(?:<br\s*/?>)?\s*#0 `)},
+		{".NET", regexp.MustCompile(`Server Error in '[^']*' Application|\bat [\w.<>` + "`" + `]+\(.*\) in .+:line \d+`)},
+		{"Ruby", regexp.MustCompile(`\.rb:\d+:in ` + "`")},
+		{"Node.js", regexp.MustCompile(`\bat (?:[\w.<>]+ )?\(?/[^\s()]+\.js:\d+:\d+\)?`)},
+		{"Go", regexp.MustCompile(`goroutine \d+ \[running\]:`)},
+	}
+	// Debug and error pages of frameworks, with the version of the
+	// framework or runtime they show, if any. Pages that only reveal the
+	// framework are a warning of low severity, those showing code or
+	// settings are danger and of high severity.
+	debugPages = []struct {
+		framework string
+		name      string
+		tagType   string
+		severity  string
+		pattern   *regexp.Regexp
+		version   *regexp.Regexp
+	}{
+		{"ASP.NET", "ASP.NET Error Page", "danger", core.SeverityHigh, regexp.MustCompile(`Server Error in '[^']*' Application`), regexp.MustCompile(`ASP\.NET Version:\s*([\d.]+)`)},
+		{"Django", "Django Debug Page", "danger", core.SeverityHigh, regexp.MustCompile(`you have <code>DEBUG = True</code>|<th>Django Version:</th>`), regexp.MustCompile(`<th>Django Version:</th>\s*<td>([\d.]+)`)},
+		{"Laravel", "Laravel Debug Page", "danger", core.SeverityHigh, regexp.MustCompile(`Illuminate\\[A-Z]|"framework_version"\s*:|laravel-ignition|Ignition\.`), regexp.MustCompile(`"framework_version"\s*:\s*"([\d.]+)"|(?i)\blaravel\s+v?(\d+\.\d+(?:\.\d+)?)`)},
+		{"Whoops", "Whoops Debug Page", "danger", core.SeverityHigh, regexp.MustCompile(`class="Whoops container"|Whoops, looks like something went wrong`), nil},
+		{"Spring Boot", "Spring Boot Whitelabel Error Page", "warning", core.SeverityLow, regexp.MustCompile(`Whitelabel Error Page`), nil},
+		{"Werkzeug", "Werkzeug Debugger", "danger", core.SeverityHigh, regexp.MustCompile(`Werkzeug Debugger|__debugger__`), regexp.MustCompile(`Werkzeug/([\d.]+)`)},
+		{"Ruby on Rails", "Rails Debug Page", "danger", core.SeverityHigh, regexp.MustCompile(`Action Controller: Exception caught|<h2[^>]*>Rails\.root:`), regexp.MustCompile(`Rails ([\d.]+)`)},
+		{"Symfony", "Symfony Debug Page", "danger", core.SeverityHigh, regexp.MustCompile(`class="exception-message-wrapper"|sf-toolbar|Symfony\\Component\\`), regexp.MustCompile(`Symfony ([\d.]+)`)},
+	}
+	sourcePaths = regexp.MustCompile(`(?:/(?:[\w.\-]+/)+|\b[A-Za-z]:\\(?:[\w .\-]+\\)+)[\w.\-]+\.(?:php|py|rb|java|kt|scala|jsp|js|ts|go|cs|vb|cshtml|aspx|ascx|asax|erb|twig)\b`)
+)
+
+// URLLeakageDetector adds notes for low effort information leaks in
+// response bodies: generator meta tags, HTML comments mentioning versions,
+// stack traces and framework debug pages.
+type URLLeakageDetector struct {
+	session *core.Session
+}
+
+func NewURLLeakageDetector() *URLLeakageDetector {
+	return &URLLeakageDetector{}
+}
+
+func (a *URLLeakageDetector) ID() string {
+	return "agent:url_leakage_detector"
+}
+
+func (a *URLLeakageDetector) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLLeakageDetector) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer a.session.TrackAgent(a.ID())()
+		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		a.detectStackTraces(page, body)
+
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error when parsing HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+		a.detectGenerators(page, doc)
+		a.detectComments(page, doc)
+	}(page)
+}
+
+func (a *URLLeakageDetector) detectGenerators(page *core.Page, doc *goquery.Document) {
+	doc.Find("meta").Each(func(i int, s *goquery.Selection) {
+		if !strings.EqualFold(s.AttrOr("name", ""), "generator") {
+			return
+		}
+		if generator := strings.TrimSpace(s.AttrOr("content", "")); generator != "" {
+			page.AddNote(fmt.Sprintf("Generator meta tag discloses %s", truncate(generator, 100)), core.SeverityInfo)
+		}
+	})
+}
+
+func (a *URLLeakageDetector) detectComments(page *core.Page, doc *goquery.Document) {
+	seen := make(map[string]struct{})
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if len(seen) >= maxCommentNotes {
+			return
+		}
+		if n.Type == html.CommentNode {
+			comment := strings.Join(strings.Fields(n.Data), " ")
+			if commentVersion.MatchString(comment) {
+				if _, ok := seen[comment]; !ok {
+					seen[comment] = struct{}{}
+					page.AddNote(fmt.Sprintf("HTML comment discloses version information: %s", truncate(comment, 200)), core.SeverityLow)
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	for _, n := range doc.Nodes {
+		walk(n)
+	}
+}
+
+func (a *URLLeakageDetector) detectStackTraces(page *core.Page, body []byte) {
+	found := false
+	for _, trace := range stackTraces {
+		if trace.pattern.Match(body) {
+			found = true
+			page.AddNote(fmt.Sprintf("Response body contains a %s stack trace or error message", trace.language), core.SeverityMedium)
+		}
+	}
+
+	debugPage := false
+	for _, debug := range debugPages {
+		if !debug.pattern.Match(body) {
+			continue
+		}
+		debugPage = true
+		page.AddTag(debug.name, debug.tagType, "")
+		note := fmt.Sprintf("%s discloses that the application runs on %s", debug.name, debug.framework)
+		if version := matchVersion(debug.version, body); version != "" {
+			note = fmt.Sprintf("%s discloses %s version %s", debug.name, debug.framework, version)
+		}
+		page.AddNote(note, debug.severity)
+	}
+	if found && !debugPage {
+		page.AddTag("Stack Trace", "warning", "")
+	}
+
+	if found || debugPage {
+		if paths := findSourcePaths(body); len(paths) > 0 {
+			page.AddNote(fmt.Sprintf("Error page discloses source file paths: %s", strings.Join(paths, ", ")), core.SeverityLow)
+		}
+	}
+}
+
+// matchVersion returns the first non-empty group of the first match of
+// pattern in body.
+func matchVersion(pattern *regexp.Regexp, body []byte) string {
+	if pattern == nil {
+		return ""
+	}
+	match := pattern.FindSubmatch(body)
+	if match == nil {
+		return ""
+	}
+	for _, group := range match[1:] {
+		if len(group) > 0 {
+			return string(group)
+		}
+	}
+	return ""
+}
+
+// findSourcePaths returns the distinct paths of source files in body, like
+// those in the frames of a stack trace. Paths of URLs and of src and href
+// attributes are left out, as they are public anyway.
+func findSourcePaths(body []byte) []string {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, loc := range sourcePaths.FindAllIndex(body, -1) {
+		path := string(body[loc[0]:loc[1]])
+		before := body[:loc[0]]
+		if seen[path] || bytes.HasSuffix(before, []byte("/")) || bytes.HasSuffix(before, []byte(":")) ||
+			bytes.HasSuffix(before, []byte(`="`)) || bytes.HasSuffix(before, []byte("='")) {
+			continue
+		}
+		seen[path] = true
+		paths = append(paths, path)
+		if len(paths) == maxSourcePaths {
+			break
+		}
+	}
+	return paths
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "..."
+}
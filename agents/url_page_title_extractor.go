@@ -39,6 +39,7 @@ func (a *URLPageTitleExtractor) OnURLResponsive(url string) {
 	a.session.WaitGroup.Add()
 	go func(page *core.Page) {
 		defer a.session.WaitGroup.Done()
+		defer a.session.TrackAgent(a.ID())()
 		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
 		if err != nil {
 			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
@@ -1,9 +1,10 @@
 package agents
 
 import (
+	"context"
 	"crypto/tls"
-	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	"github.com/mk990/aquatone/core"
@@ -35,6 +36,10 @@ func (a *URLPublisher) OnTCPPort(port int, host string) {
 	} else {
 		url = HostAndPortToURL(host, port, "http")
 	}
+	if !a.session.AllowURL() {
+		a.session.Out.Debug("[%s] Skipping %s beyond --max-urls\n", a.ID(), url)
+		return
+	}
 	a.session.EventBus.Publish(core.URL, url)
 }
 
@@ -47,14 +52,20 @@ func (a *URLPublisher) isTLS(port int, host string) bool {
 		return true
 	}
 
-	dialer := &net.Dialer{Timeout: time.Duration(*a.session.Options.HTTPTimeout) * time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.session.Options.HTTPTimeout)*time.Millisecond)
+	defer cancel()
+	conn, err := a.session.Dialer.DialContext(ctx, "tcp", a.session.DialAddress(net.JoinHostPort(host, strconv.Itoa(port))))
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+
 	conf := &tls.Config{
 		InsecureSkipVerify: true,
+		ServerName:         host,
 	}
-	conn, err := tls.DialWithDialer(dialer, "tcp", fmt.Sprintf("%s:%d", host, port), conf)
-	if err != nil {
+	if err := tls.Client(conn, conf).HandshakeContext(ctx); err != nil {
 		return false
 	}
-	conn.Close()
 	return true
 }
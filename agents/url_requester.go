@@ -1,15 +1,24 @@
 package agents
 
 import (
+	"crypto/sha256"
 	"fmt"
-	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	neturl "net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/mk990/aquatone/core"
 	"github.com/parnurzeal/gorequest"
 )
 
+// maxRateLimitRetries is how many times a URL is requested again after the
+// host answered that the scan is rate limited.
+const maxRateLimitRetries = 3
+
 type URLRequester struct {
 	session *core.Session
 }
@@ -30,18 +39,27 @@ func (a *URLRequester) Register(s *core.Session) error {
 
 func (a *URLRequester) OnURL(url string) {
 	a.session.Out.Debug("[%s] Received new URL %s\n", a.ID(), url)
+	host, _, _ := net.SplitHostPort(a.hostPort(url))
+	release := a.session.AcquireHostSlot(host)
 	a.session.WaitGroup.Add()
 	go func(url string) {
 		defer a.session.WaitGroup.Done()
-		http := Gorequest(a.session.Options)
-		resp, _, errs := http.Get(url).
-			Set("User-Agent", RandomUserAgent()).
-			Set("X-Forwarded-For", RandomIPv4Address()).
-			Set("Via", fmt.Sprintf("1.1 %s", RandomIPv4Address())).
-			Set("Forwarded", fmt.Sprintf("for=%s;proto=http;by=%s", RandomIPv4Address(), RandomIPv4Address())).End()
+		defer release()
+		defer a.session.TrackAgent(a.ID())()
+		if a.session.HostQuarantined(host) {
+			a.session.Out.Debug("[%s] Skipping %s of quarantined host %s\n", a.ID(), url, host)
+			a.session.AddFailure(url, a.ID(), core.ReasonQuarantined, nil)
+			a.session.AddUnresponsive(url, core.ReasonQuarantined, nil)
+			return
+		}
+		start := a.session.Clock.Now()
+		resp, body, errs, rateLimited := a.probeRateLimited(url, host)
 		var status string
 		if errs != nil {
+			a.recordHostResult(host, core.ClassifyError(errs[0]))
 			a.session.Stats.IncrementRequestFailed()
+			a.session.AddFailure(url, a.ID(), core.ClassifyError(errs[0]), errs[0])
+			a.session.AddUnresponsive(url, core.ClassifyError(errs[0]), errs[0])
 			for _, err := range errs {
 				a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 				if os.IsTimeout(err) {
@@ -53,6 +71,7 @@ func (a *URLRequester) OnURL(url string) {
 			return
 		}
 
+		a.recordHostResult(host, "")
 		a.session.Stats.IncrementRequestSuccessful()
 		if resp.StatusCode >= 500 {
 			a.session.Stats.IncrementResponseCode5xx()
@@ -67,6 +86,9 @@ func (a *URLRequester) OnURL(url string) {
 			a.session.Stats.IncrementResponseCode2xx()
 			status = Green(resp.Status)
 		}
+		if rateLimited {
+			status += Yellow(" (rate limited)")
+		}
 		a.session.Out.Info("%s: %s\n", url, status)
 
 		page, err := a.createPageFromResponse(url, resp)
@@ -76,15 +98,151 @@ func (a *URLRequester) OnURL(url string) {
 			return
 		}
 
-		a.writeHeaders(page)
-		if *a.session.Options.SaveBody {
-			a.writeBody(page, resp)
+		if rateLimited {
+			a.session.Stats.IncrementRateLimitedPages()
+			page.RateLimited = true
+			page.AddTag("Rate Limited", "info", "")
 		}
+		page.ResponseTime = a.session.Clock.Now().Sub(start).Milliseconds()
+		a.session.WaitForDiskSpace()
+		a.writeRequest(page, resp)
+		a.writeHeaders(page)
+		a.recordRedirectChain(page, resp)
+		a.recordAuthChallenges(page, resp)
+		body = a.decodeBody(page, resp, body)
+		a.requestBackends(page, resp)
+		body = a.followClientRedirects(page, body)
+		a.writeBody(page, body)
 
 		a.session.EventBus.Publish(core.URLResponsive, url)
 	}(url)
 }
 
+// probe requests the URL with the method and body given with --probe-method
+// and --probe-body.
+func (a *URLRequester) probe(url string, addr string) (gorequest.Response, []byte, []error) {
+	return a.request(*a.session.Options.ProbeMethod, url, addr, *a.session.Options.ProbeBody)
+}
+
+// probeRateLimited requests the URL like probe. When the host answers that
+// the scan is rate limited, it waits as long as the host asks and tries
+// again, up to maxRateLimitRetries times, and slows down all further
+// requests to the host. It reports whether the last response was still rate
+// limited.
+func (a *URLRequester) probeRateLimited(url string, host string) (gorequest.Response, []byte, []error, bool) {
+	maxDelay := time.Duration(*a.session.Options.MaxRetryAfter) * time.Second
+	for attempt := 0; ; attempt++ {
+		resp, body, errs := a.probe(url, "")
+		if errs != nil {
+			return resp, body, errs, false
+		}
+		delay, limited := core.RetryAfter(resp.StatusCode, resp.Header.Get("Retry-After"), a.session.Clock.Now())
+		if !limited {
+			return resp, body, nil, false
+		}
+		a.session.Stats.IncrementRateLimited()
+		if interval, changed := a.session.ThrottleHost(host, delay); changed {
+			a.session.Out.Warn("%s: rate limited, slowing down to one request every %v\n", host, interval)
+		}
+		if attempt == maxRateLimitRetries || maxDelay == 0 || delay > maxDelay {
+			return resp, body, nil, true
+		}
+		a.session.Out.Debug("[%s] %s answered %s, retrying in %v\n", a.ID(), url, resp.Status, delay)
+	}
+}
+
+// get requests the URL with GET, like a browser following a link.
+func (a *URLRequester) get(url string, addr string) (gorequest.Response, []byte, []error) {
+	return a.request("GET", url, addr, "")
+}
+
+// request requests the URL. Connections to the URL's host and port go to
+// addr when given, and otherwise to the address that answered the port scan.
+func (a *URLRequester) request(method string, url string, addr string, body string) (gorequest.Response, []byte, []error) {
+	host, _, _ := net.SplitHostPort(a.hostPort(url))
+	a.session.WaitForHost(host)
+	http := Gorequest(a.session)
+	dial := http.Transport.Dial
+	http.Transport.Dial = func(network, address string) (net.Conn, error) {
+		if addr != "" && address == a.hostPort(url) {
+			_, port, _ := net.SplitHostPort(address)
+			return dial(network, net.JoinHostPort(addr, port))
+		}
+		return dial(network, a.session.DialAddress(address))
+	}
+	req := http.CustomMethod(method, url).
+		Set("User-Agent", RandomUserAgent(a.session)).
+		Set("Accept-Encoding", "gzip, deflate, br").
+		Set("X-Forwarded-For", RandomIPv4Address(a.session)).
+		Set("Via", fmt.Sprintf("1.1 %s", RandomIPv4Address(a.session))).
+		Set("Forwarded", fmt.Sprintf("for=%s;proto=http;by=%s", RandomIPv4Address(a.session), RandomIPv4Address(a.session)))
+	if body != "" {
+		// Sent as text, so gorequest passes the body on as it is
+		req = req.Type("text").Send(body).Set("Content-Type", *a.session.Options.ProbeContentType)
+	}
+	return req.EndBytes()
+}
+
+// recordHostResult records the outcome of a request to a host, and warns
+// when it gets the host quarantined.
+func (a *URLRequester) recordHostResult(host string, reason string) {
+	if a.session.RecordHostResult(host, reason) {
+		a.session.Out.Warn("%s: quarantined after %d consecutive timeouts or resets, skipping its remaining URLs\n", host, *a.session.Options.QuarantineAfter)
+	}
+}
+
+func (a *URLRequester) hostPort(pageURL string) string {
+	u, err := neturl.Parse(pageURL)
+	if err != nil {
+		return ""
+	}
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+// requestBackends requests the page from every other address that answered
+// on the port, so hostnames load balanced over several backends are
+// reported per address instead of only by whichever backend answered first.
+func (a *URLRequester) requestBackends(page *core.Page, resp gorequest.Response) {
+	addrs := a.session.GetPortAddrs(a.hostPort(page.URL))
+	if len(addrs) < 2 {
+		return
+	}
+
+	page.AddBackend(core.Backend{Addr: addrs[0], Status: resp.Status, BodySize: page.BodySize})
+	for _, addr := range addrs[1:] {
+		backendResp, body, errs := a.probe(page.URL, addr)
+		if errs != nil {
+			a.session.Out.Debug("[%s] Error requesting %s from %s: %v\n", a.ID(), page.URL, addr, errs[0])
+			page.AddBackend(core.Backend{Addr: addr, Error: core.ClassifyError(errs[0])})
+			continue
+		}
+		body, _ = DecodeBody(backendResp.Header.Get("Content-Encoding"), body)
+		page.AddBackend(core.Backend{Addr: addr, Status: backendResp.Status, BodySize: int64(len(body))})
+	}
+
+	for _, backend := range page.Backends[1:] {
+		if backend.Status != page.Backends[0].Status {
+			var statuses []string
+			for _, b := range page.Backends {
+				status := b.Status
+				if status == "" {
+					status = b.Error
+				}
+				statuses = append(statuses, fmt.Sprintf("%s: %s", b.Addr, status))
+			}
+			page.AddNote(fmt.Sprintf("Backends respond differently (%s)", strings.Join(statuses, ", ")), core.SeverityLow)
+			break
+		}
+	}
+}
+
 func (a *URLRequester) createPageFromResponse(url string, resp gorequest.Response) (*core.Page, error) {
 	page, err := a.session.AddPage(url)
 	if err != nil {
@@ -96,32 +254,209 @@ func (a *URLRequester) createPageFromResponse(url string, resp gorequest.Respons
 		page.AddHeader(name, strings.Join(value, " "))
 	}
 
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		page.Certificate = core.NewCertificate(resp.TLS.PeerCertificates[0])
+	}
+
 	return page, nil
 }
 
+// writeRequest stores the raw HTTP request that was originally sent for the
+// page so it can be reproduced later.
+func (a *URLRequester) writeRequest(page *core.Page, resp gorequest.Response) {
+	req := resp.Request
+	if req == nil {
+		return
+	}
+	for req.Response != nil && req.Response.Request != nil {
+		req = req.Response.Request
+	}
+
+	raw, err := httputil.DumpRequestOut(req, false)
+	if err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		return
+	}
+	// The body was read when the request was sent, and the first request of a
+	// page is sent with the probe body
+	raw = append(raw, *a.session.Options.ProbeBody...)
+
+	filepath := fmt.Sprintf("headers/%s.req", page.BaseFilename())
+	if err := a.session.WriteFile(filepath, raw); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write HTTP request for %s to %s\n", page.URL, a.session.GetFilePath(filepath))
+		return
+	}
+	page.RequestPath = filepath
+}
+
 func (a *URLRequester) writeHeaders(page *core.Page) {
 	filepath := fmt.Sprintf("headers/%s.txt", page.BaseFilename())
-	headers := fmt.Sprintf("%s\n", page.Status)
-	for _, header := range page.Headers {
-		headers += fmt.Sprintf("%v: %v\n", header.Name, header.Value)
+	a.writeHeadersFile(page, filepath, page.Status, page.Headers)
+	page.HeadersPath = filepath
+}
+
+func (a *URLRequester) writeHeadersFile(page *core.Page, filepath string, status string, headers []core.Header) {
+	content := fmt.Sprintf("%s\n", status)
+	for _, header := range headers {
+		content += fmt.Sprintf("%v: %v\n", header.Name, header.Value)
 	}
-	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), []byte(headers), 0644); err != nil {
+	if err := a.session.WriteFile(filepath, []byte(content)); err != nil {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 		a.session.Out.Error("Failed to write HTTP response headers for %s to %s\n", page.URL, a.session.GetFilePath(filepath))
 	}
-	page.HeadersPath = filepath
 }
 
-func (a *URLRequester) writeBody(page *core.Page, resp gorequest.Response) {
-	filepath := fmt.Sprintf("html/%s.html", page.BaseFilename())
-	body, err := ioutil.ReadAll(resp.Body)
+// recordRedirectChain stores the headers of every redirect response that led
+// to the final response, oldest first, and flags security headers that are
+// only present on some of the HTTPS hops.
+func (a *URLRequester) recordRedirectChain(page *core.Page, resp gorequest.Response) {
+	if resp.Request == nil {
+		return
+	}
+
+	var chain []*http.Response
+	for r := resp.Request.Response; r != nil; r = r.Request.Response {
+		chain = append([]*http.Response{r}, chain...)
+		if r.Request == nil {
+			break
+		}
+	}
+
+	for i, r := range chain {
+		hop := core.RedirectHop{
+			Status:      r.Status,
+			HeadersPath: fmt.Sprintf("headers/%s.hop%d.txt", page.BaseFilename(), i),
+		}
+		if r.Request != nil {
+			hop.URL = r.Request.URL.String()
+		}
+		for name, value := range r.Header {
+			hop.Headers = append(hop.Headers, core.NewHeader(name, strings.Join(value, " ")))
+		}
+		a.writeHeadersFile(page, hop.HeadersPath, hop.Status, hop.Headers)
+		page.AddRedirectHop(hop)
+	}
+
+	if len(chain) == 0 {
+		return
+	}
+
+	final := core.RedirectHop{URL: resp.Request.URL.String(), Headers: page.Headers}
+	hops := append(page.RedirectChain, final)
+	for _, name := range []string{"Strict-Transport-Security", "Content-Security-Policy", "X-Frame-Options"} {
+		present := false
+		for _, hop := range hops {
+			if hop.HasHeader(name) {
+				present = true
+				break
+			}
+		}
+		if !present {
+			continue
+		}
+		for i, hop := range hops {
+			if !strings.HasPrefix(hop.URL, "https://") || hop.HasHeader(name) {
+				continue
+			}
+			if i == len(hops)-1 {
+				page.AddNote(fmt.Sprintf("%s header missing on final response %s but present on a redirect hop", name, hop.URL), core.SeverityLow)
+			} else {
+				page.AddNote(fmt.Sprintf("%s header missing on redirect hop %d (%s)", name, i, hop.URL), core.SeverityLow)
+			}
+		}
+	}
+}
+
+// recordAuthChallenges records the schemes and realms a 401 or 407 response
+// asks to authenticate with. Pages behind HTTP authentication are tagged, as
+// they often guard admin interfaces.
+func (a *URLRequester) recordAuthChallenges(page *core.Page, resp gorequest.Response) {
+	header, proxy := "WWW-Authenticate", false
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+	case http.StatusProxyAuthRequired:
+		header, proxy = "Proxy-Authenticate", true
+	default:
+		return
+	}
+
+	var challenges []core.AuthChallenge
+	for _, value := range resp.Header.Values(header) {
+		for _, challenge := range core.ParseAuthChallenges(value) {
+			challenge.Proxy = proxy
+			challenges = append(challenges, challenge)
+		}
+	}
+	page.AddAuthChallenges(challenges)
+	page.AddTag("HTTP Auth Prompt", "warning", "")
+	if len(challenges) == 0 {
+		page.AddNote(fmt.Sprintf("HTTP authentication required but no %s header was sent", header), core.SeverityInfo)
+		return
+	}
+	var descriptions []string
+	for _, challenge := range challenges {
+		descriptions = append(descriptions, challenge.String())
+	}
+	page.AddNote(fmt.Sprintf("HTTP authentication required: %s", strings.Join(descriptions, ", ")), core.SeverityInfo)
+}
+
+// decodeBody transparently decodes a compressed response body and records
+// both the transferred and the decoded size and a hash of the content on the
+// page.
+func (a *URLRequester) decodeBody(page *core.Page, resp gorequest.Response, body []byte) []byte {
+	page.ContentEncoding = resp.Header.Get("Content-Encoding")
+	page.CompressedBodySize = int64(len(body))
+	decoded, err := DecodeBody(page.ContentEncoding, body)
 	if err != nil {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
-		a.session.Out.Error("Failed to read response body for %s\n", page.URL)
-		return
+		a.session.Out.Error("Failed to decode %s encoded response body for %s\n", page.ContentEncoding, page.URL)
+		decoded = body
 	}
+	page.BodySize = int64(len(decoded))
+	page.BodyHash = fmt.Sprintf("%x", sha256.Sum256(decoded))
+	return decoded
+}
 
-	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), body, 0644); err != nil {
+// followClientRedirects follows the meta refresh and JavaScript redirects of
+// a page, up to --max-client-redirects of them, and returns the body of the
+// page it ends up at. Many sites front everything with such an instant
+// redirect, which otherwise leaves a blank page to screenshot and analyze.
+func (a *URLRequester) followClientRedirects(page *core.Page, body []byte) []byte {
+	current := page.URL
+	seen := map[string]bool{current: true}
+	for i := 0; i < *a.session.Options.MaxClientRedirects; i++ {
+		target, kind := core.FindClientRedirect(current, body)
+		if target == "" || seen[target] {
+			break
+		}
+		seen[target] = true
+
+		resp, targetBody, errs := a.get(target, "")
+		if errs != nil {
+			a.session.Out.Debug("[%s] Error following %s redirect of %s to %s: %v\n", a.ID(), kind, page.URL, target, errs[0])
+			page.AddNote(fmt.Sprintf("Client-side redirect (%s) to %s failed: %s", kind, target, core.ClassifyError(errs[0])), core.SeverityInfo)
+			break
+		}
+		if resp.Request != nil {
+			target = resp.Request.URL.String()
+		}
+		a.session.Out.Debug("[%s] Followed %s redirect of %s to %s\n", a.ID(), kind, page.URL, target)
+		page.AddClientRedirect(core.ClientRedirect{URL: target, Kind: kind, Status: resp.Status})
+		page.AddNote(fmt.Sprintf("Client-side redirect (%s) to %s", kind, target), core.SeverityInfo)
+		body = a.decodeBody(page, resp, targetBody)
+		current = target
+		seen[current] = true
+	}
+	return body
+}
+
+// writeBody writes the body, or the start of it unless full bodies are
+// saved, to the html folder where other agents read it from.
+func (a *URLRequester) writeBody(page *core.Page, body []byte) {
+	body, page.BodySampled = a.session.SampleBody(body)
+	filepath := fmt.Sprintf("html/%s.html", page.BaseFilename())
+	if err := a.session.WriteFile(filepath, body); err != nil {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 		a.session.Out.Error("Failed to write HTTP response body for %s to %s\n", page.URL, a.session.GetFilePath(filepath))
 	}
@@ -0,0 +1,213 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mk990/aquatone/core"
+)
+
+// Only this many scripts of a page are searched for routes, which is
+// plenty for the bundles of single page apps.
+const maxRouteScripts = 10
+
+// URLRouteExtractor finds the client-side routes of single page apps in the
+// scripts of pages and the precache manifests of their service workers, as
+// the interesting parts of these apps never show up at the root URL. With
+// --spa-routes, the top routes of every app are requested and screenshotted
+// as pages of their own.
+type URLRouteExtractor struct {
+	session *core.Session
+	scripts sync.Map
+	apps    sync.Map
+}
+
+type scriptRoutes struct {
+	once          sync.Once
+	routes        []string
+	hashRouter    bool
+	serviceWorker string
+}
+
+func NewURLRouteExtractor() *URLRouteExtractor {
+	return &URLRouteExtractor{}
+}
+
+func (a *URLRouteExtractor) ID() string {
+	return "agent:url_route_extractor"
+}
+
+func (a *URLRouteExtractor) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLRouteExtractor) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	release := a.session.AcquireHostSlot(page.Hostname)
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer release()
+		defer a.session.TrackAgent(a.ID())()
+		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+		if err != nil {
+			a.session.Out.Debug("[%s] Error when parsing HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		a.extractRoutes(page, doc)
+	}(page)
+}
+
+// extractRoutes searches the inline and same host scripts of a page, and the
+// service worker they register, for client-side routes.
+func (a *URLRouteExtractor) extractRoutes(page *core.Page, doc *goquery.Document) {
+	base, err := url.Parse(page.DestinationURL())
+	if err != nil {
+		return
+	}
+
+	var inline bytes.Buffer
+	doc.Find("script:not([src])").Each(func(_ int, s *goquery.Selection) {
+		inline.WriteString(s.Text())
+		inline.WriteString("\n")
+	})
+	results := []*scriptRoutes{a.parseScript(inline.Bytes())}
+
+	doc.Find("script[src]").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		if scriptURL := sameHostURL(base, s.AttrOr("src", "")); scriptURL != "" {
+			results = append(results, a.fetchScript(scriptURL, false))
+		}
+		return len(results) <= maxRouteScripts
+	})
+
+	var routes []string
+	hashRouter := false
+	for _, result := range results {
+		routes = append(routes, result.routes...)
+		hashRouter = hashRouter || result.hashRouter
+		if page.ServiceWorker != "" || result.serviceWorker == "" {
+			continue
+		}
+		if workerURL := sameHostURL(base, result.serviceWorker); workerURL != "" {
+			page.ServiceWorker = workerURL
+			routes = append(routes, a.fetchScript(workerURL, true).routes...)
+		}
+	}
+	routes = core.RankRoutes(routes)
+	if len(routes) == 0 {
+		return
+	}
+	a.session.Out.Debug("[%s] Found %d client-side routes on %s\n", a.ID(), len(routes), page.URL)
+	page.AddRoutes(routes)
+	a.requestRoutes(page, base, routes, hashRouter)
+}
+
+// requestRoutes requests the top --spa-routes routes of the app a page
+// belongs to, once per app.
+func (a *URLRouteExtractor) requestRoutes(page *core.Page, base *url.URL, routes []string, hashRouter bool) {
+	limit := *a.session.Options.SPARoutes
+	if limit == 0 {
+		return
+	}
+	if hashRouter && !*a.session.Options.KeepFragments {
+		a.session.Out.Debug("[%s] Not requesting hash routes of %s without --keep-fragments\n", a.ID(), page.URL)
+		return
+	}
+	app := base.Scheme + "://" + base.Host
+	if hashRouter {
+		app = base.Scheme + "://" + base.Host + base.Path + "#"
+	}
+	if _, requested := a.apps.LoadOrStore(app, true); requested {
+		return
+	}
+
+	if len(routes) > limit {
+		routes = routes[:limit]
+	}
+	for _, route := range routes {
+		routeURL := app + route
+		if a.session.GetPage(routeURL) != nil {
+			continue
+		}
+		if !a.session.AllowURL() {
+			a.session.Out.Debug("[%s] Skipping %s beyond --max-urls\n", a.ID(), routeURL)
+			return
+		}
+		a.session.EventBus.Publish(core.URL, routeURL)
+	}
+}
+
+// fetchScript fetches a script once and returns the routes found in it, or
+// the routes precached by it for service workers.
+func (a *URLRouteExtractor) fetchScript(scriptURL string, serviceWorker bool) *scriptRoutes {
+	result, _ := a.scripts.LoadOrStore(scriptURL, &scriptRoutes{})
+	r := result.(*scriptRoutes)
+	r.once.Do(func() {
+		resp, body, errs := PinnedGorequest(a.session).Get(scriptURL).
+			Set("User-Agent", RandomUserAgent(a.session)).
+			Set("Accept-Encoding", "gzip, deflate, br").EndBytes()
+		if errs != nil {
+			a.session.Out.Debug("[%s] Error fetching script %s: %v\n", a.ID(), scriptURL, errs[0])
+			return
+		}
+		if resp.StatusCode != 200 || len(body) == 0 {
+			a.session.Out.Debug("[%s] Skipping script %s with status %s\n", a.ID(), scriptURL, resp.Status)
+			return
+		}
+		body, err := DecodeBody(resp.Header.Get("Content-Encoding"), body)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error decoding script %s: %v\n", a.ID(), scriptURL, err)
+			return
+		}
+		if serviceWorker {
+			r.routes = core.ExtractPrecacheRoutes(body)
+			return
+		}
+		parsed := a.parseScript(body)
+		r.routes, r.hashRouter, r.serviceWorker = parsed.routes, parsed.hashRouter, parsed.serviceWorker
+	})
+	return r
+}
+
+func (a *URLRouteExtractor) parseScript(script []byte) *scriptRoutes {
+	return &scriptRoutes{
+		routes:        core.ExtractRoutes(script),
+		hashRouter:    core.IsHashRouter(script),
+		serviceWorker: core.FindServiceWorker(script),
+	}
+}
+
+// sameHostURL resolves ref against base and returns it if it's on the same
+// host.
+func sameHostURL(base *url.URL, ref string) string {
+	u, err := url.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return ""
+	}
+	u = base.ResolveReference(u)
+	if u.Host != base.Host || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	u.Fragment = ""
+	return u.String()
+}
@@ -3,9 +3,11 @@ package agents
 import (
 	"context"
 	"fmt"
+	"html"
 	"io/ioutil"
+	neturl "net/url"
 	"os"
-	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -18,6 +20,12 @@ type URLScreenshotter struct {
 	session         *core.Session
 	chromePath      string
 	tempUserDirPath string
+	pool            *chromePool
+	display         *virtualDisplay
+	chromeEnv       []string
+	wayland         bool
+	width           int
+	height          int
 }
 
 func NewURLScreenshotter() *URLScreenshotter {
@@ -30,10 +38,14 @@ func (a *URLScreenshotter) ID() string {
 
 func (a *URLScreenshotter) Register(s *core.Session) error {
 	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	s.EventBus.SubscribeAsync(core.SessionStart, a.OnSessionStart, false)
 	s.EventBus.SubscribeAsync(core.SessionEnd, a.OnSessionEnd, false)
 	a.session = s
 	a.createTempUserDir()
 	a.locateChrome()
+	a.parseResolution()
+	a.setUpDisplay()
+	a.pool = newChromePool(s, *s.Options.ChromeInstances, a.launchChrome)
 
 	return nil
 }
@@ -46,15 +58,29 @@ func (a *URLScreenshotter) OnURLResponsive(url string) {
 		return
 	}
 
+	release := a.session.AcquireHostSlot(page.Hostname)
 	a.session.WaitGroup.Add()
 	go func(page *core.Page) {
 		defer a.session.WaitGroup.Done()
+		defer release()
+		defer a.session.TrackAgent(a.ID())()
 		a.screenshotPage(page)
 	}(page)
 }
 
+// OnSessionStart starts the Chrome instances, so they are ready by the
+// time the first pages respond.
+func (a *URLScreenshotter) OnSessionStart() {
+	a.session.Out.Debug("[%s] Received SessionStart event\n", a.ID())
+	a.pool.warm()
+}
+
 func (a *URLScreenshotter) OnSessionEnd() {
 	a.session.Out.Debug("[%s] Received SessionEnd event\n", a.ID())
+	a.pool.close()
+	if a.display != nil {
+		a.display.stop()
+	}
 	os.RemoveAll(a.tempUserDirPath)
 	a.session.Out.Debug("[%s] Deleted temporary user directory at: %s\n", a.ID(), a.tempUserDirPath)
 }
@@ -70,39 +96,15 @@ func (a *URLScreenshotter) createTempUserDir() {
 }
 
 func (a *URLScreenshotter) locateChrome() {
-	if *a.session.Options.ChromePath != "" {
-		a.chromePath = *a.session.Options.ChromePath
-		return
-	}
-
-	paths := []string{
-		"/usr/bin/google-chrome",
-		"/usr/bin/google-chrome-beta",
-		"/usr/bin/google-chrome-unstable",
-		"/usr/bin/chromium-browser",
-		"/usr/bin/chromium",
-		"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
-		"/Applications/Google Chrome Canary.app/Contents/MacOS/Google Chrome Canary",
-		"/Applications/Chromium.app/Contents/MacOS/Chromium",
-		"C:/Program Files (x86)/Google/Chrome/Application/chrome.exe",
-	}
-
-	for _, path := range paths {
-		if _, err := os.Stat(path); os.IsNotExist(err) {
-			continue
-		}
-		a.chromePath = path
-	}
-
+	a.chromePath = core.LocateChrome(*a.session.Options.ChromePath)
 	if a.chromePath == "" {
-		a.session.Out.Fatal("Unable to locate a valid installation of Chrome. Install Google Chrome or try specifying a valid location with the -chrome-path option.\n")
-		os.Exit(1)
+		a.session.Out.FatalWithCode(core.ExitChromeMissing, "Unable to locate a valid installation of Chrome. Install Google Chrome or try specifying a valid location with the -chrome-path option.\n")
 	}
 
 	if strings.Contains(strings.ToLower(a.chromePath), "chrome") {
 		a.session.Out.Warn("Using unreliable Google Chrome for screenshots. Install Chromium for better results.\n\n")
 	} else {
-		out, err := exec.Command(a.chromePath, "--version").Output()
+		out, err := a.session.Runner.CommandContext(context.Background(), a.chromePath, "--version").Output()
 		if err != nil {
 			a.session.Out.Warn("An error occurred while trying to determine version of Chromium.\n\n")
 			return
@@ -123,16 +125,118 @@ func (a *URLScreenshotter) locateChrome() {
 	a.session.Out.Debug("[%s] Located Chrome/Chromium binary at %s\n", a.ID(), a.chromePath)
 }
 
+// parseResolution parses the width and height of --resolution, falling back
+// to the default resolution when it isn't given as width,height.
+func (a *URLScreenshotter) parseResolution() {
+	a.width, a.height = 1440, 900
+	if width, height, err := core.ParseResolution(*a.session.Options.Resolution); err == nil {
+		a.width, a.height = width, height
+	}
+}
+
+// setUpDisplay picks the display Chrome opens its windows on with
+// --headful: the one given with --display, the desktop Aquatone was started
+// from, or a virtual display started with Xvfb when there is none.
+func (a *URLScreenshotter) setUpDisplay() {
+	if !*a.session.Options.Headful {
+		return
+	}
+	if *a.session.Options.Display != "" {
+		a.chromeEnv = []string{"DISPLAY=" + *a.session.Options.Display}
+		return
+	}
+	if !needsDisplay() || os.Getenv("DISPLAY") != "" {
+		return
+	}
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		a.wayland = true
+		return
+	}
+
+	display, err := startVirtualDisplay(a.session.Runner, a.width, a.height)
+	if err != nil {
+		a.session.Out.FatalWithCode(core.ExitChromeMissing, "Unable to start a virtual display for --headful: %v\n", err)
+	}
+	a.display = display
+	a.chromeEnv = []string{"DISPLAY=" + display.display}
+	a.session.Out.Debug("[%s] Started virtual display %s\n", a.ID(), display.display)
+}
+
 func (a *URLScreenshotter) screenshotPage(page *core.Page) {
-	filePath := fmt.Sprintf("screenshots/%s.png", page.BaseFilename())
+	a.session.WaitForDiskSpace()
+	// capture picks the image format from the file extension
+	ext := "png"
+	if a.session.DiskSpaceLow() {
+		ext = "jpg"
+	}
+	filePath := fmt.Sprintf("screenshots/%s.%s", page.BaseFilename(), ext)
+	// Screenshots are written to a temporary file, as filePath may be a
+	// symlink into the screenshot store left by a previous scan
+	tempPath := fmt.Sprintf("screenshots/%s.tmp.%s", page.BaseFilename(), ext)
+
+	capturedAt := a.session.Clock.Now()
+	timedOut, err := a.capture(page.DestinationURL(), tempPath, page)
+	if err != nil && page.RequiresAuth() {
+		// Pages behind HTTP authentication are worth a screenshot even
+		// when Chrome gives up on the prompt
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Debug("[%s] Screenshotting authentication prompt of %s instead\n", a.ID(), page.URL)
+		timedOut, err = a.captureAuthPrompt(page, tempPath)
+	}
+	if err != nil {
+		a.session.Stats.IncrementScreenshotFailed()
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		if timedOut {
+			a.session.AddFailure(page.URL, a.ID(), core.ReasonScreenshotTimeout, err)
+			a.session.Out.Error("%s: screenshot timed out\n", page.URL)
+			return
+		}
+
+		a.session.AddFailure(page.URL, a.ID(), core.ReasonScreenshotFailed, err)
+		a.session.Out.Error("%s: screenshot failed: %s\n", page.URL, err)
+		return
+	}
+
+	a.session.Stats.IncrementScreenshotSuccessful()
+	a.session.Out.Info("%s: %s\n", page.URL, Green("screenshot successful"))
+	// The overlay is drawn after hashing, so it doesn't make screenshots of
+	// the same page look different in comparisons with other scans
+	a.hashScreenshot(page, tempPath)
+	if *a.session.Options.ScreenshotOverlay {
+		if err := core.DrawOverlay(a.session.GetFilePath(tempPath), a.session.ScreenshotOverlay(page)); err != nil {
+			a.session.Out.Debug("[%s] Unable to draw overlay on screenshot of %s: %v\n", a.ID(), page.URL, err)
+		}
+	}
+	screenshotPath, err := a.session.StoreScreenshot(tempPath, filePath)
+	if err != nil {
+		a.session.Out.Debug("[%s] Unable to store screenshot of %s: %v\n", a.ID(), page.URL, err)
+		screenshotPath = tempPath
+	}
+	if err := a.session.EmbedScreenshotMetadata(screenshotPath, a.session.ScreenshotMetadata(page, capturedAt)); err != nil {
+		a.session.Out.Debug("[%s] Unable to embed metadata in screenshot of %s: %v\n", a.ID(), page.URL, err)
+	}
+	page.ScreenshotPath = screenshotPath
+	page.HasScreenshot = true
+}
+
+// launchChrome starts a Chrome instance for the pool, with a user data
+// directory of its own.
+func (a *URLScreenshotter) launchChrome() (*chromeBrowser, error) {
 	var chromeArguments = []string{
-		"--headless", "--disable-gpu", "--hide-scrollbars", "--mute-audio", "--disable-notifications",
-		"--no-first-run", "--disable-crash-reporter", "--ignore-certificate-errors", "--incognito",
+		"--hide-scrollbars", "--mute-audio", "--disable-notifications",
+		"--no-first-run", "--disable-crash-reporter", "--ignore-certificate-errors",
 		"--disable-infobars", "--disable-sync", "--no-default-browser-check",
-		"--user-data-dir=" + a.tempUserDirPath,
-		"--user-agent=" + RandomUserAgent(),
 		"--window-size=" + *a.session.Options.Resolution,
-		"--screenshot=" + a.session.GetFilePath(filePath),
+	}
+
+	if !*a.session.Options.Headful {
+		chromeArguments = append(chromeArguments, "--headless", "--disable-gpu")
+	} else if a.wayland {
+		chromeArguments = append(chromeArguments, "--ozone-platform=wayland")
+	}
+
+	if *a.session.Options.Stealth {
+		chromeArguments = append(chromeArguments, stealthArgs...)
 	}
 
 	if os.Geteuid() == 0 {
@@ -141,47 +245,145 @@ func (a *URLScreenshotter) screenshotPage(page *core.Page) {
 
 	if *a.session.Options.Proxy != "" {
 		chromeArguments = append(chromeArguments, "--proxy-server="+*a.session.Options.Proxy)
+	} else if a.session.BrowserProxy != "" {
+		chromeArguments = append(chromeArguments, "--proxy-server="+a.session.BrowserProxy)
+	}
+
+	userDir, err := ioutil.TempDir(a.tempUserDirPath, "instance")
+	if err != nil {
+		return nil, err
+	}
+	b, err := launchChrome(a.session.Runner, a.session.Out, a.chromePath, chromeArguments, a.chromeEnv, userDir)
+	if err != nil {
+		return nil, err
+	}
+	if *a.session.Options.Headful {
+		b.windowWidth, b.windowHeight = a.width, a.height
+	}
+	if *a.session.Options.Stealth {
+		if err := b.enableStealth(); err != nil {
+			a.session.Out.Debug("[%s] Unable to determine user agent of Chrome, using random user agents: %v\n", a.ID(), err)
+		}
 	}
+	a.session.Out.Debug("[%s] Launched Chrome instance with user directory %s\n", a.ID(), userDir)
+	return b, nil
+}
 
-	chromeArguments = append(chromeArguments, page.URL)
+// capture screenshots url to the file at path with a Chrome instance from
+// the pool, and reports whether it failed by timing out. The timeout starts
+// once an instance is free. The URL and title of the page in Chrome and
+// what it reported on the console while it rendered are recorded on page,
+// unless it is nil.
+func (a *URLScreenshotter) capture(url string, path string, page *core.Page) (bool, error) {
+	b, err := a.pool.get()
+	if err != nil {
+		return false, err
+	}
+	defer a.pool.put(b)
+
+	format := "png"
+	if strings.HasSuffix(path, ".jpg") {
+		format = "jpeg"
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.session.Options.ScreenshotTimeout*1000)*time.Millisecond)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, a.chromePath, chromeArguments...)
-	if err := cmd.Start(); err != nil {
-		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
-		a.session.Stats.IncrementScreenshotFailed()
-		a.session.Out.Error("%s: screenshot failed: %s\n", page.URL, err)
-		a.killChromeProcessIfRunning(cmd)
-		return
+	// Pages followed to with client-side redirects were requested with GET
+	var request *chromeRequest
+	if page != nil && url == page.URL && *a.session.Options.ProbeMethod != "GET" {
+		request = &chromeRequest{
+			method:      *a.session.Options.ProbeMethod,
+			body:        *a.session.Options.ProbeBody,
+			contentType: *a.session.Options.ProbeContentType,
+		}
 	}
-
-	if err := cmd.Wait(); err != nil {
-		a.session.Stats.IncrementScreenshotFailed()
-		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
-		if ctx.Err() == context.DeadlineExceeded {
-			a.session.Out.Error("%s: screenshot timed out\n", page.URL)
-			a.killChromeProcessIfRunning(cmd)
-			return
+	capture, err := b.screenshot(ctx, url, RandomUserAgent(a.session), format, request)
+	if err != nil {
+		return ctx.Err() == context.DeadlineExceeded, err
+	}
+	if page != nil {
+		page.SetRendered(capture.url, capture.title)
+		for _, note := range capture.console.notes() {
+			page.AddNote(note.Text, note.Severity)
 		}
+	}
+	return false, ioutil.WriteFile(a.session.GetFilePath(path), capture.data, 0644)
+}
 
-		a.session.Out.Error("%s: screenshot failed: %s\n", page.URL, err)
-		a.killChromeProcessIfRunning(cmd)
-		return
+// captureAuthPrompt screenshots a local page standing in for the browser's
+// authentication prompt of a page, showing the URL, status and the schemes
+// and realms it asks to authenticate with.
+func (a *URLScreenshotter) captureAuthPrompt(page *core.Page, path string) (bool, error) {
+	f, err := ioutil.TempFile("", "aquatone-auth-*.html")
+	if err != nil {
+		return false, err
 	}
+	defer os.Remove(f.Name())
 
-	a.session.Stats.IncrementScreenshotSuccessful()
-	a.session.Out.Info("%s: %s\n", page.URL, Green("screenshot successful"))
-	page.ScreenshotPath = filePath
-	page.HasScreenshot = true
-	a.killChromeProcessIfRunning(cmd)
+	var challenges string
+	for _, challenge := range page.AuthChallenges {
+		realm := challenge.Realm
+		if realm == "" {
+			realm = "(no realm)"
+		}
+		challenges += fmt.Sprintf("<tr><td>%s</td><td>%s</td></tr>\n", html.EscapeString(challenge.Scheme), html.EscapeString(realm))
+	}
+	if challenges == "" {
+		challenges = "<tr><td colspan=\"2\">No challenges sent</td></tr>\n"
+	}
+	title := "Authentication required"
+	if strings.HasPrefix(page.Status, "407") {
+		title = "Proxy authentication required"
+	}
+	fmt.Fprintf(f, authPromptTemplate, title, html.EscapeString(page.URL), html.EscapeString(page.Status), challenges)
+	if err := f.Close(); err != nil {
+		return false, err
+	}
+
+	return a.capture((&neturl.URL{Scheme: "file", Path: filepath.ToSlash(f.Name())}).String(), path, nil)
 }
 
-func (a *URLScreenshotter) killChromeProcessIfRunning(cmd *exec.Cmd) {
-	if cmd.Process == nil {
+const authPromptTemplate = `<!doctype html>
+<html>
+<head>
+<meta charset="utf-8">
+<style>
+body { font-family: sans-serif; background: #f1f3f4; color: #202124; margin: 0; }
+.prompt { background: #fff; width: 480px; margin: 80px auto; padding: 24px; border-radius: 8px; box-shadow: 0 2px 6px rgba(0,0,0,.3); }
+h1 { font-size: 20px; font-weight: normal; margin-top: 0; }
+.url { word-break: break-all; color: #5f6368; }
+table { width: 100%%; border-collapse: collapse; margin-top: 16px; }
+th, td { text-align: left; padding: 6px; border-bottom: 1px solid #dadce0; }
+</style>
+</head>
+<body>
+<div class="prompt">
+<h1>%s</h1>
+<p class="url">%s</p>
+<p>%s</p>
+<table>
+<tr><th>Scheme</th><th>Realm</th></tr>
+%s</table>
+</div>
+</body>
+</html>
+`
+
+// hashScreenshot records a perceptual hash of the screenshot at path, which
+// is used to tell whether a page looks different from a previous scan.
+func (a *URLScreenshotter) hashScreenshot(page *core.Page, path string) {
+	f, err := os.Open(a.session.GetFilePath(path))
+	if err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		return
+	}
+	defer f.Close()
+
+	hash, err := core.PerceptualHash(f)
+	if err != nil {
+		a.session.Out.Debug("[%s] Unable to hash screenshot of %s: %v\n", a.ID(), page.URL, err)
 		return
 	}
-	cmd.Process.Release()
-	cmd.Process.Kill()
+	page.ScreenshotHash = hash
 }
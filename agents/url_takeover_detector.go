@@ -2,7 +2,6 @@ package agents
 
 import (
 	"fmt"
-	"net"
 	"strings"
 
 	"github.com/mk990/aquatone/core"
@@ -42,18 +41,19 @@ func (a *URLTakeoverDetector) OnURLResponsive(u string) {
 	a.session.WaitGroup.Add()
 	go func(p *core.Page) {
 		defer a.session.WaitGroup.Done()
+		defer a.session.TrackAgent(a.ID())()
 		a.runDetectorFunctions(p)
 	}(page)
 }
 
 func (a *URLTakeoverDetector) runDetectorFunctions(page *core.Page) {
 	hostname := page.ParsedURL().Hostname()
-	addrs, err := net.LookupHost(fmt.Sprintf("%s.", hostname))
+	addrs, err := a.session.LookupHost(fmt.Sprintf("%s.", hostname))
 	if err != nil {
 		a.session.Out.Error("Unable to resolve %s to IP addresses: %s\n", hostname, err)
 		return
 	}
-	cname, err := net.LookupCNAME(fmt.Sprintf("%s.", hostname))
+	cname, err := a.session.LookupCNAME(fmt.Sprintf("%s.", hostname))
 	if err != nil {
 		a.session.Out.Error("Unable to resolve %s to CNAME: %s\n", hostname, err)
 		return
@@ -157,8 +157,7 @@ func (a *URLTakeoverDetector) detectGithubPages(p *core.Page, addrs []string, cn
 			if addr == githubAddr {
 				for _, fingerprint := range fingerprints {
 					if strings.Contains(body, fingerprint) {
-						p.AddTag("Domain Takeover", "danger", "https://help.github.com/articles/using-a-custom-domain-with-github-pages/")
-						a.session.Out.Warn("%s: vulnerable to takeover on Github Pages\n", p.URL)
+						a.reportTakeover(p, "Github Pages", "https://help.github.com/articles/using-a-custom-domain-with-github-pages/", cname, fingerprint, nil)
 						return true
 					}
 				}
@@ -176,8 +175,7 @@ func (a *URLTakeoverDetector) detectAmazonS3(p *core.Page, addrs []string, cname
 	}
 	for _, fingerprint := range fingerprints {
 		if strings.Contains(body, fingerprint) {
-			p.AddTag("Domain Takeover", "danger", "https://docs.aws.amazon.com/AmazonS3/latest/dev/website-hosting-custom-domain-walkthrough.html")
-			a.session.Out.Warn("%s: vulnerable to takeover on Amazon S3\n", p.URL)
+			a.reportTakeover(p, "Amazon S3", "https://docs.aws.amazon.com/AmazonS3/latest/dev/website-hosting-custom-domain-walkthrough.html", cname, fingerprint, a.verifyAmazonS3)
 			return true
 		}
 	}
@@ -190,8 +188,7 @@ func (a *URLTakeoverDetector) detectCampaignMonitor(p *core.Page, addrs []string
 	}
 	p.AddTag("Campaign Monitor", "info", "https://www.campaignmonitor.com/")
 	if strings.Contains(body, "Double check the URL or ") {
-		p.AddTag("Domain Takeover", "danger", "https://help.campaignmonitor.com/custom-domain-names")
-		a.session.Out.Warn("%s: vulnerable to takeover on Campaign Monitor\n", p.URL)
+		a.reportTakeover(p, "Campaign Monitor", "https://help.campaignmonitor.com/custom-domain-names", cname, "Double check the URL or ", nil)
 		return true
 	}
 	return true
@@ -203,8 +200,7 @@ func (a *URLTakeoverDetector) detectCargoCollective(p *core.Page, addrs []string
 	}
 	p.AddTag("Cargo Collective", "info", "https://cargocollective.com/")
 	if strings.Contains(body, "404 Not Found") {
-		p.AddTag("Domain Takeover", "danger", "https://support.2.cargocollective.com/Using-a-Third-Party-Domain")
-		a.session.Out.Warn("%s: vulnerable to takeover on Cargo Collective\n", p.URL)
+		a.reportTakeover(p, "Cargo Collective", "https://support.2.cargocollective.com/Using-a-Third-Party-Domain", cname, "404 Not Found", nil)
 		return true
 	}
 	return true
@@ -216,8 +212,7 @@ func (a *URLTakeoverDetector) detectFeedPress(p *core.Page, addrs []string, cnam
 	}
 	p.AddTag("FeedPress", "info", "https://feed.press/")
 	if strings.Contains(body, "The feed has not been found.") {
-		p.AddTag("Domain Takeover", "danger", "https://support.feed.press/article/61-how-to-create-a-custom-hostname")
-		a.session.Out.Warn("%s: vulnerable to takeover on FeedPress\n", p.URL)
+		a.reportTakeover(p, "FeedPress", "https://support.feed.press/article/61-how-to-create-a-custom-hostname", cname, "The feed has not been found.", nil)
 		return true
 	}
 	return true
@@ -228,8 +223,7 @@ func (a *URLTakeoverDetector) detectGhost(p *core.Page, addrs []string, cname st
 		return false
 	}
 	if strings.Contains(body, "The thing you were looking for is no longer here, or never was") {
-		p.AddTag("Domain Takeover", "danger", "https://docs.ghost.org/faq/using-custom-domains/")
-		a.session.Out.Warn("%s: vulnerable to takeover on Ghost\n", p.URL)
+		a.reportTakeover(p, "Ghost", "https://docs.ghost.org/faq/using-custom-domains/", cname, "The thing you were looking for is no longer here, or never was", a.verifyServiceName(".ghost.io.", "The thing you were looking for is no longer here, or never was"))
 		return true
 	}
 	return true
@@ -241,8 +235,7 @@ func (a *URLTakeoverDetector) detectHelpjuice(p *core.Page, addrs []string, cnam
 	}
 	p.AddTag("Helpjuice", "info", "https://helpjuice.com/")
 	if strings.Contains(body, "We could not find what you're looking for.") {
-		p.AddTag("Domain Takeover", "danger", "https://help.helpjuice.com/34339-getting-started/custom-domain")
-		a.session.Out.Warn("%s: vulnerable to takeover on Helpjuice\n", p.URL)
+		a.reportTakeover(p, "Helpjuice", "https://help.helpjuice.com/34339-getting-started/custom-domain", cname, "We could not find what you're looking for.", a.verifyServiceName(".helpjuice.com.", "We could not find what you're looking for."))
 		return true
 	}
 	return false
@@ -254,8 +247,7 @@ func (a *URLTakeoverDetector) detectHelpScout(p *core.Page, addrs []string, cnam
 	}
 	p.AddTag("HelpScout", "info", "https://www.helpscout.net/")
 	if strings.Contains(body, "No settings were found for this company:") {
-		p.AddTag("Domain Takeover", "danger", "https://docs.helpscout.net/article/42-setup-custom-domain")
-		a.session.Out.Warn("%s: vulnerable to takeover on HelpScout\n", p.URL)
+		a.reportTakeover(p, "HelpScout", "https://docs.helpscout.net/article/42-setup-custom-domain", cname, "No settings were found for this company:", a.verifyServiceName(".helpscoutdocs.com.", "No settings were found for this company:"))
 		return true
 	}
 	return true
@@ -267,8 +259,7 @@ func (a *URLTakeoverDetector) detectHeroku(p *core.Page, addrs []string, cname s
 		if strings.HasSuffix(cname, herokuCname) {
 			p.AddTag("Heroku", "info", "https://www.heroku.com/")
 			if strings.Contains(body, "No such app") {
-				p.AddTag("Domain Takeover", "danger", "https://devcenter.heroku.com/articles/custom-domains")
-				a.session.Out.Warn("%s: vulnerable to takeover on Heroku\n", p.URL)
+				a.reportTakeover(p, "Heroku", "https://devcenter.heroku.com/articles/custom-domains", cname, "No such app", a.verifyServiceName(".herokuapp.com.", "No such app"))
 				return true
 			}
 			return true
@@ -283,8 +274,7 @@ func (a *URLTakeoverDetector) detectJetBrains(p *core.Page, addrs []string, cnam
 	}
 	p.AddTag("JetBrains", "info", "https://www.jetbrains.com/")
 	if strings.Contains(body, "is not a registered InCloud YouTrack") {
-		p.AddTag("Domain Takeover", "danger", "https://www.jetbrains.com/help/youtrack/incloud/Domain-Settings.html#use-custom-domain-name")
-		a.session.Out.Warn("%s: vulnerable to takeover on JetBrains\n", p.URL)
+		a.reportTakeover(p, "JetBrains", "https://www.jetbrains.com/help/youtrack/incloud/Domain-Settings.html#use-custom-domain-name", cname, "is not a registered InCloud YouTrack", a.verifyServiceName(".myjetbrains.com.", "is not a registered InCloud YouTrack"))
 		return true
 	}
 	return true
@@ -296,8 +286,7 @@ func (a *URLTakeoverDetector) detectMicrosoftAzure(p *core.Page, addrs []string,
 	}
 	p.AddTag("Microsoft Azure", "info", "https://azure.microsoft.com/")
 	if strings.Contains(body, "404 Web Site not found") {
-		p.AddTag("Domain Takeover", "danger", "https://docs.microsoft.com/en-us/azure/app-service/app-service-web-tutorial-custom-domain")
-		a.session.Out.Warn("%s: vulnerable to takeover on Microsoft Azure\n", p.URL)
+		a.reportTakeover(p, "Microsoft Azure", "https://docs.microsoft.com/en-us/azure/app-service/app-service-web-tutorial-custom-domain", cname, "404 Web Site not found", nil)
 		return true
 	}
 	return true
@@ -309,8 +298,7 @@ func (a *URLTakeoverDetector) detectReadme(p *core.Page, addrs []string, cname s
 		if strings.HasSuffix(cname, readmeCname) {
 			p.AddTag("Readme", "info", "https://readme.io/")
 			if strings.Contains(body, "Project doesnt exist... yet!") {
-				p.AddTag("Domain Takeover", "danger", "https://readme.readme.io/docs/setting-up-custom-domain")
-				a.session.Out.Warn("%s: vulnerable to takeover on Readme\n", p.URL)
+				a.reportTakeover(p, "Readme", "https://readme.readme.io/docs/setting-up-custom-domain", cname, "Project doesnt exist... yet!", a.verifyServiceName(".readme.io.", "Project doesnt exist... yet!"))
 				return true
 			}
 			return true
@@ -333,8 +321,7 @@ func (a *URLTakeoverDetector) detectSurge(p *core.Page, addrs []string, cname st
 	if detected {
 		p.AddTag("Surge", "info", "https://surge.sh/")
 		if strings.Contains(body, "project not found") {
-			p.AddTag("Domain Takeover", "danger", "https://surge.sh/help/adding-a-custom-domain")
-			a.session.Out.Warn("%s: vulnerable to takeover on Surge\n", p.URL)
+			a.reportTakeover(p, "Surge", "https://surge.sh/help/adding-a-custom-domain", cname, "project not found", nil)
 		}
 		return true
 	}
@@ -354,8 +341,7 @@ func (a *URLTakeoverDetector) detectTumblr(p *core.Page, addrs []string, cname s
 	}
 	if detected {
 		if strings.Contains(body, "Whatever you were looking for doesn't currently exist at this address") {
-			p.AddTag("Domain Takeover", "danger", "https://tumblr.zendesk.com/hc/en-us/articles/231256548-Custom-domains")
-			a.session.Out.Warn("%s: vulnerable to takeover on Tumblr\n", p.URL)
+			a.reportTakeover(p, "Tumblr", "https://tumblr.zendesk.com/hc/en-us/articles/231256548-Custom-domains", cname, "Whatever you were looking for doesn't currently exist at this address", nil)
 		}
 		return true
 	}
@@ -368,8 +354,7 @@ func (a *URLTakeoverDetector) detectUserVoice(p *core.Page, addrs []string, cnam
 	}
 	p.AddTag("UserVoice", "info", "https://www.uservoice.com/")
 	if strings.Contains(body, "This UserVoice subdomain is currently available!") {
-		p.AddTag("Domain Takeover", "danger", "https://developer.uservoice.com/docs/site/domain-aliasing/")
-		a.session.Out.Warn("%s: vulnerable to takeover on UserVoice\n", p.URL)
+		a.reportTakeover(p, "UserVoice", "https://developer.uservoice.com/docs/site/domain-aliasing/", cname, "This UserVoice subdomain is currently available!", a.verifyServiceName(".uservoice.com.", "This UserVoice subdomain is currently available!"))
 	}
 	return true
 }
@@ -379,8 +364,7 @@ func (a *URLTakeoverDetector) detectWordpress(p *core.Page, addrs []string, cnam
 		return false
 	}
 	if strings.Contains(body, "Do you want to register") {
-		p.AddTag("Domain Takeover", "danger", "https://en.support.wordpress.com/domains/map-subdomain/")
-		a.session.Out.Warn("%s: vulnerable to takeover on Wordpress\n", p.URL)
+		a.reportTakeover(p, "Wordpress", "https://en.support.wordpress.com/domains/map-subdomain/", cname, "Do you want to register", a.verifyServiceName(".wordpress.com.", "Do you want to register"))
 	}
 	return true
 }
@@ -391,8 +375,7 @@ func (a *URLTakeoverDetector) detectSmugMug(p *core.Page, addrs []string, cname
 	}
 	p.AddTag("SmugMug", "info", "https://www.smugmug.com/")
 	if body == "" {
-		p.AddTag("Domain Takeover", "danger", "https://help.smugmug.com/use-a-custom-domain-BymMexwJVHG")
-		a.session.Out.Warn("%s: vulnerable to takeover on SmugMug\n", p.URL)
+		a.reportTakeover(p, "SmugMug", "https://help.smugmug.com/use-a-custom-domain-BymMexwJVHG", cname, "", nil)
 	}
 	return true
 }
@@ -411,8 +394,7 @@ func (a *URLTakeoverDetector) detectStrikingly(p *core.Page, addrs []string, cna
 	if detected {
 		p.AddTag("Strikingly", "info", "https://www.strikingly.com/")
 		if strings.Contains(body, "But if you're looking to build your own website,") {
-			p.AddTag("Domain Takeover", "danger", "https://support.strikingly.com/hc/en-us/articles/215046947-Connect-Custom-Domain")
-			a.session.Out.Warn("%s: vulnerable to takeover on Strikingly\n", p.URL)
+			a.reportTakeover(p, "Strikingly", "https://support.strikingly.com/hc/en-us/articles/215046947-Connect-Custom-Domain", cname, "But if you're looking to build your own website,", nil)
 		}
 		return true
 	}
@@ -425,8 +407,7 @@ func (a *URLTakeoverDetector) detectUptimeRobot(p *core.Page, addrs []string, cn
 	}
 	p.AddTag("UptimeRobot", "info", "https://uptimerobot.com/")
 	if strings.Contains(body, "This public status page <b>does not seem to exist</b>.") {
-		p.AddTag("Domain Takeover", "danger", "https://blog.uptimerobot.com/introducing-public-status-pages-yay/")
-		a.session.Out.Warn("%s: vulnerable to takeover on UptimeRobot\n", p.URL)
+		a.reportTakeover(p, "UptimeRobot", "https://blog.uptimerobot.com/introducing-public-status-pages-yay/", cname, "This public status page <b>does not seem to exist</b>.", nil)
 	}
 	return true
 }
@@ -437,8 +418,7 @@ func (a *URLTakeoverDetector) detectPantheon(p *core.Page, addrs []string, cname
 	}
 	p.AddTag("Pantheon", "info", "https://pantheon.io/")
 	if strings.Contains(body, "The gods are wise") {
-		p.AddTag("Domain Takeover", "danger", "https://pantheon.io/docs/domains/")
-		a.session.Out.Warn("%s: vulnerable to takeover on Pantheon\n", p.URL)
+		a.reportTakeover(p, "Pantheon", "https://pantheon.io/docs/domains/", cname, "The gods are wise", a.verifyServiceName(".pantheonsite.io.", "The gods are wise"))
 	}
 	return true
 }
@@ -15,6 +15,26 @@ type FingerprintRegexp struct {
 	Regexp *regexp.Regexp
 }
 
+// Version returns the first non-empty submatch of the pattern in s, which
+// by convention of the fingerprints holds the version of the technology.
+func (f FingerprintRegexp) Version(s string) string {
+	matches := f.Regexp.FindStringSubmatch(s)
+	if len(matches) < 2 {
+		return ""
+	}
+	for _, m := range matches[1:] {
+		if m != "" {
+			return m
+		}
+	}
+	return ""
+}
+
+type technologyMatch struct {
+	Fingerprint Fingerprint
+	Version     string
+}
+
 type Fingerprint struct {
 	Name               string            `json:"name"`
 	Categories         []string          `json:"categories"`
@@ -121,14 +141,23 @@ func (a *URLTechnologyFingerprinter) OnURLResponsive(url string) {
 	a.session.WaitGroup.Add()
 	go func(page *core.Page) {
 		defer a.session.WaitGroup.Done()
+		defer a.session.TrackAgent(a.ID())()
 		seen := make(map[string]struct{})
-		fingerprints := append(a.fingerprintHeaders(page), a.fingerprintBody(page)...)
-		for _, f := range fingerprints {
+		matches := append(a.fingerprintHeaders(page), a.fingerprintBody(page)...)
+		versions := make(map[string]string)
+		for _, m := range matches {
+			if m.Version != "" && versions[m.Fingerprint.Name] == "" {
+				versions[m.Fingerprint.Name] = m.Version
+			}
+		}
+		for _, m := range matches {
+			f := m.Fingerprint
 			if _, ok := seen[f.Name]; ok {
 				continue
 			}
 			seen[f.Name] = struct{}{}
 			page.AddTag(f.Name, "info", f.Website)
+			page.AddTechnology(f.Name, versions[f.Name], f.Categories, f.Website)
 			for _, impl := range f.Implies {
 				if _, ok := seen[impl]; ok {
 					continue
@@ -137,6 +166,7 @@ func (a *URLTechnologyFingerprinter) OnURLResponsive(url string) {
 				for _, implf := range a.fingerprints {
 					if impl == implf.Name {
 						page.AddTag(implf.Name, "info", implf.Website)
+						page.AddTechnology(implf.Name, "", implf.Categories, implf.Website)
 						break
 					}
 				}
@@ -145,8 +175,8 @@ func (a *URLTechnologyFingerprinter) OnURLResponsive(url string) {
 	}(page)
 }
 
-func (a *URLTechnologyFingerprinter) fingerprintHeaders(page *core.Page) []Fingerprint {
-	var technologies []Fingerprint
+func (a *URLTechnologyFingerprinter) fingerprintHeaders(page *core.Page) []technologyMatch {
+	var technologies []technologyMatch
 
 	for _, header := range page.Headers {
 		for _, fingerprint := range a.fingerprints {
@@ -157,7 +187,7 @@ func (a *URLTechnologyFingerprinter) fingerprintHeaders(page *core.Page) []Finge
 
 				if pattern.Regexp.MatchString(header.Value) {
 					a.session.Out.Debug("[%s] Identified technology %s on %s from %s response header\n", a.ID(), fingerprint.Name, page.URL, header.Name)
-					technologies = append(technologies, fingerprint)
+					technologies = append(technologies, technologyMatch{fingerprint, pattern.Version(header.Value)})
 				}
 			}
 		}
@@ -166,8 +196,8 @@ func (a *URLTechnologyFingerprinter) fingerprintHeaders(page *core.Page) []Finge
 	return technologies
 }
 
-func (a *URLTechnologyFingerprinter) fingerprintBody(page *core.Page) []Fingerprint {
-	var technologies []Fingerprint
+func (a *URLTechnologyFingerprinter) fingerprintBody(page *core.Page) []technologyMatch {
+	var technologies []technologyMatch
 	body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
 	if err != nil {
 		a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
@@ -187,7 +217,7 @@ func (a *URLTechnologyFingerprinter) fingerprintBody(page *core.Page) []Fingerpr
 		for _, pattern := range fingerprint.HTMLFingerprints {
 			if pattern.Regexp.MatchString(strBody) {
 				a.session.Out.Debug("[%s] Identified technology %s on %s from HTML\n", a.ID(), fingerprint.Name, page.URL)
-				technologies = append(technologies, fingerprint)
+				technologies = append(technologies, technologyMatch{fingerprint, pattern.Version(strBody)})
 			}
 		}
 
@@ -196,7 +226,7 @@ func (a *URLTechnologyFingerprinter) fingerprintBody(page *core.Page) []Fingerpr
 				if script, exists := s.Attr("src"); exists {
 					if pattern.Regexp.MatchString(script) {
 						a.session.Out.Debug("[%s] Identified technology %s on %s from script tag\n", a.ID(), fingerprint.Name, page.URL)
-						technologies = append(technologies, fingerprint)
+						technologies = append(technologies, technologyMatch{fingerprint, pattern.Version(script)})
 					}
 				}
 			})
@@ -208,7 +238,7 @@ func (a *URLTechnologyFingerprinter) fingerprintBody(page *core.Page) []Fingerpr
 					content, _ := s.Attr("content")
 					if pattern.Regexp.MatchString(content) {
 						a.session.Out.Debug("[%s] Identified technology %s on %s from meta tag\n", a.ID(), fingerprint.Name, page.URL)
-						technologies = append(technologies, fingerprint)
+						technologies = append(technologies, technologyMatch{fingerprint, pattern.Version(content)})
 					}
 				}
 			})
@@ -0,0 +1,113 @@
+package agents
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/mk990/aquatone/core"
+	"github.com/parnurzeal/gorequest"
+)
+
+// URLWellKnownFetcher fetches security.txt and other well-known URIs from
+// the base URL of every web server, and records on the page the base URL
+// was found with which of them the server publishes.
+type URLWellKnownFetcher struct {
+	session *core.Session
+	bases   sync.Map
+}
+
+func NewURLWellKnownFetcher() *URLWellKnownFetcher {
+	return &URLWellKnownFetcher{}
+}
+
+func (a *URLWellKnownFetcher) ID() string {
+	return "agent:url_well_known_fetcher"
+}
+
+func (a *URLWellKnownFetcher) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLWellKnownFetcher) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+	base := BaseURL(page.URL)
+	if _, fetched := a.bases.LoadOrStore(base, true); fetched || base == "" {
+		return
+	}
+
+	release := a.session.AcquireHostSlot(page.Hostname)
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		defer release()
+		defer a.session.TrackAgent(a.ID())()
+		// Servers answering every path make the mere presence of
+		// change-password meaningless
+		resp, _, errs := a.get(base + "/.well-known/aquatone-" + page.UUID)
+		catchAll := errs == nil && resp.StatusCode < 300
+
+		for _, name := range core.WellKnownNames {
+			uri := a.fetch(base, name, catchAll)
+			if uri.Name == core.WellKnownSecurityTxt && uri.Found && uri.Expired(a.session.Clock.Now()) {
+				page.AddNote(fmt.Sprintf("security.txt at %s expired on %s", uri.URL, uri.Expires), core.SeverityLow)
+			}
+			page.AddWellKnownURI(uri)
+		}
+	}(page)
+}
+
+// fetch requests a well-known URI. security.txt is also looked for at the
+// root, where RFC 9116 allows it for legacy reasons.
+func (a *URLWellKnownFetcher) fetch(base string, name string, catchAll bool) core.WellKnownURI {
+	urls := []string{base + "/.well-known/" + name}
+	if name == core.WellKnownSecurityTxt {
+		urls = append(urls, base+"/security.txt")
+	}
+
+	uri := core.WellKnownURI{Name: name, URL: urls[0]}
+	for _, u := range urls {
+		resp, body, errs := a.get(u)
+		if errs != nil {
+			a.session.Out.Debug("[%s] Error requesting %s: %v\n", a.ID(), u, errs[0])
+			continue
+		}
+		if u == urls[0] {
+			uri.Status = resp.Status
+		}
+		if resp.StatusCode != 200 {
+			continue
+		}
+		body, _ = DecodeBody(resp.Header.Get("Content-Encoding"), body)
+
+		if name == core.WellKnownChangePassword {
+			if catchAll {
+				break
+			}
+			uri.Found = true
+			if resp.Request != nil && resp.Request.URL.String() != u {
+				uri.Location = resp.Request.URL.String()
+			}
+			break
+		}
+		if core.ValidWellKnown(name, body) {
+			uri.Found, uri.URL, uri.Status = true, u, resp.Status
+			uri.SetContent(body)
+			break
+		}
+	}
+	return uri
+}
+
+func (a *URLWellKnownFetcher) get(u string) (gorequest.Response, []byte, []error) {
+	return PinnedGorequest(a.session).Get(u).
+		Set("User-Agent", RandomUserAgent(a.session)).
+		Set("Accept-Encoding", "gzip, deflate, br").EndBytes()
+}
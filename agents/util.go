@@ -1,11 +1,17 @@
 package agents
 
 import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
 	"crypto/sha1"
 	"crypto/tls"
 	"fmt"
 	"io"
-	"math/rand"
+	"io/ioutil"
+	"net"
 	"net/url"
 	"strconv"
 	"strings"
@@ -13,6 +19,7 @@ import (
 
 	"github.com/mk990/aquatone/core"
 
+	"github.com/andybalholm/brotli"
 	"github.com/fatih/color"
 	"github.com/parnurzeal/gorequest"
 )
@@ -75,15 +82,16 @@ var (
 	red    = color.New(color.FgRed).SprintFunc()
 )
 
-func RandomUserAgent() string {
-	return UserAgents[rand.Intn(len(UserAgents))]
+// RandomUserAgent returns a user agent picked with the random source of the
+// session, so the picks are reproducible with --seed.
+func RandomUserAgent(s *core.Session) string {
+	return UserAgents[s.Random.Intn(len(UserAgents))]
 }
 
-func RandomIPv4Address() string {
-	rand.Seed(time.Now().UnixNano())
+func RandomIPv4Address(s *core.Session) string {
 	blocks := []string{}
 	for i := 0; i < 4; i++ {
-		number := rand.Intn(255)
+		number := s.Random.Intn(255)
 		blocks = append(blocks, strconv.Itoa(number))
 	}
 
@@ -94,12 +102,92 @@ func URLEscape(s string) string {
 	return url.QueryEscape(s)
 }
 
-func Gorequest(o core.Options) *gorequest.SuperAgent {
-	return gorequest.New().
+// DecodeBody decodes a response body according to the value of its
+// Content-Encoding header. Multiple encodings are undone in reverse order.
+func DecodeBody(contentEncoding string, body []byte) ([]byte, error) {
+	encodings := strings.Split(contentEncoding, ",")
+	for i := len(encodings) - 1; i >= 0; i-- {
+		var r io.Reader
+		var err error
+		switch strings.ToLower(strings.TrimSpace(encodings[i])) {
+		case "", "identity":
+			continue
+		case "gzip", "x-gzip":
+			r, err = gzip.NewReader(bytes.NewReader(body))
+		case "deflate":
+			// Servers send both zlib wrapped and raw deflate streams
+			r, err = zlib.NewReader(bytes.NewReader(body))
+			if err != nil {
+				r, err = flate.NewReader(bytes.NewReader(body)), nil
+			}
+		case "br":
+			r = brotli.NewReader(bytes.NewReader(body))
+		default:
+			return body, fmt.Errorf("unsupported content encoding: %s", encodings[i])
+		}
+		if err != nil {
+			return body, err
+		}
+		if body, err = ioutil.ReadAll(r); err != nil {
+			return body, err
+		}
+	}
+	return body, nil
+}
+
+// Gorequest returns a request agent configured from the session options.
+// Connections are made with the session dialer.
+func Gorequest(s *core.Session) *gorequest.SuperAgent {
+	o := s.Options
+	timeout := time.Duration(*o.HTTPTimeout) * time.Millisecond
+	agent := gorequest.New().
 		Proxy(*o.Proxy).
-		Timeout(time.Duration(*o.HTTPTimeout) * time.Millisecond).
 		SetDebug(*o.Debug).
 		TLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+	agent.Transport.Dial = func(network, address string) (net.Conn, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		conn, err := s.Dialer.DialContext(ctx, network, address)
+		if err != nil {
+			return nil, err
+		}
+		conn.SetDeadline(s.Clock.Now().Add(timeout))
+		return conn, nil
+	}
+	if *o.TLSFingerprint != "" && *o.TLSFingerprint != core.TLSFingerprintGo {
+		agent.Transport.DialTLS = fingerprintDialTLS(agent.Transport, *o.TLSFingerprint)
+	}
+	return agent
+}
+
+// PinnedGorequest returns a request agent whose connections go to the IP
+// addresses that answered during port scanning.
+func PinnedGorequest(s *core.Session) *gorequest.SuperAgent {
+	agent := Gorequest(s)
+	dial := agent.Transport.Dial
+	agent.Transport.Dial = func(network, address string) (net.Conn, error) {
+		return dial(network, s.DialAddress(address))
+	}
+	return agent
+}
+
+// recordHostIPs records the addresses a host resolved to, and the names in
+// the PTR records of the ones that weren't known yet and, with --lookup-asn,
+// their autonomous systems.
+func recordHostIPs(s *core.Session, host string, ips []string) {
+	for _, ip := range s.AddHostIPs(host, ips) {
+		if names, err := s.LookupAddr(ip); err == nil {
+			s.AddHostPTRs(host, names)
+		}
+		if !*s.Options.LookupASN {
+			continue
+		}
+		if asn, err := s.LookupASN(ip); err != nil {
+			s.Out.Debug("Failed to look up the ASN of %s: %v\n", ip, err)
+		} else if asn != nil {
+			s.AddHostASN(host, *asn)
+		}
+	}
 }
 
 func BaseFilenameFromURL(s string) string {
@@ -118,6 +206,16 @@ func BaseFilenameFromURL(s string) string {
 	return strings.ToLower(filename)
 }
 
+// BaseURL returns the scheme and host of a URL, like https://example.com:8443,
+// which paths are probed on once per web server.
+func BaseURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
+
 func HostAndPortToURL(host string, port int, protocol string) string {
 	return core.HostAndPortToURL(host, port, protocol)
 }
@@ -0,0 +1,99 @@
+package agents
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// Xvfb gets this long to start and report the display it listens on.
+const virtualDisplayStartTimeout = 10 * time.Second
+
+// virtualDisplay is an X server without a screen, started with Xvfb for
+// Chrome windows to be drawn on when Chrome runs with --headful on a
+// machine without a desktop.
+type virtualDisplay struct {
+	cmd     *exec.Cmd
+	display string
+	exited  chan struct{}
+}
+
+// needsDisplay reports whether Chrome needs an X or Wayland display to open
+// windows on this platform. On Windows and macOS it uses the desktop of the
+// user running Aquatone.
+func needsDisplay() bool {
+	return runtime.GOOS != "windows" && runtime.GOOS != "darwin"
+}
+
+// startVirtualDisplay starts Xvfb with a screen of the given size. Xvfb
+// picks a free display number itself and writes it to the pipe it is given
+// with -displayfd, so scans running at the same time don't clash.
+func startVirtualDisplay(runner core.CommandRunner, width int, height int) (*virtualDisplay, error) {
+	path, err := exec.LookPath("Xvfb")
+	if err != nil {
+		return nil, errors.New("Xvfb is not installed, install it (e.g. apt install xvfb) or give a display with --display")
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	d := &virtualDisplay{
+		cmd:    runner.CommandContext(context.Background(), path, "-displayfd", "3", "-screen", "0", fmt.Sprintf("%dx%dx24", width, height), "-nolisten", "tcp"),
+		exited: make(chan struct{}),
+	}
+	d.cmd.ExtraFiles = []*os.File{w}
+	setProcAttr(d.cmd)
+	err = d.cmd.Start()
+	w.Close()
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		d.cmd.Wait()
+		close(d.exited)
+	}()
+
+	number := make(chan string, 1)
+	go func() {
+		// Xvfb closes the pipe when it exits, ending the read
+		line, _ := bufio.NewReader(r).ReadString('\n')
+		number <- strings.TrimSpace(line)
+	}()
+
+	select {
+	case n := <-number:
+		if n == "" {
+			d.stop()
+			return nil, errors.New("Xvfb exited on start")
+		}
+		d.display = ":" + n
+		return d, nil
+	case <-time.After(virtualDisplayStartTimeout):
+		d.stop()
+		return nil, fmt.Errorf("Xvfb did not start within %s", virtualDisplayStartTimeout)
+	}
+}
+
+// stop asks Xvfb to exit, which lets it remove its lock file, and kills it
+// if it doesn't.
+func (d *virtualDisplay) stop() {
+	d.cmd.Process.Signal(syscall.SIGTERM)
+	select {
+	case <-d.exited:
+	case <-time.After(5 * time.Second):
+		d.cmd.Process.Kill()
+		<-d.exited
+	}
+}
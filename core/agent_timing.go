@@ -0,0 +1,75 @@
+package core
+
+import (
+	"sort"
+	"time"
+)
+
+// AgentTiming holds the number of items an agent has processed and the time
+// spent on them. Busy is the sum of the time spent on every item, so it can
+// be larger than the elapsed time when items are processed concurrently.
+type AgentTiming struct {
+	Agent      string        `json:"agent"`
+	Items      int           `json:"items"`
+	Busy       time.Duration `json:"busy"`
+	StartedAt  time.Time     `json:"startedAt"`
+	FinishedAt time.Time     `json:"finishedAt"`
+}
+
+// Elapsed returns the time from when the agent started processing its first
+// item until it finished its last.
+func (t *AgentTiming) Elapsed() time.Duration {
+	return t.FinishedAt.Sub(t.StartedAt)
+}
+
+// Average returns the average time spent on an item.
+func (t *AgentTiming) Average() time.Duration {
+	if t.Items == 0 {
+		return 0
+	}
+	return t.Busy / time.Duration(t.Items)
+}
+
+// TrackAgent records that the agent with the given ID started processing an
+// item. The returned function must be called when the item is done:
+//
+//	defer a.session.TrackAgent(a.ID())()
+func (s *Session) TrackAgent(id string) func() {
+	start := s.Clock.Now()
+	return func() {
+		finish := s.Clock.Now()
+		s.agentTimingsMutex.Lock()
+		defer s.agentTimingsMutex.Unlock()
+
+		timing, ok := s.AgentTimings[id]
+		if !ok {
+			timing = &AgentTiming{Agent: id, StartedAt: start}
+			s.AgentTimings[id] = timing
+		}
+		timing.Items++
+		timing.Busy += finish.Sub(start)
+		if start.Before(timing.StartedAt) {
+			timing.StartedAt = start
+		}
+		if finish.After(timing.FinishedAt) {
+			timing.FinishedAt = finish
+		}
+	}
+}
+
+// SortedAgentTimings returns copies of the agent timings in the order the
+// agents started working, so it is safe to call while agents are running.
+func (s *Session) SortedAgentTimings() []*AgentTiming {
+	s.agentTimingsMutex.Lock()
+	defer s.agentTimingsMutex.Unlock()
+
+	var timings []*AgentTiming
+	for _, timing := range s.AgentTimings {
+		timing := *timing
+		timings = append(timings, &timing)
+	}
+	sort.Slice(timings, func(i, j int) bool {
+		return timings[i].StartedAt.Before(timings[j].StartedAt)
+	})
+	return timings
+}
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Types of API descriptions found with --probe-apis.
+const (
+	APIGraphQL = "graphql"
+	APIOpenAPI = "openapi"
+)
+
+// Paths of base URLs probed for OpenAPI (Swagger) documents and GraphQL
+// endpoints with --probe-apis.
+var (
+	OpenAPIPaths = []string{
+		"/openapi.json",
+		"/swagger.json",
+		"/swagger/v1/swagger.json",
+		"/v2/api-docs",
+		"/v3/api-docs",
+		"/api-docs",
+		"/api/openapi.json",
+		"/api/swagger.json",
+		"/.well-known/openapi.json",
+	}
+	GraphQLPaths = []string{
+		"/graphql",
+		"/api/graphql",
+		"/v1/graphql",
+	}
+)
+
+// GraphQLIntrospectionQuery asks a GraphQL endpoint for the operations of its
+// schema.
+const GraphQLIntrospectionQuery = `{"query":"query IntrospectionQuery { __schema { queryType { name } mutationType { name } subscriptionType { name } types { name fields { name } } } }"}`
+
+// APIDocument is an API description found on a base URL: an OpenAPI or
+// Swagger document, or the schema of a GraphQL endpoint retrieved with an
+// introspection query. Path is the saved document relative to the output
+// directory, which is empty for GraphQL endpoints with introspection
+// disabled.
+type APIDocument struct {
+	Type       string   `json:"type"`
+	URL        string   `json:"url"`
+	Path       string   `json:"path,omitempty"`
+	Spec       string   `json:"spec,omitempty"`
+	Title      string   `json:"title,omitempty"`
+	Version    string   `json:"version,omitempty"`
+	Operations []string `json:"operations,omitempty"`
+}
+
+var openAPIMethods = map[string]bool{
+	"get": true, "put": true, "post": true, "delete": true,
+	"options": true, "head": true, "patch": true, "trace": true,
+}
+
+// ParseOpenAPI parses an OpenAPI 3 or Swagger 2 document in JSON and lists
+// its operations, like "GET /users/{id}". It returns false if data isn't
+// such a document.
+func ParseOpenAPI(data []byte) (*APIDocument, bool) {
+	var spec struct {
+		Swagger string `json:"swagger"`
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Title   string `json:"title"`
+			Version string `json:"version"`
+		} `json:"info"`
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(data, &spec); err != nil || spec.Paths == nil {
+		return nil, false
+	}
+
+	doc := &APIDocument{Type: APIOpenAPI, Title: spec.Info.Title, Version: spec.Info.Version}
+	switch {
+	case spec.OpenAPI != "":
+		doc.Spec = "OpenAPI " + spec.OpenAPI
+	case spec.Swagger != "":
+		doc.Spec = "Swagger " + spec.Swagger
+	default:
+		return nil, false
+	}
+	for path, item := range spec.Paths {
+		for method := range item {
+			if openAPIMethods[strings.ToLower(method)] {
+				doc.Operations = append(doc.Operations, strings.ToUpper(method)+" "+path)
+			}
+		}
+	}
+	sort.Strings(doc.Operations)
+	return doc, true
+}
+
+// ParseGraphQLIntrospection parses the response to
+// GraphQLIntrospectionQuery and lists the operations of the schema, like
+// "query users" or "mutation createUser". It returns false if data isn't a
+// schema.
+func ParseGraphQLIntrospection(data []byte) (*APIDocument, bool) {
+	type typeName struct {
+		Name string `json:"name"`
+	}
+	var resp struct {
+		Data struct {
+			Schema *struct {
+				QueryType        *typeName `json:"queryType"`
+				MutationType     *typeName `json:"mutationType"`
+				SubscriptionType *typeName `json:"subscriptionType"`
+				Types            []struct {
+					Name   string     `json:"name"`
+					Fields []typeName `json:"fields"`
+				} `json:"types"`
+			} `json:"__schema"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil || resp.Data.Schema == nil {
+		return nil, false
+	}
+
+	schema := resp.Data.Schema
+	roots := make(map[string]string)
+	for kind, t := range map[string]*typeName{"query": schema.QueryType, "mutation": schema.MutationType, "subscription": schema.SubscriptionType} {
+		if t != nil && t.Name != "" {
+			roots[t.Name] = kind
+		}
+	}
+	doc := &APIDocument{Type: APIGraphQL, Spec: "GraphQL"}
+	for _, t := range schema.Types {
+		kind, ok := roots[t.Name]
+		if !ok {
+			continue
+		}
+		for _, field := range t.Fields {
+			doc.Operations = append(doc.Operations, fmt.Sprintf("%s %s", kind, field.Name))
+		}
+	}
+	sort.Strings(doc.Operations)
+	return doc, true
+}
+
+// IsGraphQLError reports whether data is a GraphQL response with errors,
+// which GraphQL endpoints answer with when introspection is disabled.
+func IsGraphQLError(data []byte) bool {
+	var resp struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return false
+	}
+	return len(resp.Errors) > 0 && resp.Errors[0].Message != ""
+}
+
+// AddAPIDocument records an API description found on the base URL of the
+// page.
+func (p *Page) AddAPIDocument(doc APIDocument) {
+	p.Lock()
+	defer p.Unlock()
+	p.APIDocuments = append(p.APIDocuments, doc)
+}
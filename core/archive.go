@@ -0,0 +1,199 @@
+package core
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+// Directories in the output directory that are included in archives along
+// with the aquatone_* files.
+var archiveDirs = []string{"screenshots", "headers", "html", "api"}
+
+// WriteArchive packages the report, session file, screenshots, headers and
+// bodies of the output directory into a single archive. Archives ending in
+// .zip are written as zip files and everything else as gzip compressed tar
+// files. Paths inside the archive are relative to the output directory so
+// the report works directly after extraction. If passphrase is not empty,
+// the archive is symmetrically encrypted with OpenPGP and can be decrypted
+// with gpg -d.
+func (s *Session) WriteArchive(filename string, passphrase string) error {
+	files, err := s.archiveFiles(filename)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var w io.WriteCloser = nopWriteCloser{f}
+	if passphrase != "" {
+		hints := &openpgp.FileHints{IsBinary: true, FileName: filepath.Base(strings.TrimSuffix(filename, ".gpg"))}
+		if w, err = openpgp.SymmetricallyEncrypt(f, []byte(passphrase), hints, nil); err != nil {
+			return err
+		}
+	}
+
+	if isZipArchive(filename) {
+		err = writeZipArchive(w, s.GetFilePath(""), files)
+	} else {
+		err = writeTarArchive(w, s.GetFilePath(""), files)
+	}
+	if err != nil {
+		w.Close()
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// archiveFiles returns the paths, relative to the output directory, of the
+// files to archive. The archive itself is left out in case it is written to
+// the output directory.
+func (s *Session) archiveFiles(filename string) ([]string, error) {
+	archivePath, _ := filepath.Abs(filename)
+	root := filepath.Clean(s.GetFilePath(""))
+
+	var files []string
+	add := func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		if abs, _ := filepath.Abs(path); abs == archivePath {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	}
+
+	matches, err := filepath.Glob(filepath.Join(root, "aquatone_*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range matches {
+		info, err := os.Stat(match)
+		if err := add(match, info, err); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, dir := range archiveDirs {
+		if err := filepath.Walk(filepath.Join(root, dir), add); err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+func writeTarArchive(w io.Writer, root string, files []string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	for _, name := range files {
+		if err := addTarFile(tw, root, name); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func addTarFile(tw *tar.Writer, root string, name string) error {
+	f, err := os.Open(filepath.Join(root, filepath.FromSlash(name)))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+func writeZipArchive(w io.Writer, root string, files []string) error {
+	zw := zip.NewWriter(w)
+
+	for _, name := range files {
+		if err := addZipFile(zw, root, name); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func addZipFile(zw *zip.Writer, root string, name string) error {
+	f, err := os.Open(filepath.Join(root, filepath.FromSlash(name)))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	header.Method = zip.Deflate
+
+	fw, err := zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, f)
+	return err
+}
+
+func isZipArchive(filename string) bool {
+	return strings.HasSuffix(strings.ToLower(strings.TrimSuffix(filename, ".gpg")), ".zip")
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+func TestWriteArchiveEncrypted(t *testing.T) {
+	outDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(outDir, "screenshots"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"aquatone_report.html":     "<html></html>",
+		"screenshots/example.png":  "png",
+		"not_part_of_the_scan.txt": "ignored",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(outDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	session := testSession()
+	session.Options.OutDir = &outDir
+
+	archive := filepath.Join(t.TempDir(), "results.tar.gz.gpg")
+	if err := session.WriteArchive(archive, "s3cret"); err != nil {
+		t.Fatalf("WriteArchive() failed: %v", err)
+	}
+
+	f, err := os.Open(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	prompted := false
+	md, err := openpgp.ReadMessage(f, nil, func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		if prompted {
+			t.Fatal("passphrase did not decrypt the archive")
+		}
+		prompted = true
+		return []byte("s3cret"), nil
+	}, nil)
+	if err != nil {
+		t.Fatalf("ReadMessage() failed: %v", err)
+	}
+	gz, err := gzip.NewReader(md.UnverifiedBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archived := make(map[string]string)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+		content, _ := ioutil.ReadAll(tr)
+		archived[header.Name] = string(content)
+	}
+	for _, name := range []string{"aquatone_report.html", "screenshots/example.png"} {
+		if archived[name] != files[name] {
+			t.Errorf("archive has %q for %s; want %q", archived[name], name, files[name])
+		}
+	}
+	if _, ok := archived["not_part_of_the_scan.txt"]; ok {
+		t.Error("archive contains a file that is not part of the scan")
+	}
+}
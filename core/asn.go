@@ -0,0 +1,89 @@
+package core
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ASN is an autonomous system that announces addresses of a host, looked
+// up with --lookup-asn in the IP to ASN mapping of Team Cymru, which answers
+// over DNS so the lookups go through the DNS cache like any other.
+type ASN struct {
+	Number  int    `json:"number"`
+	Name    string `json:"name,omitempty"`
+	Prefix  string `json:"prefix,omitempty"`
+	Country string `json:"country,omitempty"`
+}
+
+// Zones of the IP to ASN mapping of Team Cymru
+// (https://www.team-cymru.com/ip-asn-mapping).
+const (
+	asnOriginZone  = "origin.asn.cymru.com"
+	asnOrigin6Zone = "origin6.asn.cymru.com"
+	asnZone        = "asn.cymru.com"
+)
+
+// LookupASN returns the autonomous system that announces the address, or
+// nil if it isn't announced.
+func (s *Session) LookupASN(addr string) (*ASN, error) {
+	name, ok := reverseAddr(addr)
+	if !ok {
+		return nil, fmt.Errorf("Invalid address %q", addr)
+	}
+	if strings.HasSuffix(name, ".in-addr.arpa") {
+		name = strings.TrimSuffix(name, "in-addr.arpa") + asnOriginZone
+	} else {
+		name = strings.TrimSuffix(name, "ip6.arpa") + asnOrigin6Zone
+	}
+
+	// Answers look like "13335 | 1.1.1.0/24 | AU | apnic | 2011-08-11",
+	// with the numbers of all origins of prefixes announced by more than one
+	records, err := s.LookupTXT(name)
+	if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.IsNotFound {
+		return nil, nil
+	}
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+	fields := asnFields(records[0])
+	if len(fields) < 3 || len(strings.Fields(fields[0])) == 0 {
+		return nil, fmt.Errorf("Unexpected answer %q for %s", records[0], name)
+	}
+	number, err := strconv.Atoi(strings.Fields(fields[0])[0])
+	if err != nil {
+		return nil, fmt.Errorf("Unexpected answer %q for %s", records[0], name)
+	}
+	asn := &ASN{Number: number, Prefix: fields[1], Country: fields[2]}
+
+	// Names look like "13335 | US | arin | 2010-07-14 | CLOUDFLARENET, US"
+	if records, err := s.LookupTXT(fmt.Sprintf("AS%d.%s", number, asnZone)); err == nil && len(records) > 0 {
+		if fields := asnFields(records[0]); len(fields) >= 5 {
+			asn.Name = fields[4]
+		}
+	}
+	return asn, nil
+}
+
+// AddHostASN records an autonomous system that announces addresses of the
+// host.
+func (s *Session) AddHostASN(hostname string, asn ASN) {
+	s.Lock()
+	defer s.Unlock()
+	host := s.addHost(hostname, "")
+	for _, known := range host.ASNs {
+		if known.Number == asn.Number {
+			return
+		}
+	}
+	host.ASNs = append(host.ASNs, asn)
+}
+
+func asnFields(record string) []string {
+	fields := strings.Split(record, "|")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	return fields
+}
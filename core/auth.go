@@ -0,0 +1,124 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AuthChallenge is a challenge of a WWW-Authenticate header of a 401
+// response, or of a Proxy-Authenticate header of a 407 response when Proxy
+// is set. Pages behind HTTP authentication are often admin interfaces, and
+// the realm tends to name the product or device.
+type AuthChallenge struct {
+	Scheme string `json:"scheme"`
+	Realm  string `json:"realm,omitempty"`
+	Proxy  bool   `json:"proxy,omitempty"`
+}
+
+func (c AuthChallenge) String() string {
+	if c.Realm == "" {
+		return c.Scheme
+	}
+	return fmt.Sprintf("%s realm=%q", c.Scheme, c.Realm)
+}
+
+// ParseAuthChallenges parses the challenges of a WWW-Authenticate or
+// Proxy-Authenticate header value like `Basic realm="Router", NTLM`. Only
+// the realm parameter is kept.
+func ParseAuthChallenges(value string) []AuthChallenge {
+	var challenges []AuthChallenge
+	afterScheme := false
+	for pos := skipAuthSpaces(value, 0); pos < len(value); pos = skipAuthSpaces(value, pos) {
+		if value[pos] == ',' {
+			afterScheme = false
+			pos++
+			continue
+		}
+		token, next := readAuthToken(value, pos)
+		if token == "" {
+			pos++
+			continue
+		}
+		pos = skipAuthSpaces(value, next)
+
+		switch {
+		case pos < len(value) && value[pos] == '=':
+			padding := pos
+			for padding < len(value) && value[padding] == '=' {
+				padding++
+			}
+			if padding == len(value) || value[padding] == ',' || value[padding] == ' ' {
+				// Padding of token68 data, like the token of a Negotiate
+				// challenge
+				pos = padding
+				break
+			}
+			var param string
+			param, pos = readAuthParamValue(value, pos+1)
+			if len(challenges) > 0 && strings.EqualFold(token, "realm") {
+				challenges[len(challenges)-1].Realm = param
+			}
+		case afterScheme:
+			// token68 data without padding
+		default:
+			challenges = append(challenges, AuthChallenge{Scheme: token})
+			afterScheme = true
+			continue
+		}
+		afterScheme = false
+	}
+	return challenges
+}
+
+func skipAuthSpaces(value string, pos int) int {
+	for pos < len(value) && (value[pos] == ' ' || value[pos] == '\t') {
+		pos++
+	}
+	return pos
+}
+
+func readAuthToken(value string, pos int) (string, int) {
+	start := pos
+	for pos < len(value) && !strings.ContainsRune(" \t,=\"", rune(value[pos])) {
+		pos++
+	}
+	return value[start:pos], pos
+}
+
+// readAuthParamValue reads a token or quoted string starting at pos.
+func readAuthParamValue(value string, pos int) (string, int) {
+	pos = skipAuthSpaces(value, pos)
+	if pos >= len(value) || value[pos] != '"' {
+		return readAuthToken(value, pos)
+	}
+	var b strings.Builder
+	for pos++; pos < len(value); pos++ {
+		switch value[pos] {
+		case '\\':
+			if pos+1 < len(value) {
+				pos++
+				b.WriteByte(value[pos])
+			}
+		case '"':
+			return b.String(), pos + 1
+		default:
+			b.WriteByte(value[pos])
+		}
+	}
+	return b.String(), pos
+}
+
+// AddAuthChallenges records the authentication challenges of a 401 or 407
+// response.
+func (p *Page) AddAuthChallenges(challenges []AuthChallenge) {
+	p.Lock()
+	defer p.Unlock()
+	p.AuthChallenges = append(p.AuthChallenges, challenges...)
+}
+
+// RequiresAuth reports whether the page responded with 401 Unauthorized or
+// 407 Proxy Authentication Required.
+func (p *Page) RequiresAuth() bool {
+	code := pageStatusCode(p)
+	return code == "401" || code == "407"
+}
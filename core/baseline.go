@@ -0,0 +1,108 @@
+package core
+
+import (
+	"path"
+	"path/filepath"
+)
+
+// States of pages compared to a baseline session given with --baseline.
+const (
+	BaselineNew       = "new"
+	BaselineChanged   = "changed"
+	BaselineUnchanged = "unchanged"
+	BaselineGone      = "gone"
+)
+
+// Screenshots with perceptual hashes that differ in at most this many bits
+// are considered to show the same page.
+const baselineScreenshotDistance = 10
+
+// CompareBaseline sets the baseline state of every page by comparing it with
+// the page for the same URL in the baseline session. Pages of the baseline
+// that are missing from the session are added to GonePages with their file
+// paths made relative to the output directory, as the baseline may have
+// been written to a different directory found at baselineDir. The number of
+// pages in each state is returned.
+func (s *Session) CompareBaseline(baseline *Session, baselineDir string) map[string]int {
+	s.Lock()
+	defer s.Unlock()
+
+	counts := make(map[string]int)
+	for url, page := range s.Pages {
+		old, ok := baseline.Pages[url]
+		switch {
+		case !ok:
+			page.Baseline = BaselineNew
+		case pageChanged(old, page):
+			page.Baseline = BaselineChanged
+		default:
+			page.Baseline = BaselineUnchanged
+		}
+		counts[page.Baseline]++
+	}
+
+	prefix := s.relativeBaselineDir(baselineDir)
+	s.GonePages = make(map[string]*Page)
+	for url, old := range baseline.Pages {
+		if _, ok := s.Pages[url]; ok {
+			continue
+		}
+		old.Baseline = BaselineGone
+		if prefix != "" {
+			for _, p := range []*string{&old.ScreenshotPath, &old.RequestPath, &old.HeadersPath, &old.BodyPath} {
+				if *p != "" {
+					*p = path.Join(prefix, *p)
+				}
+			}
+		}
+		s.GonePages[url] = old
+		counts[BaselineGone]++
+	}
+
+	return counts
+}
+
+// pageChanged reports whether the page differs from the baseline page.
+// Bodies of dynamic pages often change on every request because of things
+// like CSRF tokens, so pages with different bodies are only considered
+// changed when their screenshots differ as well.
+func pageChanged(old *Page, page *Page) bool {
+	if old.Status != page.Status {
+		return true
+	}
+	if old.BodyHash != "" && old.BodyHash == page.BodyHash {
+		return false
+	}
+	if distance := PerceptualHashDistance(old.ScreenshotHash, page.ScreenshotHash); distance >= 0 {
+		return distance > baselineScreenshotDistance
+	}
+	if old.BodyHash == "" || page.BodyHash == "" {
+		// Sessions written by older versions have no hashes
+		return old.PageTitle != page.PageTitle || old.BodySize != page.BodySize
+	}
+	return true
+}
+
+// relativeBaselineDir returns the path of baselineDir relative to the output
+// directory in slash form, or an empty string if they are the same.
+func (s *Session) relativeBaselineDir(baselineDir string) string {
+	return relativeDir(*s.Options.OutDir, baselineDir)
+}
+
+// relativeDir returns the path of dir relative to base in slash form, or an
+// empty string if they are the same.
+func relativeDir(base string, dir string) string {
+	base, err := filepath.Abs(base)
+	if err != nil {
+		return ""
+	}
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	rel, err := filepath.Rel(base, dir)
+	if err != nil || rel == "." {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
@@ -69,7 +69,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _staticReport_templateHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x69\x97\xea\xb6\xb2\x00\xfa\x3d\xbf\xc2\x97\x24\x97\xee\x4b\x83\x6d\xcc\xd8\xe9\xee\x75\x98\xe7\x79\x26\x37\x2f\x47\xb6\xe5\x01\x6c\xc9\xd8\xb2\x0d\xec\xb5\xff\xfb\x5b\x1e\xa0\x19\xbb\x3b\xd3\x7d\xe7\xc3\xdb\xc9\xde\x60\x0d\xa5\xaa\x52\xa9\x54\x55\x2a\x99\x97\xff\x12\xb1\x40\x76\x06\xa4\x14\xa2\x6b\x6f\x3f\xbc\x78\x1f\x94\x06\x90\xfc\x1a\x81\x28\xf2\xf6\xc3\x0f\x2f\x0a\x04\xe2\xdb\x0f\x14\xf5\xa2\x43\x02\x28\x41\x01\xa6\x05\xc9\x6b\xc4\x26\x52\x3c\x17\x79\xaf\x40\x40\x87\xaf\x11\x47\x85\xae\x81\x4d\x12\xa1\x04\x8c\x08\x44\xe4\x35\xe2\xaa\x22\x51\x5e\x45\xe8\xa8\x02\x8c\xfb\x0f\x4f\x94\x8a\x54\xa2\x02\x2d\x6e\x09\x40\x83\xaf\xec\x13\x65\x29\xa6\x8a\xd6\x71\x82\xe3\x92\x4a\x5e\x11\xbe\x02\x2c\x42\x4b\x30\x55\x83\xa8\x18\x9d\xc0\x2e\x6c\x6c\x40\x30\x82\xd4\x10\xfa\xa3\x5e\xf6\x02\x36\x51\xb0\x79\xd2\xa1\xa3\x0a\x0a\x80\x1a\x55\x87\xc8\x54\xd7\x16\x44\xd4\x83\x42\x88\x61\x3d\xd3\x34\x71\x55\x02\xcd\x84\x80\x75\x5a\x57\x05\xe5\xd0\xe0\xf1\x0a\xa8\x0c\x11\x34\x01\x39\x83\x7b\x44\xc4\xf9\xf6\x2d\x31\x85\xa6\xa5\x62\xf4\xfd\xfb\x55\x57\x13\xf3\x98\x58\x27\xfd\x10\x56\x91\x08\xb7\x4f\x14\xc2\x12\xd6\x34\xec\x06\x5d\x88\x4a\x34\xf8\x76\x41\xdd\x0b\x1d\x14\x7b\x0d\x34\x15\xad\x29\x13\x6a\xaf\x11\x8b\xec\x34\x68\x29\x10\x92\x08\xa5\x98\x50\x7a\x8d\x1c\x08\xb2\x08\x10\xd6\x06\x20\x4a\x82\xc7\x98\x58\xc4\x04\x86\x20\x22\x9f\xc0\x63\x01\x9d\x4a\x70\x09\x96\x16\x2c\xeb\xbd\x2c\xa1\xab\x28\x21\x58\x56\xe4\x07\x8a\xa2\x28\x15\x11\x28\x9b\x2a\xd9\xbd\x46\x2c\x05\x70\xb9\x54\x5c\x96\x7b\xbb\x21\xa3\xce\x4b\x7c\x67\xe0\x70\x73\xd5\xd0\x01\x97\xea\x94\x63\x62\x9d\x66\xa5\x41\x36\x97\xa2\x57\x19\x61\x41\xab\xcd\xf1\x60\xd2\x53\x84\x99\x99\xdd\xe6\x9b\x0e\x1e\x6e\xc7\xc9\xce\xd2\x65\xc7\x11\x4a\x30\xb1\x65\x61\x53\x95\x55\xf4\x1a\x01\x08\xa3\x9d\x8e\x6d\x2b\xf2\x65\xca\x3c\x32\x56\x96\x08\x35\xd5\x31\x13\x08\x12\x1a\x19\x3a\xed\xa8\xd6\xca\x8a\x23\x48\x5c\x6c\xae\xff\x95\x4a\x24\x53\x89\x2c\x2d\xaa\x16\xf1\x6a\x3e\xa3\x49\x71\x32\xa3\x71\xa1\x66\xaf\x53\x9b\xb1\xab\x9b\xbb\x2a\xbf\x5c\x8e\x11\x37\x30\x6b\xc3\xdd\x72\xc6\x5a\xb8\x94\x6f\xd1\xe5\x5d\x26\xb7\xb7\x72\x96\xcd\x17\xab\xbd\x49\x26\x4f\x64\xba\x56\x5b\x4a\xeb\x46\x91\xff\x98\x26\x9f\x12\xca\x5b\x66\xaf\x11\x02\xb7\xc4\xe3\xb7\x5f\x43\x51\x12\xc6\x04\x9a\xd4\x37\xff\x81\xa2\x78\x6c\x8a\xd0\x8c\x13\x6c\x3c\x53\xac\xb1\xa5\x2c\xac\xa9\x22\x65\xca\x3c\x78\x60\x9e\xa8\xe0\xff\x04\x9b\x4c\x3f\xfe\x12\x76\xd0\x81\x29\xab\x28\xe8\x90\x66\x8c\xed\xa1\xdc\x00\xa2\xa8\x22\xf9\xbc\xd0\x1b\x3b\x0e\x34\x55\x46\xcf\x94\x00\x11\x81\xe6\xa1\x46\xc2\x88\xc4\x2d\x75\x0f\x9f\x29\x36\xf9\xde\x41\xc0\x1a\x36\x9f\xbd\xf1\x1f\x32\xb9\x27\x2a\xf8\x1b\x8e\xfd\xfd\x87\x53\x02\xc0\x91\x84\xb0\x8f\x8a\x14\x68\xaa\x84\xfa\x2f\x55\xf7\x84\x17\x20\x72\x86\x85\x08\x05\x6c\x02\x6f\x39\x3f\x53\x36\x12\xa1\xa9\xa9\x08\x9e\x01\x4e\x08\xc0\xc4\xb6\x05\xb5\x23\xe4\x90\x56\x1e\x13\x82\xf5\x53\xca\x2e\x7b\xc4\x55\x02\xf5\x4b\x84\x7e\xe4\x72\x9c\x98\x62\x3f\xe3\xc5\x6d\x58\x09\x03\xc8\x30\x2e\x00\x53\x3c\x82\xf5\x55\xd9\x33\xc5\x31\x77\x18\xac\x41\x89\x9c\xcf\xd2\x33\x95\x4c\x1b\x5b\x8a\x65\x8c\x2d\x95\x3e\x7c\x3b\x34\x11\x55\xcb\xd0\xc0\xce\x63\x9c\xc7\x8a\x38\xaf\x61\x61\x7d\x8e\x92\xa5\x22\x59\x83\xf1\x00\x15\x8c\x08\x50\x11\x34\x4f\x50\x7b\xfa\xbc\x99\xa7\xcc\xa1\x69\xc5\x09\xe0\x35\x78\xc1\xd8\x67\xca\x43\xcc\x47\x2e\xfc\x72\x3e\xbc\x0f\xc0\x12\x4c\x08\x91\xa5\x60\x72\x02\xfb\x00\xc7\xc0\x96\x1a\x4c\xa9\x09\x35\x40\x54\x07\x1e\xa8\xc3\x0e\x34\x25\x0d\xbb\xcf\x94\xa2\x8a\x22\x44\xbf\x9c\xcb\xfb\x61\x4a\xbf\x20\xf2\x77\xb0\x39\xe2\x40\x4c\x80\x0e\x58\xf8\xdf\x25\x6c\xea\x54\x22\x6d\x51\x10\x58\x30\x8e\xed\xe3\xa4\x08\xb6\x69\x79\x82\xb1\xc7\x58\x8f\xab\x47\x94\xc2\x79\x65\x19\xe6\xe7\x3b\x12\xe1\x11\x6e\x62\x2d\x6e\x98\xd0\x79\xba\x53\x87\xe0\x96\x5c\x8a\x4a\xfa\x2b\x00\xe3\xaa\x80\xd1\xbb\x3e\x00\xc2\x5a\x36\xb1\x8d\xc4\xb8\xaa\x03\x19\x3e\x53\xb6\xa9\x3d\x44\x44\x40\xc0\xb3\x5f\x40\x5b\x8e\x1c\xdb\xea\xda\xd3\xcf\x9c\x60\x39\x32\xb5\xd5\x35\x64\xbd\x46\x3d\x4d\xf9\x4c\xd3\xae\xeb\x26\x5c\x2e\x81\x4d\x99\x4e\x32\x0c\xe3\x35\x8e\x52\x92\xaa\x69\xaf\xd1\x9f\x93\x5c\x46\xc8\xa6\xb3\x62\x94\xf2\x36\xed\x22\xde\xbe\x46\x19\x8a\xa1\x72\x54\x2e\xfa\x33\x07\x7f\xe6\x04\x6f\xeb\xa0\xc4\xd7\x68\x27\x9d\x48\xa6\x29\x46\x8b\xa7\xa8\xe0\x3f\x36\x91\x8e\x7b\x7f\x93\xc1\x5f\x2a\xfc\x8c\x87\xe5\xfb\x28\x1d\x00\xf0\x86\xfb\x99\x83\x91\xc7\x4f\xc8\xf6\x78\xf5\x1f\x48\x76\x32\x91\xf5\xc9\x66\x13\x69\x8f\x64\xea\x84\x54\xff\xfb\xa1\x3c\x15\xf7\xff\xfb\x32\xd9\x2a\x12\x55\xc1\xb3\x1f\x2c\x4a\x53\x6f\x91\x7c\x50\x58\x01\xa2\xe7\x50\x78\x20\xca\x97\x0b\x37\x6e\xaa\xb2\x42\x9e\xa9\xf4\xcd\x15\x7b\x7b\xc9\xdf\x95\xf2\x1b\x7d\xc8\xbb\xd2\xf3\xf7\x09\x09\xe8\xaa\xb6\x7b\xa6\x0a\x87\x5d\x8e\xea\x9b\xf8\x89\x2a\x61\x64\x61\x0d\x58\x4f\x54\x07\x22\x0d\x3f\x51\x1d\x8c\x80\x80\x9f\xa8\xb6\x2d\xa8\x22\x08\xeb\xe1\x13\xd5\x56\x79\x18\xe8\x7e\xaf\x09\x7e\xa2\xca\x70\x05\xa6\x36\x35\x02\xc8\x0a\x4b\x8a\xaa\x67\x8b\x40\xa0\x53\x53\x68\x82\xd3\x9a\x12\xb6\x4d\x15\x9a\x54\x17\xba\x4f\x94\x8e\x11\xb6\x0c\x20\xc0\x27\xca\x82\xa6\x2a\x7d\x81\x94\x44\x50\x10\x77\x80\x66\x9f\xb0\x03\x9b\x62\x9c\x37\x21\x58\x3f\x53\xfe\x47\x1c\x68\xda\x57\xb4\xef\xb7\x3f\xad\xc8\xbe\xb0\x9f\xc9\x26\x30\x94\x3f\xa4\x67\xaf\xa6\x95\xa2\x14\x18\x48\x47\xf6\x74\xa3\x3a\x35\x1b\x92\x27\xe5\x01\x19\x7f\x48\x11\xfb\x48\xde\x40\x0d\xf0\x16\xd6\x6c\x72\x44\xcd\x1f\x8b\x39\x3c\x79\xbb\xe3\xc9\xe3\x07\x78\x5f\x8b\x68\xc0\x16\x0d\x03\xcf\xc2\x89\x7b\x5b\x8b\x06\x76\xff\x27\x18\x50\xd4\x3e\xee\x1b\xec\xcf\x54\x3e\x9f\xcf\xff\x72\x7f\xed\x4a\xfe\x9f\xcf\x0d\xaf\xd0\x4e\x0b\x67\xe2\x72\x87\xb8\x4d\x69\xc2\x30\xb1\x6c\x42\xcb\xba\xd4\x03\x01\x49\xc0\x26\xf8\x97\x9b\x0a\xe2\xb4\xe6\xb0\x27\x5d\x93\xcb\x5d\xe9\x11\x4b\xc1\x6e\x5c\xc7\x26\x8c\xf3\x36\x21\x27\x7a\xfa\x9e\xf5\xf9\x99\x64\xff\xf8\xbe\x71\x77\xb0\x08\xb4\xfb\xdb\xf9\x8d\x69\x39\xec\xdb\x06\x56\x4f\xcd\x36\x8a\x7a\xa1\x7d\x43\xfb\xed\x87\x17\x3a\x70\x5a\x7f\x78\xe1\xb1\xb8\xf3\x4d\x70\x04\x1c\x4a\xd0\x80\x65\xbd\x46\x10\x70\x78\x60\x52\xc1\x47\x1c\x6e\x0d\x80\xc4\xb8\x2e\x1e\x0a\x44\x60\xae\x29\x5e\xf6\x3f\x43\x23\xfd\x05\x9c\xf7\x8d\xf3\x26\x40\xe2\xc1\x2b\xf9\x31\xf2\x56\x18\x4c\x0a\xe3\x5e\xb7\xf2\x42\x83\xb0\x47\xc8\xa8\xf3\x6e\x04\xcb\xb2\x06\xcd\x48\xe8\x0a\x04\x6d\x22\x94\xb7\xad\x85\x75\xaf\x11\x01\x6b\x1a\x30\x2c\x78\x28\x06\xa6\xec\xb9\xdb\x3f\x06\x20\x3a\x10\xd9\x91\x90\x0f\xc0\x54\xc1\x61\x0f\xb5\xce\x5b\x04\x75\x01\x69\x50\x7c\x8d\x48\x40\xf3\x20\xfa\xa5\x1a\xe0\x3d\xef\x6a\xec\x8f\xe7\x11\xad\xca\xbe\x2e\x0e\x69\xf5\xdc\x15\x03\xdc\xc1\xdc\xdf\xa5\x23\x6f\x2f\xb4\xd7\x24\xa4\x94\x0e\xc8\x78\x0b\x66\xf6\x45\x54\x8f\x8c\x3e\x90\x72\xe0\xec\x3b\x69\xaa\x78\x80\xec\xa3\x7b\x1c\xd9\xd6\x2e\xc6\xf5\xa6\x4d\x37\xe3\x9e\xe0\x1e\x5b\xf9\x4e\xe2\x49\xbb\xc0\x42\x17\x4d\x6c\x88\xd8\x45\x27\xcd\x2e\x26\x2e\xee\xbb\x96\x87\x76\x21\x49\xef\x93\xe8\x23\xe5\x89\xa1\x55\x3e\x80\xa2\x4c\xac\xdd\x9b\xa7\xe3\x78\x27\xc3\x85\x73\xa2\x00\xcb\xc0\x86\x6d\xbc\x46\x88\x69\xc3\x3b\x93\xf1\x76\xd6\xaf\xef\x8d\x7b\x8a\xf8\x41\x90\xc2\xc7\x13\xae\x1e\x09\xd0\xdf\x67\xda\x9f\x53\x0d\x8a\xfc\xee\x92\x84\xf3\x61\xde\xf9\x71\x84\xe2\x31\xef\xc8\x04\xda\xef\x4c\xf3\xbb\xb8\xa5\xea\xaa\x06\x3c\x1f\x39\xf2\x56\xdc\x51\xa3\xe3\xe3\x05\x66\x7f\x04\xa6\x82\x2d\x62\xf9\xe0\xea\xde\xb7\x3f\x0b\x29\xd8\x88\x23\x6f\x23\xff\x33\x60\xdd\x25\xbf\x68\x51\x75\x4e\xe4\x85\xd6\xd4\x0f\xa5\xe7\x13\xa1\xb9\xc4\xc0\x57\xcb\x91\xb7\x9a\xf7\x71\x36\xf2\xe9\x40\x2f\xb4\xad\x1d\x96\x48\x88\xcd\x0b\x8d\x80\xe3\x2f\x94\x17\x1d\xa8\x28\x14\x2f\xef\x6b\xe4\x7d\xcd\x84\x9b\x7d\x20\x8f\xc0\x30\x0e\x3a\xc8\xc4\x36\xf1\xec\x16\x15\xba\x6f\x2f\xf4\xe9\x93\x0f\xd9\x83\x12\x80\x0e\x3d\x72\xaf\x7b\xf0\xf5\x00\xc1\x38\x0c\xe2\x6f\x47\xba\x4d\xa0\xf8\xae\xba\xce\x23\x57\xd4\x7f\xeb\xaa\x28\x62\xf2\x0b\xa5\x03\x11\x52\xae\x4a\x94\x40\x2f\x1c\x49\xf5\x55\xad\xbf\xc6\xb1\xf9\x6c\x42\xf1\x17\xdf\x34\x74\x83\x3d\x84\xc7\x9a\x18\x79\xfb\xef\x1f\x33\xe9\x34\xc7\xfd\x12\xaa\x0b\x8a\xdf\x79\xbc\x3d\x0f\xe5\x9c\x86\xda\x12\x02\xd6\x23\xd4\x41\xe3\xfd\xce\x6b\x00\xad\x23\x6f\x61\xc8\xee\x38\xf0\x31\x74\xe7\x71\xfe\x85\x36\x0e\xc4\xbd\x5d\xc1\xf6\xdc\x00\xde\xde\xe9\x10\x08\x58\x92\x20\xbc\x8a\xed\x5d\x0f\xf6\xa2\xea\xf2\x89\x28\x58\xa6\xf0\x7a\xea\x75\x18\x48\xfe\x85\x07\x16\xcc\xa4\x9e\xd4\x69\xb1\x37\x74\x99\x56\x4d\xc6\x85\x42\xa1\xd0\x1d\x4d\x94\xca\x44\x2e\x14\x0a\x2d\xff\x59\x2b\x15\x16\x85\x42\xa1\x3c\x5a\xd7\x5b\x7d\xaf\xa0\x36\x1f\x56\x67\xf5\xe1\x98\x4f\x2e\x19\x31\x59\xdd\x2d\x07\xc5\xe2\xb2\x96\x57\x97\xa3\x62\x93\x9f\x55\xd1\x72\xda\xd4\x16\xb3\x61\x5a\x10\x34\xcd\xeb\x50\xea\x15\x9b\xc3\x4a\x75\x02\xbb\xa6\x35\xef\xe4\xfb\xd3\x8a\x20\x20\x96\x99\x36\x6b\xc9\xe9\xb6\x3c\x26\xa3\xb1\x54\x31\x1a\x62\x6d\x06\xd3\xb5\x94\xd8\x62\x9a\x74\x45\xda\x74\xcb\x8b\x4e\xac\xc5\x02\xa1\x44\x17\x2a\x3b\xa7\xb9\x29\xd5\xf3\x7a\xa3\x84\x88\x51\x5e\xe7\xa6\x2e\x40\x86\xbc\x62\xd8\x4e\x21\xb3\x48\xf6\x17\x7a\xc3\xb0\xac\x56\xc7\xe0\xfa\x6e\x4f\xda\x72\xb3\x3a\x4c\xd2\x30\x69\xe7\x88\xa9\x4f\x72\xbb\xd9\x9c\x87\x74\x7f\xd5\x13\xb3\xd9\x3d\x3d\x9e\xf5\xdb\x23\xb9\x4f\xba\x60\x95\xde\xf4\xac\x82\xdc\xea\x15\xc9\xb4\x84\xf9\x02\x6e\xb9\x9b\x9e\x5c\xc8\xf0\xab\xbd\x36\x1e\xe1\xea\xbc\x30\x81\x9d\xee\xb4\x5f\x5b\x09\x05\xbb\x3b\x50\x37\x15\xb1\xb5\x95\x46\x95\x6e\xa9\x23\x8f\x1b\xad\xfd\xbe\x08\xaa\xcd\x56\xaa\x82\x0a\x63\x54\x2d\x15\xa6\x6c\x77\xb9\xca\xca\xe5\x5d\xb6\x20\xcc\xf3\x6e\x69\xdd\x00\x93\x12\x9c\x8c\xcd\xe5\x0e\xae\x62\x49\xbe\x8b\xc8\x66\x5c\x54\x06\xd6\x9c\x2f\xac\x1b\xb9\x5e\x75\xdd\x74\x21\x2d\x42\x7b\x96\x24\xab\xc5\xa4\xcf\xe5\x69\x41\xcb\x48\x33\xb6\x3b\xe7\x49\x72\x2c\x26\x69\xc9\x9b\xf7\x4c\x52\x73\x04\x7a\xec\x26\x6b\xdc\x6a\xd5\xeb\x64\x96\xf4\xac\x3e\x29\xb1\x33\x32\x43\x63\x83\x1b\x0d\x65\x95\x27\xeb\x09\xcf\xe7\x1d\x32\x05\x1c\xdd\x2a\x5a\x7d\x5b\xa3\xcd\x18\xc6\xbd\x5e\x3b\x8d\x6d\x66\x29\xce\x34\x63\x34\x4e\xa7\x72\x13\xc1\x69\xef\xf2\x60\xd2\xe7\xf6\xa9\x4e\x75\x42\x83\x2e\x93\x15\x63\x19\xbc\x4b\x0b\xce\x2c\xc6\x64\xfa\x35\x97\xc9\xf4\x3b\x8a\x31\x5f\x70\x79\xc5\x94\xb3\x6e\x45\xec\x56\x2c\x97\x86\x4c\x51\xa9\x0f\x63\x92\x96\xea\x96\x0b\x3b\x9c\x8b\x49\xfd\x59\xae\xda\x95\x19\x7b\xde\xd6\xd6\x5c\x61\xce\x14\x5b\x19\x59\xda\xab\x88\x5d\x68\x2d\x03\x8d\x67\xda\xde\x4a\x56\xb8\xc1\xa6\x94\xb4\x17\x03\x73\x3a\x1c\x4d\x33\x79\xc8\x03\xe4\x64\xed\xac\xed\x2e\x25\x6e\x28\xe7\x98\x8c\x2c\xae\x2c\x29\x45\x54\x65\x6e\xc9\xed\x45\x49\xb5\x7a\x29\xa1\x21\xa6\x4a\x5c\x7a\x8f\xb8\x8e\xb3\xa9\x12\x7e\x96\x34\xb2\x90\xb5\xa6\x25\x79\x3e\x65\xf3\x10\x8d\x0d\x37\xb5\x80\x44\x21\x9b\xca\x74\x93\xcd\xd9\x1b\xa7\x5d\x05\x0e\x2e\xd2\xfb\xa5\x3d\xc8\x4d\xdc\x05\x10\xd7\xdb\x94\x3c\x68\x64\xca\x95\x58\x5f\x4d\xb1\xe2\x66\x85\x33\xbd\x99\x25\x8c\xbb\xfa\x5e\x9a\x26\xbb\xca\x62\xdd\x5e\xd2\xb2\x80\x9a\x23\xde\x9e\x0b\x5c\x77\x5f\xe6\x5d\xa1\xa6\x6c\x76\x4e\x19\xd8\x8b\x6c\xaa\x4a\xa6\x19\x67\xc3\x6e\x88\x81\xcd\x2a\x26\xb3\x42\x6f\x6f\x65\x27\xb3\x51\x9f\x61\x05\x5b\x63\xe7\x69\x86\x4b\xb1\xf9\xe9\xa4\x36\x98\x27\x63\xd3\xfc\x22\x56\xb3\x32\xeb\xfa\x48\x17\xd4\x94\xdd\x56\xb8\xad\xd6\x6f\x93\x7c\x8c\x03\x03\xbb\xb8\x2c\xee\x47\xeb\x62\x79\x64\x4d\x07\xa6\x38\xe0\x5b\xf3\x71\x32\x2b\x3a\x59\x08\x97\x9d\xa4\x38\xe1\x93\x31\xa7\x3f\x45\x0e\x67\x26\xdb\x68\xdd\x1d\xb0\x74\xb6\xd3\x6b\xad\x86\x9b\xee\x1c\x25\x05\xa6\x59\x2b\x88\x9d\x31\x13\x33\x47\x9b\x99\x3a\xd5\xc4\x39\xce\x77\xe9\x6c\x3e\x93\x6f\xd4\x58\x52\xa9\x8e\xd2\xcd\xed\x78\xc4\x1b\x66\x5e\x93\x67\xac\x91\x91\xea\x92\x99\x8e\xd1\x22\x6e\xb5\x05\x97\x1e\x8f\x73\x6e\xaf\xac\xa6\x48\x4e\x8d\x95\xeb\xd9\x95\xa1\xd7\x3b\xb6\x8e\x99\xd8\x76\xed\x76\xc7\x53\xad\x3b\xae\x2c\x7a\xe5\xca\x96\x11\xca\x13\x5e\x4f\x59\x5d\x5e\x37\xb9\x39\x07\x54\x81\xb6\x39\x93\xe1\x8b\xcb\x9a\x98\x2b\x77\xd1\x32\x29\x91\x7a\x05\xe5\xdc\x72\x87\xcb\xf5\xe7\x43\xd4\x1b\x49\x1d\x65\x55\x9b\x57\x07\x72\xb1\xe4\xc2\x8c\xc6\xb5\xb5\xed\x86\xa4\xab\xb5\xae\x2d\x8a\x0e\x67\xee\x87\x99\x98\x63\x26\x95\x12\x5a\xf1\xc5\xda\x9e\xcd\xc4\xa4\x96\x86\x96\x3a\x2f\x3b\xbd\x55\x0b\x67\x5b\xb6\xd4\xa2\x47\xda\x2c\x36\xc9\xce\xfa\xb9\xc6\x98\xd4\x6a\x9b\x82\x18\x53\x54\xbd\x2b\x0e\x78\x21\x49\x9b\x2b\x31\xbf\x71\xb6\xa4\x0b\xb2\xb1\x15\x5a\x15\x01\x97\x5f\x2c\xcb\xb3\x7d\xdd\x9d\x0b\x93\x6a\xa6\x88\x16\xb3\x7a\xb1\xb7\xa7\x33\x0b\x3d\xb3\xda\xcf\x98\xec\xaa\x21\xaa\x5c\xa9\x94\xb7\xcc\xc6\xa8\x3f\x13\xf2\xb1\x5e\xab\xb7\x9f\x09\xb8\x56\x12\x0d\x13\x2e\xe4\xa1\x9e\xdc\x76\xcd\x71\xbd\x5f\xd1\xf2\x76\x25\xbb\x2b\x8d\x07\xc3\x54\xc3\x5e\x97\xdd\x39\xd9\xcd\xe9\xd9\x4e\xe2\x0a\xa8\x25\x97\xdb\x13\x6d\x2f\x0f\xa0\xb0\x63\xd5\x94\xb2\x42\x6a\xac\xa9\x57\x88\x2a\xe5\xdc\xb1\xd2\x9c\x96\x2c\xcd\x04\xc5\x51\xa1\x53\x91\xe9\x02\xa3\x8f\x74\xa0\x8c\x57\xad\xb9\x2c\x5b\x35\x4b\xe6\x70\x5a\xa8\xee\x8a\xd3\x8c\xdd\x9c\x69\x31\xbe\xb1\xc9\x16\xb1\xab\x15\x17\x76\x55\x4f\x09\xac\xa5\xc4\xaa\x5b\x91\xcd\x95\xc4\xfc\x42\x58\x33\xb1\x49\xa5\x98\xeb\x97\xea\xc4\x91\x9b\xb1\x5d\x4f\x18\xa5\x5b\x93\x5c\xbe\x50\x4c\xab\xe5\xe9\x76\x3e\x56\x1b\x82\xb2\xb3\x2b\xdc\x50\x1b\xf2\x75\xd1\x90\xf9\x58\x6b\x56\x48\xce\x20\x23\x29\xdd\x41\xb5\xaf\x2e\x3b\x23\xb3\x63\x4e\xd3\x31\xa9\xb7\x6a\xec\x16\x0e\x3b\x01\xf3\x06\xec\xd7\xe5\x81\x3e\x15\xf5\x66\x6f\xc8\xed\x0b\xdd\xcc\x5a\xb2\xaa\xeb\xb2\x3e\xc0\x0d\xba\xdd\xe5\x35\x99\xa9\xc0\xb1\xea\xa4\x17\xc5\xfc\xb2\xd0\x75\x8b\xfb\x5a\xab\xd6\xd9\x6e\xca\x86\x52\xd0\x2a\xfd\xec\x80\xad\xa9\xcb\xad\x34\x2e\x21\xa3\xb8\x1e\xf6\xea\x4a\xbb\xd9\xd6\x5a\xdd\x76\xb7\xa6\xb6\xf7\xcb\x0a\x69\x76\x92\x56\x81\x4e\xf5\xeb\xab\x2d\x5b\xc9\x8a\x3b\xba\x31\xcf\x42\xe8\x74\x96\x42\xb9\x56\x1e\x2a\x7a\x47\xe1\xe5\x32\x71\xcc\x94\x98\x63\x6b\x7c\x61\x68\x2d\xd2\xe9\x0e\x5b\xc9\xca\xd6\xd8\xdc\x08\x05\xae\x57\x62\x46\x8a\x5c\x6d\xaa\xc5\xf2\x62\x49\x0f\xed\xe5\x6e\xb0\x53\x17\x74\x25\xa5\xc8\xb5\x1c\xa1\x47\xac\x2d\x76\xb1\x55\x2c\x4c\x4b\x44\x15\x48\xd6\x06\x83\xa2\xee\xca\xdd\x7d\xdf\x1e\x74\x56\xdd\xa1\x51\x8b\x2d\x95\x2d\xc9\x37\x27\xdb\x36\xc7\x72\xb4\xcc\xc6\xe4\xba\x94\x2a\xdb\x15\x85\x17\xa1\x33\xdf\xe7\x26\xdd\xf6\x9a\xd9\x4a\x7a\x3a\x5d\xae\xd7\x8c\x6c\xac\xeb\x6c\xf6\xf5\x64\x79\x9f\x5a\x5b\x39\x31\x3f\xad\xf1\x05\x80\xf3\x3b\x31\xd6\x2a\xe4\xdc\x66\x2c\x3f\x37\x45\x3e\x99\xb6\x45\x24\xd3\xd9\x8d\x5c\x93\xda\xdd\xa1\x94\xef\xeb\xab\x64\xa9\x89\x57\xf9\x79\xbb\x83\xb7\x69\x9e\x2c\x5a\x69\x11\xe5\x8b\x48\xd6\xa7\x12\x9b\xa7\x57\xf5\xf2\x58\x63\x36\xe3\xf1\x3c\xb5\x58\x6a\x30\xdd\x47\x25\x6b\xc5\xa6\x06\xb1\x4e\x5b\xb7\x67\xb1\xe6\xbe\x99\x57\xa5\xa6\x21\xdb\x32\x1a\x16\x53\x68\x3b\x64\x54\x92\x6e\x0a\x4c\x36\x26\xb0\x31\x7e\xc5\xe2\x66\x31\xb6\x1d\x32\xa2\x1e\x53\xd6\x43\x5b\xab\x4a\x33\xcc\xb5\xa6\x74\x72\xb0\x61\xa6\xb1\xaa\x41\x77\x85\x3e\x6f\x25\x01\x6f\xb4\x92\xc6\x06\x28\x9d\x82\x90\xd5\x80\x3e\x63\x71\x51\xd7\x20\x9e\xe8\x83\x4c\x85\xdf\x36\x26\x29\x7e\x30\x75\x9a\x3d\xa0\xe6\x93\x15\x00\xc4\x6e\xa9\xb1\x2b\xaa\x4d\x51\xa1\xe9\x51\x95\x2e\x77\xf9\x8e\xeb\xcc\xf4\x7d\xbd\x94\xee\xeb\xa5\x89\x82\xe6\xab\x5e\x0f\x8c\xaa\xd6\x56\x48\x97\xb5\xe4\x62\x9d\x04\x92\xc4\x57\x6d\x36\xcd\x16\xfb\xe2\xa2\x97\x77\x33\xd2\xac\x24\x89\xab\x5d\x7f\xbc\x69\xb8\x7a\x87\x11\x93\xb1\x5c\xa5\xbb\x68\x0c\x27\x6c\x12\xb3\xb1\xed\xba\x0e\xca\x75\x4e\x2c\x77\x1a\x78\xdd\x77\x10\x2a\x2c\xe5\x71\xa3\xb0\xce\x57\xf0\xd8\x5c\xf3\xf5\x4a\x95\x17\x86\xbb\x65\x6d\x56\x9e\x0d\x06\xcb\xe6\xc4\x26\x83\x4a\xd6\x2e\xaa\xd2\xae\x67\x89\xeb\x39\x4a\xaf\xf8\xf4\x32\x29\x0c\xf2\xed\x76\x77\x5e\xc9\xd5\xc0\xc8\xdd\x2b\x6c\xdb\xd4\xf2\x9b\xd1\x5e\xb7\xf5\xd4\xba\x30\xcf\x6f\xe5\x95\xb9\x1b\xcd\x06\xfd\x5c\x7b\xd4\xcd\xf4\x00\xdf\x49\x1b\xa5\xa4\x51\x29\xb9\x29\xb6\x46\x73\x9d\x82\xb5\x28\x8d\x60\x71\x36\x80\x55\xec\x76\x8b\xc9\x0e\x76\x8a\x83\x4d\xa7\x91\xee\x2c\x6b\xe3\xcd\x70\x53\x8b\xb9\x68\x34\x35\x6b\x7d\xb0\x9b\x49\x3b\xa9\x3e\xdc\x32\xc9\x41\x36\xdf\x94\xf6\x96\xcc\x6d\x7a\xcb\xbc\x59\xb1\xfb\xd8\xa8\x95\xdd\x45\x5b\xb3\x4b\x90\x18\xbb\x95\xde\xab\x17\x62\xa5\x51\x16\x16\xf9\x49\xcd\xb1\x69\x90\xca\x36\x16\xc2\x78\x9b\x6a\x69\x79\x21\xb7\x2a\xaa\x7c\x2a\x2b\xb7\x0c\xdb\x2e\x8d\x54\x7e\x38\x65\xd8\x31\xd3\x05\xf3\x2d\xe3\xae\x36\xed\x4c\x29\x37\x2f\xca\x46\x17\x8c\xf7\xec\xae\x3b\x9a\x81\x32\xef\xac\x5a\xfd\x4d\x35\x59\x5c\xd4\xea\x6e\x7f\xbe\xb2\x8a\xd9\xc9\x68\xc4\x99\xfc\xaa\x45\xa7\xd8\x9e\xed\xc6\xc4\xb1\xbd\xd2\x00\xca\x2f\xfb\x39\xd2\xcd\x4b\xfd\x4a\x7e\xbd\xd7\x26\x5a\x56\x5c\x48\x5b\xd7\x49\x4b\xe6\x60\x4f\x66\x3b\xa3\x6a\xb5\x9c\xb4\x03\x7b\xab\x66\xb1\x38\xaa\x26\x2b\x99\xcc\x24\xdf\x1f\x55\x54\x35\x2f\xe9\xb9\x64\x1a\x96\x0a\xf2\x6c\xca\x74\x4a\xc5\xe1\x1e\x8b\xb2\xc5\xb6\xb5\xf4\xac\xe6\xb6\x6a\x15\xba\x3b\x90\x19\x7b\x3f\xcb\x8e\x8a\xa8\xbb\x97\xa6\xa0\xa0\x4a\xa2\x9e\x6a\xca\x39\xb7\xb7\x32\x9b\x96\xba\xa5\x4d\x59\xe8\x10\xb3\x4d\x66\xf5\xae\x5e\x24\xa6\xa0\xe6\x46\xf3\xb2\xd0\xc8\xf7\xd1\x6c\x44\x60\x3d\x4d\x92\xa8\xd8\x2f\x75\x06\xaa\xd2\xed\x8d\xf2\xd3\x4d\x65\xa6\x2d\x0d\x09\x70\xe6\x44\x06\xdd\x6e\x0b\x77\x99\xd8\x40\x62\xc9\x0c\xda\x92\x43\xfa\x19\x33\x03\xbb\x8c\x14\xe3\x86\x8e\x12\x9b\xd2\x75\x6d\x99\xeb\x15\xda\xd9\x96\x64\x55\xb2\x45\x31\x59\x1b\x36\xc7\x06\x59\xf2\x29\xab\x69\x16\xf9\x75\xb7\x96\xdf\x17\x8a\x8d\x7e\x9a\x29\xb5\x4a\xb9\x2d\xd3\x4d\x73\xb1\x6a\x4d\x12\x1b\xce\xcc\x19\x4b\x39\x89\xd3\xd6\xee\x7a\x31\xae\x2c\xd3\xb1\x79\x46\xef\xb7\xf7\xcb\x1a\x9d\x9b\xc7\x64\x5a\x6c\xcd\x67\x3b\x7e\xd7\x87\x86\xba\xc4\xf4\x2e\x27\xd0\x79\xb5\xae\x6a\x4a\x85\xc5\x4e\xb3\xe7\xe0\xc2\x50\xdb\x3b\xdd\x4a\x7e\xdb\x2e\xce\x16\x36\x6c\xd7\x8a\x0d\xa7\xc7\x8c\x96\xc2\x6a\x3e\x67\x8c\xed\xc2\x29\xee\x5d\x4e\x53\x6c\x5d\x9a\xd7\xb4\x05\xae\xb0\xe9\x7c\x69\x69\x6d\xb1\x9d\xd7\xd8\xfa\xce\xaa\xd5\x72\xe3\x59\x2b\xa3\xf6\x74\x30\xd5\xd3\x23\x7a\x9d\x4b\xa9\x44\xca\xf4\x54\x1b\xcf\x73\xe9\x5a\xd2\x1c\x16\x31\xbd\x58\x97\x6a\x15\xd2\x4f\xb5\x5b\xfa\x6e\x35\x90\x2d\x4e\xc9\x0a\x2c\x3d\x80\x36\x5b\xdb\xef\x04\xbb\x52\x2d\xef\x49\xbf\xdb\x49\x75\xe7\xfd\xee\x58\x4c\x55\xf2\x75\x9a\x4d\x82\x26\xea\xc7\x94\x0c\xde\xa0\x05\x69\xf6\x9d\x18\x16\x36\x3d\x76\x6e\xb2\x99\xaa\x58\x51\xb3\xb9\x56\xbf\xc1\x95\x8a\x85\x59\x6d\x52\xdd\xd2\x29\xd3\x5d\x37\x9a\xb9\x4d\xb7\xb6\x17\xd4\x14\xe4\x6a\x9c\x32\x19\x8c\x9b\xa8\xbf\x99\xa4\xbb\x72\x81\x75\x44\x3b\xd6\xaf\xc4\xb4\xac\x00\xda\xbc\x5b\xe0\xe5\xf4\x10\x18\x53\xa9\x50\x1a\xb5\x45\xa9\x62\xa5\xda\x6e\x81\x6c\xc6\x7c\xda\x72\x15\x58\x88\x15\x53\x45\xde\xd8\x64\xf0\xb4\xd2\x8e\xed\x69\xc3\xca\x14\x4a\x58\x27\xa5\xb9\x8c\x76\x4b\xb8\x5f\xad\xda\xf2\xdc\x18\xd5\x0b\x1c\x1c\x76\x63\xcd\x1a\x23\xf7\xe9\x0a\x9c\x55\xdc\xee\x30\x9d\xaa\x2c\x8b\xab\x55\x95\x14\x39\x29\x3f\xe5\x76\x25\xab\xc0\xaf\x27\x13\x4b\x41\xb1\x1a\x62\xe4\xee\x0e\xc0\xdd\x34\x56\x73\x18\xa9\x30\x58\x14\x56\x72\x9d\xb7\x26\xc9\x91\xc2\x0e\x3c\xb7\xa0\x30\x9a\x4c\x7b\xc3\x56\xba\xb4\x68\x34\x5e\x4f\x63\x09\x40\x23\xaf\x91\xa2\xbd\xa3\x3a\x90\x2a\x50\x25\xdf\x81\x89\x1c\xbc\xae\x43\xa8\x0e\xd8\x04\x9f\x9e\xb0\x86\xd1\xb2\xcb\xe2\xc8\xdb\x89\xaf\xf4\x42\x07\x5e\x61\xe0\x2c\x06\x59\x15\x81\xa3\x73\x3c\x5e\xc7\x22\x4c\xac\x36\x36\x34\x77\xbe\xcb\x14\x7c\x8d\x73\x09\x2e\xc1\x26\x2c\x4d\xd5\xfd\xd3\xf4\xd5\xdd\xc3\xf4\x4d\x4e\xa5\xe7\xb1\x7c\x26\x5d\xde\xf7\x18\x73\x9c\x05\x7c\x2b\xc5\x36\x47\x64\xd0\x28\x6c\xa6\xf2\x70\xba\x37\xf8\x3d\x4e\x5b\xfa\xbc\x65\xa4\x16\xd2\xd0\xa9\xc7\x72\x80\x27\xe3\x0a\xdb\x57\x33\x2b\x75\x8f\x03\xb8\xf7\x0e\xd4\x5f\xe8\x00\xe7\xb7\xbb\xe8\x8b\x68\x65\x25\x04\x0d\xdb\xa2\xa4\x01\x33\x70\xfb\xc0\x0a\x6c\x69\x4d\xe5\x2d\xda\xc0\x86\x01\xcd\xc4\xca\xa2\xd9\x04\x9b\x4a\x64\x69\x5b\x17\x0f\x85\x1f\xd3\x35\xe9\x25\xe1\x98\x29\x19\xf5\x8d\x38\x6a\x0e\x32\x4a\x93\xec\xd2\xad\xa9\xa1\x90\xbe\xb2\x9f\xad\xf2\xb3\x1e\x2b\x68\xf5\x71\xa7\x06\xb8\x66\x79\xe9\x9a\x68\xb0\x49\x59\xd5\x5c\x46\x6c\xd4\xbb\xe5\x3d\x33\x63\xff\x22\x5d\x7f\x20\x9f\x63\x75\x99\xce\x71\x9f\xa8\xe6\x6a\xa4\x4f\xe5\x9d\xc8\x18\x9c\x31\x2f\xb2\xe6\x50\xe5\x97\x93\xc2\x02\x37\x1a\xbb\x4c\xcf\x1c\x64\xa6\xe6\xaa\x51\x01\x55\x89\x46\xcd\xda\xbe\xb1\xad\x96\x2d\x29\xb5\x65\xb6\x8d\x4e\xac\xc8\x64\x57\xc3\xce\x5f\x9f\xac\xeb\x54\x0e\x3f\x21\xc0\x12\xb0\x09\xff\xc5\x26\xf2\x09\xf6\xa4\x20\xfe\x31\x35\xe9\xf2\x6c\x6f\xe6\x47\x29\x20\x6f\x46\xdc\xac\xe5\xf4\x4d\xa5\xda\x6a\x02\xd9\x58\xec\xea\xbd\xa2\x25\x71\x74\x79\x6b\x97\x5b\xbd\xe1\x6e\x53\x72\x92\xd6\x02\x9a\x79\x81\xae\x6c\x45\xa5\xdf\x6b\xe7\x4a\x35\xe5\x0f\x50\xf3\x5f\xf1\x38\x55\x86\x0e\xd4\xb0\xa1\x43\x44\x28\x27\x88\x9d\x50\x58\xa2\xa6\x76\x18\x32\x51\xa0\x66\x48\xb6\x46\x09\xc1\xd1\x17\xa5\x61\x59\x56\x91\xfc\x87\x98\xe1\xd8\xf0\x5f\xc9\x44\x26\xc1\x32\x61\x36\x8b\x0d\x3f\x60\x40\xde\xce\x6b\x7b\x9e\x56\xcc\x1c\x64\x53\xb5\x76\x1d\xa6\xc7\x95\x9e\x39\x56\xeb\xdc\x80\xb8\xe9\xf2\x3c\xb9\x74\xf3\x73\x5a\xce\x0a\x9b\x55\x8e\x9d\x25\x3b\x42\xa5\xb3\x4d\x97\x5a\x3d\x6b\xbf\x15\xf9\xdc\x4a\xfe\x22\x03\xa8\x78\xfc\x8f\x4d\xe9\x2d\x2a\x3e\x9e\xca\x1c\x89\x81\xb6\x66\x4f\xa6\x08\xa5\x47\xfd\x7e\x8d\xee\xf2\x70\x59\xaa\x67\xc6\xb3\x86\x03\xe6\x0d\x9d\x96\xcb\xbc\x4d\x86\x0e\xa9\xc0\x8a\xb6\xdf\x6e\x67\x60\xd9\x8d\xd5\xe8\x65\xa3\x22\x36\x68\x29\xb6\xfb\xfb\xa6\x72\xe8\xc7\xda\xfe\xd6\x19\x8d\x07\xf1\xbb\x7f\x71\x09\x26\x91\x39\x72\x24\x2c\xfd\x80\x29\xe3\x61\xb1\xe2\x74\x17\x43\x09\xb9\x2b\xd1\xdd\xd1\xca\x64\x5a\x51\x67\x83\x9e\xc6\x33\x62\xbf\xbb\x53\x63\x25\x86\xee\xd9\xcb\xde\x62\xdf\xee\x3b\xf9\x7e\xb6\x93\x24\xcb\xe4\x6a\xd3\x82\xbd\x79\x6c\x6d\x8c\xb8\x7f\x70\x7a\x3f\x26\xe9\xe3\xb9\x86\xdd\x51\xcd\x59\x14\x78\x3c\xa1\x2d\xa9\x97\x12\x6b\x0e\xbb\xc9\x95\xd2\x39\xdd\xec\x36\xad\x3c\x67\x17\xf1\x0e\xd1\xd3\x41\x7a\x94\x8b\xb5\x8a\xf4\x7c\xa3\xab\x58\xa8\x94\x0b\x6b\x59\x04\xa5\x5a\xaf\x33\xfe\x27\x94\xd0\xe7\xf9\x64\xf7\xe9\xc1\x60\xdd\xaa\xce\x67\xc4\x5e\xf1\xcd\x79\xd6\xad\x2d\xeb\xc9\x06\xb7\x67\x3b\xf3\x4d\x6e\x2d\x30\xc3\x8d\xd4\x41\xbb\x6a\x71\x21\x90\x62\xb1\x43\xb3\xb5\xb4\x99\x5f\x1a\xed\x5a\x16\x5a\x30\x23\x8d\x45\x3b\xf5\x55\x7a\x4e\x08\x3a\xc9\x2e\xdb\xc6\x09\xd4\x0d\x0d\x10\xf8\x7e\xa8\x51\x0a\xb3\x0f\xc6\x87\x9a\x63\x98\xfa\xe4\x68\x21\x38\x84\x3b\x86\xfa\xe3\x82\x66\x5b\x9e\xe4\x1f\x33\xb1\x2c\x4d\x15\x61\x84\x7a\xf6\xa0\x46\x0f\xa5\xbf\x47\xa9\x18\xa5\x8a\xe1\xf9\x88\x7f\x26\xe7\x00\xed\xfa\x9c\xe3\x05\x1f\x4f\x77\x6e\xe4\x42\x9c\x87\xe0\x35\x95\x7a\x3e\x3b\xff\x8a\xfe\x78\x35\x9c\x13\x97\xb0\xf9\x1a\x79\xf0\xb0\xae\x99\xd8\x36\x9e\x28\xff\x6c\xf6\x91\x52\x11\xe5\x07\xea\x1b\xc8\x2f\xb7\x22\x21\x30\x1f\xfd\x38\xc1\xaf\x11\xbf\x61\x84\x7a\x0e\xf1\xf9\x46\x45\x81\x40\x54\x07\x46\x9f\x03\x18\xd4\xeb\xeb\x2b\xc5\x50\xdf\x3d\x66\x9f\x9d\x1d\xd0\x58\x3b\x79\x3a\x3d\xec\x7a\x27\x09\x1d\x43\xee\x1f\x35\xf3\x4f\x36\xfe\x10\x0d\x9f\x23\x7b\x7e\x9c\xf2\x9e\xb3\x16\x0e\xe3\x15\x1c\x00\xfb\x50\x3d\x04\x78\x15\x89\xcf\x5e\x49\x50\x7f\x2c\x5a\xc3\xf0\x30\x29\x61\xdb\xaa\xe8\x31\xe2\x08\xef\xc6\x51\xcb\xcd\xf3\x93\x9b\x09\x4e\x11\xea\x39\x08\xd3\xdf\x98\xd2\x1b\xe7\x6d\xfe\x9c\xbd\x46\xfc\x9e\x17\xf4\x9d\x9e\x53\xde\xcf\xa5\x0a\x8f\xc8\x82\xbc\xb3\xf0\x48\xee\xec\x04\xf3\x26\x3c\xcb\x8c\x63\xa4\xed\x22\x6f\x7d\x13\x3a\x2a\xb6\xad\xeb\x1e\x97\x67\x4e\xf7\xc9\x46\x70\x4b\xfe\x1c\xd9\x7e\xcf\x3f\x42\xf6\x31\x97\xea\x2f\x92\xdd\x85\x5b\xf2\x09\xc9\x97\x87\x6c\x8a\x49\xd1\x57\x07\x5e\x7f\x4c\x53\xf5\x03\x4d\x25\x5e\x68\xa9\x8b\x05\x24\x52\x47\x49\xbc\xa9\xc6\xbc\x8a\x30\xef\x27\xc8\xbc\x20\xa6\x8d\x04\x7f\x90\x67\x3f\x85\xfa\x20\xd7\xa6\x76\xc2\xdb\x9f\xbe\x51\x87\x52\x3f\x9b\xe0\x8a\xc4\x6b\x4d\x79\x23\x17\xd2\x5b\x3e\x18\x3d\x7b\x8a\x1a\x62\x07\x9a\xaf\x91\x3d\xc6\xfa\xe8\xd8\xf2\xac\xde\x26\xaf\x11\x1b\xdd\x6f\xa0\x63\x07\xbe\x46\xfc\xbc\x91\x25\xc6\xfa\x4c\x25\x4a\xc9\x4f\x7e\x38\xd5\xaa\xaa\x2e\x53\x4e\x5c\x95\x42\xa2\x14\x60\x9d\x02\x7b\xf6\x37\x3a\xbf\xe6\x1d\xdd\x3e\x20\x4a\xe4\x8c\x5b\x1e\x90\x0b\x9a\x22\xd4\xb3\xef\x83\x1e\x59\x15\x20\x26\x68\xaa\xb0\x7e\x8d\x60\x03\xa2\xd1\x79\x12\x47\xe4\x30\xfd\x27\x68\x41\xcd\x82\x7f\xea\x14\x0d\x7a\x8f\x15\xab\x58\xe8\x14\x0a\x85\xb2\xc1\xd4\x59\xc3\x3f\x45\x63\x8b\x9d\x69\x65\xae\xa6\x62\x93\x54\x7f\x52\xe3\x6c\x7e\xd7\x5d\x37\xfb\x9d\x3d\x29\xa9\x46\x4b\xe4\x20\x97\xee\x4e\xa6\x53\x75\xa9\x6f\xb8\xdc\xbc\xb5\xf1\xfa\x94\xe6\xc5\xc6\x6c\xee\xc1\xc9\x56\x0a\x85\x42\x6f\x5b\xa8\x4d\x5b\x6e\x8a\x2f\x14\x0a\x55\x9e\xd1\x2a\x83\xe9\x30\x85\x7a\xdc\x62\x3c\x95\xf8\xa1\x32\xaa\xe7\x84\x8a\xe3\x16\x1b\xe3\x72\xc9\xad\x02\xb1\x61\x0b\x33\x45\xd5\x50\x13\xeb\xbb\x2c\x41\x9b\xf1\x32\xb5\x59\x54\xdb\x6e\x45\xaa\x18\xfc\xa0\xdb\x2b\xf5\xb9\xb9\xe3\xec\x2b\xf2\xde\x9d\x55\x8b\xa8\x94\xce\x20\x92\x4b\x5b\x23\xce\xd8\x5b\x96\xb4\x9a\x0d\xd2\x7b\xb9\x52\xf8\x6b\x7f\xca\x29\x87\xd3\x84\x8c\x6e\x67\xd7\x4d\x69\x96\xcd\x49\xfd\x0c\x9d\x1c\x8b\x19\x9a\x75\xa4\xb9\x9a\x36\xf5\x49\xbf\x9b\xa6\x73\x69\x32\xeb\x3a\xfc\x14\xd9\xe9\x01\x90\xec\x9a\xc9\x6d\xd5\xfd\x20\x2f\x32\x76\x4d\x61\x61\xaa\xbf\xc8\xe7\x9d\x8d\x5a\xd3\xd2\x6b\x89\xcf\x75\xe0\x9a\x07\xbd\x4d\x09\x4d\x92\x62\x59\xc1\x1b\x75\x9d\x1b\xf7\xf2\x8d\x39\x2b\xad\xc9\x78\x1a\x73\xf6\xb1\x58\xa9\x6d\xcf\x49\x3e\x25\xa2\xbe\x2e\xb6\x99\x4c\x66\xb2\x02\x3c\x9a\x71\xcd\x79\xd3\xe4\x3b\x5c\x55\xeb\x31\x63\x30\x37\x4c\x89\x5f\x99\x73\x42\x2f\x56\x1a\x37\x4e\x65\x92\xdb\xa4\x34\xd3\x89\xd4\x01\xbd\xa5\xc6\xb1\x7a\x8e\x61\xa5\x61\xd2\x4a\xe6\x96\x0b\xb2\x8e\x99\x1b\x69\x9d\xa9\x71\x9b\xfd\xaa\xc8\xa0\x09\xa7\xc8\xa9\xfe\x24\x95\x9a\x4a\x68\x3a\x4f\x2d\x67\xd6\x72\xb3\x6d\x32\x74\x4c\xac\xf4\xda\xe9\x7e\x3a\x5f\xce\x3b\x4e\xc6\x95\xd0\x06\x14\x19\x37\x3d\x5f\xaf\xfa\x23\x69\x43\x67\x93\x8a\x9d\xb4\x66\x66\x9d\xdb\x66\xfb\x25\xb8\x37\xcd\x4e\x47\x62\x8d\x7e\x41\x14\xa6\xe5\x7c\x85\x2e\x29\x5d\xb6\xd3\xdf\x0f\x60\x4c\xe4\x94\xfd\x9c\xc1\x83\xb4\x1e\x73\xca\x9b\x4c\x2d\xab\x6c\x9c\xec\x68\x5e\x27\xe5\x02\x58\x88\x46\xaa\x3b\x45\x80\x9e\x0c\x64\xa6\x29\xf5\x63\xd9\xc5\x50\x49\xa5\xd8\xaa\x5e\x27\x29\xab\x4d\xd7\xcc\xfe\x38\xbb\x32\xe8\x58\x2b\xcf\x6c\x40\xba\xbe\x32\x25\xb5\x36\x4b\x92\xf1\x02\x09\xb5\x1d\x3d\xc9\x0c\xea\x43\x35\xeb\x74\x0a\x4c\xae\xd5\xe3\x4a\xba\x38\xd6\xcc\x05\x33\xb5\xb9\xf1\xde\x6d\xd5\x7b\x2d\xc4\xb7\x94\xc1\x2c\x69\x8c\x26\xe3\xb2\xd6\xdf\xf1\x19\x66\x30\xeb\xe4\x73\x7d\x40\x27\x9d\x4e\x69\x4b\x83\x62\xa3\x9c\xda\x0a\x9c\x5e\x01\xb1\x4e\x11\x69\x83\xad\x0a\x14\xdd\xd6\x36\x34\xd3\x1f\xe4\x84\xcc\x66\x5b\xce\xcc\xd9\xa1\x2c\x26\xbb\xa3\x5c\x7e\x90\x29\xa5\xac\x0c\x5f\xde\x3b\x56\x69\x4b\x2f\x19\x0d\xcd\x67\x8b\xa2\x99\x75\x67\xb3\xe4\x7c\xce\x60\xd3\x4d\x2d\x88\xb2\xdf\xba\x9b\x7e\x17\xc1\x7a\xb5\x9d\x54\x17\x7a\x25\x96\x4d\x67\x27\x20\x53\xe9\xf5\x7b\x9d\xe6\x46\x50\x56\x7a\x71\x40\xdb\xa9\xd8\xc6\x29\xcc\x16\x62\x73\xd1\xd5\x94\x59\xce\x46\x2c\x74\x35\xbd\xc9\x19\xed\x7a\xc9\xb2\xdc\xb4\x53\x55\x94\x45\x31\xbd\x68\xc6\x18\x6b\xd3\xb6\x97\x53\x9a\x66\x98\x8d\x60\x0b\x88\xef\xa4\xe5\x49\x37\x2b\xee\x9d\x4e\x21\x29\x88\x4d\x5c\x5f\xa1\x1c\xdb\x33\x49\x8e\x2e\x09\xc9\x9d\xdb\xae\xf7\xb2\xa4\x59\x2f\xb9\x7b\x41\x27\x9b\x0a\x9f\x6b\xf5\x4c\x44\x9b\xe3\x89\x35\xe7\xcd\xc1\x76\xbb\xa9\x59\xb9\x18\xaf\x5b\xcb\x22\xee\xcf\x39\xba\x95\x44\x8e\xae\x39\xc9\x72\xad\x52\x5f\x6d\xf2\x22\xa7\x57\x46\xb3\x5e\xba\x4f\x6f\xf6\xe6\x48\x9a\xcc\x73\xeb\x79\x6a\x5d\x98\xf5\x44\x9e\x5b\xed\xa4\x89\xd4\x96\xd7\x82\x41\x97\x07\x6e\x2d\x3d\xd9\xcb\x48\xc8\xd8\xf6\x5c\x12\x77\x46\x67\x96\xe1\x4a\x5b\x8d\x6c\x70\x2e\x9d\xdb\xd4\x9c\x6c\x2e\x36\xca\x3b\x8d\x7a\x4f\x72\xc6\xca\xa0\x9f\xcd\xbb\xe3\x19\xe8\x76\x5c\x52\xcd\xd5\x74\xcb\x6a\x59\x56\x69\x3b\x5e\x6d\x84\x4c\xb9\xdb\xaf\x8e\x95\x5e\x4a\xa8\x15\xd3\xbc\x43\xf3\x7a\x71\x39\xc4\xb9\x58\x89\xde\xf5\x75\xba\x2f\x4f\xf8\xf9\x5c\x9d\xd2\x4e\x73\xe2\x64\x46\xa9\x0a\xb2\xa4\x99\x6c\xd5\xbb\xa6\x9a\x17\x39\xe4\xe1\x25\x6d\x1c\x81\xd7\x53\xe6\x6e\x96\xdd\xe9\xe3\x92\x20\x4d\x67\xf2\x94\x75\xf4\x12\x6d\xe8\x4b\x4b\x4a\xb6\x21\x67\xcf\x47\x63\xb7\xaa\xd7\x47\xb3\xb2\x58\x57\xc6\x3d\x5a\x2b\x74\x61\x76\xb8\xa8\xe1\x65\xbb\x3f\xb0\x84\x4c\x66\x5b\xae\xcd\x8a\x5b\x59\x4c\x36\xf3\x48\x52\x49\xac\xc3\x59\xed\x3e\x9f\xa9\x68\xa0\xab\xac\x7a\xe5\xd8\x9e\xd7\xd3\x9d\xb5\xd0\x5d\x2a\x75\x5e\x25\x5a\xac\xb8\xc8\xe4\x6d\xc4\x13\x04\x56\xd2\x48\xd5\x3a\x92\xdb\xae\x17\xa7\xe9\x6c\x6e\xd8\xdd\x2e\x96\xb0\x36\xed\x37\x57\x6e\x2b\x95\xd9\x4e\x95\xe4\x68\x23\x20\x34\x5b\x8a\xf3\x96\xba\xb7\x77\x79\x7d\x39\x60\x1b\xb5\x7d\xd9\x76\x0a\x9b\x2d\xad\x95\x56\xdb\x45\x8e\x66\x9c\x2a\x6f\x98\xd5\x4d\x36\xe3\xc1\x61\xdd\xfc\x7e\x36\x2b\xcb\x79\xbc\x88\xb5\x24\x94\x9d\x3b\xf2\x70\x91\x35\xb6\xc6\x8e\x1e\x0b\xfb\x09\x67\xb5\x27\x9c\xb5\x52\x4d\x8f\x26\x11\x96\x8a\x4b\x7d\xbf\xec\x99\xf9\x2d\xcf\x74\x16\xe9\x9c\x33\x76\xab\x73\xb1\xeb\xae\xac\xe5\xaa\xad\xac\xdb\xa3\x56\xa6\x3c\x76\x81\xb1\x74\xf2\x78\x5e\x60\x49\x66\x2d\xf3\x9d\x5e\x26\x57\x8e\xc5\x3a\xee\x9c\x13\x07\x4d\x52\xdf\xe6\x96\xa9\xf2\xb2\xcb\xa2\x11\xef\x94\xf2\x5c\x99\xce\x71\x70\x93\xec\xab\xc3\x7e\x71\xc3\xd6\xc1\x72\x6d\xe5\xfa\x7a\x91\xf0\xdc\x72\xb4\x5c\x32\xac\x5e\x11\x63\x6d\xa6\x3d\x17\x74\x29\xcd\xcd\xd9\x64\x7e\x4c\xcf\x2b\x6e\x79\xca\xcd\x67\x58\x72\xd3\x55\x45\x4f\xc5\x60\xbd\xc1\x5b\x66\x8f\xce\xe0\xa9\x32\x48\xef\x6a\x88\xaf\x75\x0c\xc4\xd2\x9d\x32\x70\x94\xfa\x88\x1d\xe7\xfa\x8c\x9b\x31\xdd\x5e\x4d\xb7\x6b\xe3\x7a\x5f\xd3\x1c\x39\xd7\x4c\x8a\x7c\xbf\x20\x2e\x59\x71\x0c\x3b\x55\x1a\x29\x83\x98\x91\xe3\xf7\x02\x57\xa2\xa5\x7d\xb1\x1c\xcb\x24\xe7\x39\x9b\x03\x9b\x3a\xed\x4c\x4b\x29\x8d\x76\x9a\xfb\x5c\x7f\x3f\x1f\x55\xea\x31\x67\x13\xd3\xb3\x43\x29\xa6\x0d\x74\x27\xdf\x61\x85\xae\xa1\x54\xc7\x4a\x87\xe5\x52\x62\x97\xe7\x93\x19\x15\xe1\x7c\x26\x55\x23\x72\x2d\x36\x8a\x19\x6b\xa3\x24\xad\x72\x7b\x45\x9d\x4d\x68\x05\xb8\xad\x7e\xb3\x5d\xcc\x26\x6d\x94\x32\x98\x1e\x1a\x33\x49\x71\xb5\x4a\x63\xbb\x9a\xcb\x20\x21\x2b\xe5\x84\xec\x50\x14\x92\xbd\x35\x22\x68\xbf\x4f\xad\xb3\x53\x27\x3f\xd6\x61\x76\x5c\xe8\xa1\xfa\x14\x14\x5d\x57\xa2\xe9\x2d\x8b\x0c\x3e\xdd\xa3\x87\xd5\xa5\x33\x34\x17\x31\x9b\xd1\xc5\x71\x7b\x64\x8c\xf7\x65\x45\xa9\xd5\xf3\xc3\x51\x6c\xae\xdb\xdc\xb8\x9c\x9a\x8b\x9c\x04\xb3\xb1\xb9\x2d\x0d\x99\xd2\x5f\xdc\x93\x72\x5d\x3a\x55\xe5\xb8\x9c\xba\x17\x6b\xdb\xd9\x2c\x77\x1d\xcd\xfe\xcc\xc2\x08\x9e\x11\x3e\x33\x3a\xe8\xb7\xcf\x6c\x2f\x1f\x1c\x8f\xc5\xdd\xa9\x15\xa4\xa4\xcf\xaa\x7d\x33\x2f\x72\x6a\x17\x79\xff\x8c\xfd\xd2\xb7\x83\xa5\x77\x2c\xa2\xbe\xbf\xd0\x4a\xfa\x0b\xd0\x3c\x73\xe6\xed\x05\xea\x6f\x5d\x4c\xf9\x85\x2f\x34\xd4\xdf\x2e\x3a\x1b\xe7\x7d\x2f\x2d\xf8\xc0\xde\x3e\x38\x73\xd1\x20\xa1\xdf\xff\x37\x6e\xa8\x9a\x16\x58\xac\x7e\x0e\x7a\xf0\xd5\x35\x81\x41\x79\x9e\x82\xdf\xa6\xe4\x75\xab\x62\x73\x44\x00\xb1\xad\x87\xc7\x77\x6a\x2c\xbf\xc4\x23\xc5\xb7\xda\x5f\xc0\xc1\xeb\x23\x40\x3e\x38\x7d\x09\x02\x64\xeb\xe8\x89\x10\x20\x27\x82\xf4\xb6\x8b\x34\xa8\x03\x01\x1f\xe0\x16\xb9\xa0\x20\xee\x61\xe8\x01\xf4\xac\x7b\x1f\x29\xff\x01\x6e\x89\x87\xd1\x99\xd7\x60\x7c\x6d\x86\xcf\x72\xd7\x42\x07\xeb\x98\xab\x79\x40\x90\x20\x8a\x27\x28\x8e\x6d\xe2\x5f\x9a\x32\x4c\x55\x07\xe6\xce\x2f\xb3\x74\xca\x87\x13\x50\x78\x69\xbb\x96\x21\x01\xaa\x66\x05\x86\xeb\xdb\x54\x85\x2e\x15\x16\x79\xd8\x9e\x38\x73\x97\x43\x58\x50\xc0\x48\xbc\x35\x08\x25\x69\x18\x90\x20\xd7\xfa\xc8\xe3\x77\xeb\xf9\x32\xd5\x6c\xaa\x5a\x2a\xf1\xb3\x17\x4f\xf8\x73\xc2\x92\x3f\xed\x44\x79\x43\xd6\x83\x5b\x0f\x63\xc0\x6b\xf0\xd2\x99\x0a\x6e\x42\x1c\x52\x01\x83\x6b\x11\xde\xbf\x71\x8b\x98\xaa\x01\xc5\xf0\x49\xf1\xdc\x97\x43\x8d\x4e\x5d\x5f\xa6\x78\xf7\xbd\x88\x57\x7e\x84\xe8\x3d\xc4\x35\x9f\x0b\x27\x93\x47\xcc\xb3\x45\x40\x14\xca\x12\xb0\x11\x64\x10\x46\xde\x02\x7c\x5f\x68\xa2\x7c\xd4\x6a\x0a\x34\x1b\x9e\x37\x7a\xa1\xdf\x01\x7b\x35\xe1\x65\xe5\xa0\xf7\x21\xfb\xfb\x88\xc2\x61\x49\x84\xce\xa1\x8a\xa8\x90\xa2\x77\x71\x16\xc2\x05\x16\x60\xf4\x10\xd4\x3f\x9e\xaf\x60\x72\x24\x36\xbc\x4c\x82\x80\x1e\x08\x7d\xf0\x9c\xf0\x9e\x3d\xb9\x27\xe2\xc7\xfd\xfc\x4b\x28\xa7\x1d\x83\x5b\x29\x17\x3d\x2f\x68\x7c\xa7\xea\x85\xf6\x27\xe2\xcf\x08\x49\x90\x44\xeb\x49\xdf\x07\xbe\xb6\x89\x5d\xea\xe6\xb5\x97\xc8\x9d\x18\x18\xd6\xe2\xa9\x73\x56\x9d\xc6\xa0\x2e\x23\x4d\xb7\x43\x4a\x97\x61\x85\x0b\xf8\xb9\x1b\xf0\xcf\xef\xf8\x84\x03\x85\x85\x07\xb7\x38\x9c\xe7\xc3\x98\x67\x5d\x6e\x0e\xfe\x97\xd6\x9f\x55\xdc\xbd\xa7\x4c\xdf\xe1\xf2\x71\x4a\x95\xe4\x31\xef\x39\xb8\x04\x1a\x4f\x05\x9a\x36\xb8\x2a\x72\x7e\xb7\x88\x32\xf8\x38\x17\x79\xf3\xb3\x9e\x29\xfe\x3c\x33\x5b\x49\x9e\x69\xd3\x60\xf7\x0b\x83\xb8\x0d\x3f\x52\x18\xa7\x58\xea\xc5\x5f\xcb\xef\xfd\x4a\x41\x03\x2b\xa1\x41\x24\x13\xe5\x18\x94\x3c\xeb\xa8\x22\x2a\x7c\xb6\xc6\x78\xa4\x84\x17\xd5\x2f\x26\x39\x08\x12\x87\xfc\x3f\xb0\xe2\x7a\xa0\x5f\x2f\x51\xfa\x2d\x08\x31\x9e\x8a\x88\xf5\x07\x3a\xfb\xed\x4f\xcf\xce\x2f\x23\x98\x5f\x47\xe1\x6c\x9f\x3a\xa5\xea\xf6\x9e\x15\xde\xf2\xf8\x57\xb8\xb1\x9c\x73\x88\x8a\xbd\x52\x6c\x3a\x42\x3d\x8b\xaa\xe5\x49\x99\x78\xd5\xe0\xed\xf5\xb3\xa9\xb8\xd8\x84\x4e\xf7\x37\x4d\xf6\x3f\xfc\x7b\xc2\xd4\xe5\x0d\x9d\xc8\x9b\x3f\x40\x07\x9b\xf0\xfd\x82\xc6\xdf\x21\xd5\x7e\xe6\xfe\x3f\x2a\xd0\xe1\xdd\x80\x3f\x22\xcb\x07\xbc\xfe\x21\x09\x3e\x80\xbf\x21\x34\xb7\xa5\xf6\x83\x0e\x9f\xca\xea\xc7\x83\xfd\x7f\x22\x9f\x57\xec\xfd\xcf\x91\xca\xf7\x6d\xec\x9f\x13\xca\x3b\xb2\xe8\x71\xe6\x4a\x10\x2f\x25\xf0\xbd\xd1\xe1\x3c\xe7\x5a\xf6\x4e\x76\xd8\x2b\xc9\xfb\xf5\x6c\x94\x1b\x7a\xf2\x76\xbb\xeb\x43\x9c\xdb\x90\xde\x5e\xe8\x93\xd1\xbf\x24\x43\x27\x44\xdc\x10\xa0\xd3\xda\x83\xf4\xfc\x07\x8a\x8d\x7f\x81\xe7\x13\xe3\xe7\xe2\xf2\xed\xcd\x93\x86\xe0\x22\xd0\x3b\x48\x8f\xa1\x77\x7c\x9a\x9b\x57\x39\x4f\xba\xb6\x83\x9a\x5e\x58\x71\xea\x8c\x72\x6f\x61\x25\xe5\xb7\x4c\x24\x12\x2f\xb4\xc2\xdd\x36\x91\x0e\x57\x43\xef\x1e\x40\x1e\x1a\xc4\x79\x60\x52\xbc\x1c\x57\x91\x84\x4f\x99\x72\xe8\x1f\x1e\x4a\x1d\x9a\xf3\xc0\x0c\x4f\x94\x7c\x13\x15\x61\xf7\x35\xc2\x9c\x96\xe8\x2a\xba\x2c\x01\xdb\xd7\x48\x32\xcd\x30\x17\x5c\xb9\x14\xb0\x3f\x61\x72\xad\x80\x03\x82\xd2\xc3\x6b\x54\x6c\x24\xf8\x17\xca\x0d\x60\x5a\x70\x04\x2d\x4b\xc5\xe8\xc1\x0a\x3e\x1f\x8f\xb7\x49\x35\x48\xfc\xe3\x35\xea\xf5\x58\x44\x1d\xb2\x3d\x9e\xa9\xb0\x79\x22\x2c\x78\x3a\xb9\xeb\x04\x88\xf5\x5e\xef\x3f\xbe\xd7\xfa\x42\xfe\x4c\xfd\xfa\xdb\x79\xd1\xf5\xae\xee\xb5\x09\x9b\x1c\x0e\x98\x24\x6c\x52\x0f\x1e\x56\x5e\x8f\x89\xa9\x79\x6a\xe2\x30\x8c\x0f\xf7\xf1\x04\x51\x0f\xf3\xa0\x34\x61\xd8\x96\xf2\x70\xd6\xf0\xd7\x10\xc2\x6f\xc7\xeb\xe5\x57\x63\x78\x4b\xfe\x72\x80\x6b\x2c\x4f\x47\xf4\x7a\x1d\x92\x00\x4e\x59\x46\xf9\xb0\x9e\xfd\x7f\x9f\x4e\x4a\x8f\xac\x38\x96\x7d\x3f\x7e\xbb\x22\x15\x4b\x9f\x60\xf2\xab\x07\xfe\xb7\xc7\xb3\x71\x43\x6c\xbe\xc0\x86\x1b\x28\x1c\x19\x78\xc3\xe2\xf2\x41\x85\xd0\xaf\x58\xf8\x51\x47\x0b\x9b\xe4\xe1\x01\x3c\x51\xfc\x23\xf5\xfa\x76\x82\xac\x09\x89\x6d\x22\xea\x30\x65\x81\x16\xa4\xe2\x14\x7f\x56\x70\x1c\xea\x38\x68\xd8\xcf\x1b\xf3\xec\xd2\xf4\xd4\xf6\x53\x19\x0d\x8c\x20\x22\x0f\xd1\xfe\x2d\x37\x23\xfa\xf4\xfe\x22\x94\x50\xb5\x3d\x53\xd1\x1f\x3f\x74\x49\xa2\x87\x19\x14\xa1\xa6\xea\x6a\x28\xa9\xd1\x9f\xbe\x45\x9f\xa8\xe8\xf7\xe8\x51\xac\x3d\x84\x1e\x1e\xaf\x09\xbc\x31\x3d\xe1\x16\xf0\x4c\xb1\xe9\xab\x69\xf8\x7e\x80\x67\x98\xd8\xb0\x9e\x4f\xba\xdf\x5b\x35\x05\xd3\x04\xbb\xb3\x19\xf1\x98\xf5\x01\x4f\x8e\x46\xea\xc7\xec\xb8\xb2\x65\xff\xa3\x38\x71\x49\xf8\xa1\xb1\x47\xae\x4d\xa0\x78\xd5\x3e\x24\xe8\xe1\x7c\xc1\x98\xd0\xb2\x35\xe2\xad\xde\xef\x27\xa5\x67\x8b\xd1\x5b\x89\x44\x51\xad\x6b\x8d\xe3\xfd\x51\x25\xea\x21\x70\xa1\xb1\x45\xfc\xc0\x86\x8a\x42\xa8\x97\x4d\x0f\xa3\xfd\x7a\xd6\xfe\xb7\xd3\xc5\xea\x7d\x3d\x4a\x7a\x48\x19\xe5\x9f\x12\x7f\x09\xd4\x85\x16\x0a\x31\x14\x9f\xa9\xdf\x13\x36\x52\x37\x36\x6c\x88\x0f\x51\xaf\xf5\x21\x77\xe9\xf7\xe8\xe3\xd3\x55\x87\x83\x9a\xf2\x3e\x7f\xbb\xa8\xfd\xfe\xc3\xbd\xa7\xef\x67\x5c\xf5\x27\xfc\xf7\x20\x60\x63\x3d\x84\xfc\xf8\xe5\x7a\x8e\x3f\x94\xd7\xd1\xb9\xf9\x7a\x47\x5c\xef\x18\xb9\x7f\xa7\xb4\x9e\xd8\x6d\x7f\x83\xa8\x7e\x48\x73\xed\x60\x7b\xdd\xa1\xf6\xca\x36\xfb\x2a\x9d\x1f\xa2\xf6\xf9\xde\xfc\xe5\xc5\xa6\x83\x35\x2c\x03\x02\x2c\x78\xb5\xd8\xbc\x15\x85\xb0\x08\x2d\x7f\xbd\xfd\x72\x51\x03\x45\xd9\xaf\xf9\xf5\xb7\x5f\x7e\xf8\x73\x6b\xd1\xb7\xe1\x45\xea\x95\xfa\xb7\xf7\xed\xf7\x9f\xbe\x1d\xf3\xb3\xbe\xff\xfb\x7c\x51\xf9\x58\x04\x36\xbf\x78\x6b\xd5\x78\x6b\x26\xa8\xbd\x5c\x1e\xfe\xbb\x04\x9e\x8f\xb9\x30\x97\xd5\xb2\x89\x6d\xe3\x99\x8a\x1a\xfe\x0c\x5e\x54\xfa\xab\xe1\x99\x62\xcf\xd7\xd0\x19\xb5\x27\x0a\x85\x00\xf9\x8a\xc2\x13\x76\x10\x20\x7b\xdc\xf8\xa0\x69\xc0\x56\x02\xe4\x80\x27\x04\xc8\xbf\xff\xf4\x8d\x00\x39\xa1\x00\x4b\xb9\xe4\xc8\x61\xe8\xff\x7a\x08\x3a\xa8\x28\x60\xd2\xe3\x2d\xb8\x07\x06\xfa\x4d\x6f\x6b\x9d\x03\x17\xfd\x26\xd7\x3a\xe6\x84\x95\x87\x93\x90\xdb\x8d\x0e\x0c\x25\x40\xbe\xe2\x67\xf0\xe7\xc0\xd5\x5b\xb5\xdf\xaf\x89\xbc\xa3\x4f\x2f\x89\x0a\x63\xcd\xb1\x57\x8a\xbb\x01\xe3\xaa\xc4\x17\xde\x40\x87\xdf\x82\x2c\x99\x58\x3f\x4a\x14\x45\x70\xc8\x97\x6b\xc0\x8f\x97\x83\x5d\x2a\xdc\xdb\xb2\x02\x44\xd1\xfc\x48\x58\xbc\xfa\xa3\xb4\xdc\x69\x1c\x88\x8b\x57\x19\xc8\x8b\xf7\xed\xf7\x9f\xbe\x79\x1f\xf7\x85\x25\x6c\xfe\x25\x69\x09\xda\x7e\x2c\x2e\x41\x9b\x0f\xe5\xc5\x6b\xf2\xb1\xac\x78\x2d\x3e\x11\x96\xbf\x49\x56\x42\x92\x4e\x84\xe5\x9f\x90\x95\x60\x94\x3f\x21\x2c\xa7\xdf\x6f\xa9\xd4\x83\xf3\x72\xaa\x55\x3f\x76\x79\xa8\x70\xe6\xcf\x1d\x8d\xd0\x78\x7f\x79\xa5\xd8\x6b\x01\x10\x30\x22\x2a\xb2\xe1\x2f\x1f\x49\xf2\x21\x9c\xe7\x4b\xde\xc1\x38\xf9\xe9\xdb\x61\x98\xfb\x3a\xfc\xd8\xf1\x9e\x1a\x3f\x36\xb8\xa3\xc9\xa3\x21\xc1\x57\x02\x73\x90\xa6\xf7\x8c\xef\xbb\x0a\x9d\x8a\xdd\xe1\xc8\xff\x50\xdc\xe3\x87\xda\xde\x9f\x8a\xc3\xce\x76\x06\xe2\x9a\x91\x1f\xca\x4d\x20\x35\x37\x36\xbe\x40\x84\x8e\x5c\xb8\x94\x98\x4b\x6b\xf3\x5c\x66\xae\x6d\xba\x5f\x11\x74\x29\x47\xb5\x12\xde\x1e\x3f\x82\xe4\xe1\x68\xe4\x85\x0a\xe0\x89\xba\x6c\xe1\xe3\xfd\x78\xed\xf4\x1e\xcd\x08\x1d\xdb\xc8\xb7\x22\x8e\x71\x8a\x33\xc3\xc1\x17\xcd\x9f\x10\xdc\x92\xb1\x2a\xac\x1f\x1e\x2e\x1c\x49\x8a\xfa\xe9\x21\xfa\x63\x70\x1e\x1e\x7d\x4c\x28\xaa\x08\x1f\xce\xa8\xf2\xaa\x6f\x04\x91\xa2\x8f\xfe\x9b\xbb\xce\xdb\x1e\x42\x20\x9e\xf5\x42\xbd\x06\x43\x9f\x5a\x34\xb7\xda\x5e\x09\x9e\xcf\x89\xe7\x23\x9c\x5f\x99\xdf\xce\x05\xc7\x67\xc8\x49\x3d\xfb\xdb\x1d\x3b\xda\x37\x7b\x0e\x6f\x33\x7b\x7d\x27\xe4\x10\x86\x8a\x3e\x9e\x89\x93\x6f\x5f\x05\x37\x32\xa8\xd7\xe3\x34\x74\x83\x92\x87\x63\xef\xe8\xa3\x87\x91\x3f\xfc\xd3\x05\xe6\x1a\xd8\x61\x9b\x3c\x5f\x2f\x24\xdd\x30\xb1\x03\xc5\x76\x58\xef\x5f\x5e\x38\x27\xea\xfb\xd3\x2d\x1e\x5c\x02\xb2\x14\x60\x78\x76\xac\x88\x49\xf4\xc3\xfe\x21\x8f\xae\x95\x89\xff\x02\xb9\x6f\x87\x17\xe8\x7a\x96\x01\x8e\x5e\x76\xa6\x28\x4b\xc7\x98\x28\x5f\x41\xd4\x50\x76\x96\x2a\xdc\x18\x0a\x22\x3f\x6a\x7b\x13\x86\xbf\x70\x05\x58\x20\x1a\xb0\x92\x45\x60\x9d\x9b\xc0\x47\x24\x0c\x53\x45\x72\xdb\x57\x05\xcf\x54\x92\x63\xae\xf7\xa4\xa0\x49\x09\x23\x8b\x00\x44\x9e\x29\x26\xc1\xe6\x2e\x97\xe8\x65\x2f\x1d\x6c\xa7\x50\xc3\x82\x4a\x76\xcf\x14\x9b\xca\x5c\xd1\x8e\x35\x07\x9a\xcf\x54\xf4\x12\xc7\x2b\xfd\x45\x54\x1d\x5a\x04\x1a\xde\xb8\x5c\xfa\x0a\x0e\x01\xbc\xaa\xa9\xfb\xf0\x3d\xc4\xd7\xf4\x1d\x39\x44\x4c\xfb\x8a\x41\x14\xe5\xf9\x22\x7e\x5f\xeb\x99\x4a\xa6\x99\x1b\xd4\xdb\x86\x08\x08\x6c\x84\x77\x62\xbc\x56\x1f\xd3\x7e\xf1\xe8\x6b\xe8\x1b\x33\x17\x58\xdf\xb7\x30\x0e\xc5\x27\xfa\x63\x32\x07\xb2\xa9\x74\xf4\x33\x56\xfb\x66\xe7\x87\x80\x18\x26\xcb\x4b\xd2\xe7\x80\x7c\x9b\xe4\x43\x48\x6c\x16\x24\xf9\xdc\xe7\x90\x4e\xf6\xa3\x0f\xe1\x49\x92\xc0\x32\xd9\x2b\x78\xf7\xd5\xfd\xb9\x32\x09\x15\x49\x02\xa3\x87\xe8\x99\x24\x1c\x95\xcf\x93\xb7\x73\x99\x40\xb7\xae\x14\x72\xa8\xb9\xa0\x29\x40\x44\xbc\xcd\xed\xf5\xd0\x34\xf1\x2e\x14\x14\x4d\x85\x65\x04\x13\xa0\x3d\x52\xff\x43\xb1\x0c\x73\xbe\x1d\x1d\x94\x5f\x02\x10\x62\x3e\x44\xcf\x22\xec\xd1\x27\xea\x0a\xe6\x63\x42\xb0\xac\x87\xa8\x7f\xd1\x3b\xfa\x44\xfd\xfb\xa7\x6f\xef\x48\x7c\xff\xf9\xdf\x67\xaa\xfe\x2e\xbd\x02\xbc\xa0\xb8\x71\x84\x5f\xc6\xc8\x73\xcc\xaf\xb7\xa0\x4f\x51\xf5\x16\xc0\x05\x76\x51\x96\x61\x7e\x8e\x5e\x6c\xc0\xf7\x37\xab\xeb\x8d\xed\x0e\x05\x07\xdc\xe1\x83\x3f\xe8\x49\xd4\xe5\x3d\x72\xfb\x1e\x34\xb0\x88\x89\x77\x7f\xd7\xe6\x7b\xb9\xa1\x7e\xbf\x88\x15\xdf\x8b\x7a\x74\x31\xa9\x62\x1b\x89\x77\x03\x1f\x91\x17\x85\x7d\xeb\x61\x6c\x58\x09\xaa\x8c\x51\x94\x50\x6b\x84\x5d\xca\x55\xa0\x09\x29\xa2\x00\x42\xa9\x56\xe2\x85\x56\xd8\xb7\xc8\x87\x03\x9d\x9d\x0a\x7f\x10\xff\xbc\xbc\x10\xf8\xa7\xa3\x2c\x9e\x09\x3a\x22\x9e\x92\xbf\x3a\x12\x39\x8b\xbc\x7c\x1e\xc0\x3c\x5c\x75\xbb\x8a\x60\x86\xb1\x36\x41\xb1\xd1\xfa\xe1\x3d\x3a\xf2\x44\x71\x7f\x38\xe2\x76\x4c\x38\xba\xc3\x9a\xcb\x1b\x48\x7f\x29\xf8\xf4\x4c\xf5\xf8\x15\x14\xc8\x95\x39\x08\x89\x82\xc5\xb3\xe6\x37\x93\x3b\xaf\x62\x4b\x41\x8e\x67\x09\x8b\x9e\xc2\xf1\x8f\xba\x1a\x88\x3c\xd0\xff\xcf\xc3\xff\x8a\xb1\xc7\xff\xb5\xe8\x04\xdc\x42\xe1\x9d\x43\x61\x4e\xa8\x67\x0d\x9d\x2d\x2b\xcf\xbf\x39\x01\xf5\x46\xa5\xf2\xf9\x4b\x6b\x3c\xe4\x7a\x98\xdd\x29\x02\x24\x43\x33\x7a\xb6\x36\x03\xd7\xf1\x0a\x16\xf7\x19\x2c\x17\x98\x48\x45\xf2\x97\x80\x25\x3f\x03\xa6\x22\x09\x7f\x09\x12\xfb\x19\x24\xcb\x16\x04\x4f\xe9\xdf\x00\xf6\x61\xb7\x43\x3e\xe8\x79\xc7\x77\xb1\x7c\x5f\x15\xe7\x17\xbd\x1e\xa0\x03\xd1\x45\x08\xfd\xa7\xa0\x30\x11\xe4\x8a\x06\xda\xf4\x1b\x15\x3d\xbe\xc7\x3e\xea\x79\x6b\x02\xd0\xe0\x43\xf2\x31\x7a\xe6\xda\x9c\x0c\x73\x79\xa3\xec\xaf\x0d\xc4\xde\x1f\xe8\xc6\xc5\xb4\x5b\x63\xf9\x7e\xf8\xf1\x1d\xd6\xaf\xd7\x63\x6b\xd8\x82\x16\x79\x88\xde\xff\x85\x81\xe8\x85\xbb\xf3\x31\xf2\xf1\xe0\xce\x74\xf4\x99\x7a\x08\x5b\x7a\x80\xe7\x54\xfc\x1d\x8d\x04\x96\x24\x0b\x92\x87\xc7\x84\x06\x25\xf2\x48\xd1\x27\x55\xfe\xee\xf5\xf0\x18\x6e\xd7\x54\x8c\x8a\xfe\xec\xe7\x5f\x9f\x02\x5b\xdc\x06\x46\xb0\x71\x0e\x2b\x78\x51\xcb\x39\xb0\xbb\xfc\xbc\x71\xa7\xee\x16\x3f\x43\x2c\x4c\xff\xb3\x0c\x25\x60\x6b\xe4\xda\xc7\xd3\xbd\xee\x07\x2d\xe6\x73\x3d\x72\xf9\xd6\xe5\xc8\x59\xa7\xb3\x0e\x09\x49\x45\xe2\x43\x34\xe1\x17\x06\xf9\xef\xd1\x47\x3f\x88\x79\xa2\x5d\x6c\x53\xfb\x1c\xc2\xc9\x74\x6a\x2a\x5a\x47\x1f\x43\xf3\x41\x31\xa1\x14\x7d\xa2\x4e\x74\xd5\xd9\xf5\xc4\xcf\x01\x5f\x08\xcb\x11\xb0\x65\x0a\x1f\xc1\x3d\x58\x2f\x1a\x39\x6b\xf5\x31\x2d\xfe\xd3\x43\xd4\xdb\xfc\xa3\xf7\xe7\xee\x34\xa7\xfc\xef\x9d\x38\xf1\x34\x5b\xfd\xaa\x87\xe9\x9f\x2a\x1c\x36\x3a\x55\x83\x0f\xd1\xaf\xe4\xc5\x7e\x9c\x12\x7b\xbe\xe4\x3c\x57\x7b\x6a\xc3\x8b\xb0\x8c\x7f\xa7\xf3\xca\x42\x0f\xe1\x3c\x9f\x70\x37\x2c\xfa\xc8\xd5\x31\x21\xf2\xdf\x3c\x6f\x42\x2b\x11\x7c\x3f\xaf\xf7\x94\xb9\x2a\x0c\xfd\x9a\xaa\xe7\x70\x79\x0d\x2f\x0a\xcf\x2c\xc7\xc4\x4f\x7e\xd4\xe5\x21\x7a\xc6\xbd\x5b\xbf\x0a\x10\xfd\xbf\x58\x07\x8e\x6a\xa9\x24\x48\xa3\x0e\x52\x98\xee\xaf\x84\x2f\xc2\x83\x6e\xdc\x04\xee\x91\x94\xcf\xa0\x86\xed\xbe\xb6\xb8\x8e\xd0\x4d\x68\x19\x18\x59\x9f\x23\xcd\x63\x71\xf7\x09\xec\x7b\xab\xe8\xeb\x86\xdb\xf9\xb4\xdd\x37\x6e\x6f\x5d\x7f\xf8\xd3\x96\xdc\x51\x9e\x6f\x9e\x10\xde\xb0\xe5\x6e\x5f\x21\x38\x5b\x27\x9e\x79\x12\xa6\xfc\xab\x48\x30\x21\xb0\xa0\x35\x82\x82\xed\x39\xbd\xf7\xcc\x94\xf0\x2a\xc6\x7d\x33\xe5\x04\xa8\x08\xff\x10\xd0\x9b\x26\xd9\xb5\x09\x1e\x8d\xfe\xd1\x59\x3b\xc9\x2e\xfc\xf4\x70\xfb\x1f\x31\xb9\x43\xec\x02\xe4\x04\x8c\x2c\x72\x48\x3a\xa2\x5e\xa9\x6f\xdf\x12\xdf\xc3\x43\x91\xa0\x2a\x0c\x76\xfe\x9e\x80\x5b\x02\x91\xf8\x70\x33\x9b\xec\x89\xfa\x46\x09\xb6\x69\x42\x44\xfc\x37\x03\x3d\x53\xae\x8a\x44\xec\x26\x34\x2c\xf8\x4e\xb4\x7f\xfc\x78\xdc\xe4\x03\xc8\xc1\x6b\x70\xc2\xa0\xe5\xd4\x86\xc1\x3b\x85\x8e\xfa\xd4\xaf\xf6\xc8\x3c\x12\xf3\x8d\x32\x00\x51\x9e\xa9\x28\x1d\x7d\xf2\x2c\x2d\x60\x79\xdf\x8d\xeb\xf7\xb3\x47\x9f\xa8\x23\xc3\x9f\xbf\x96\x24\xf4\xf8\x74\x64\xde\xdd\xe3\xf0\x0f\x52\x9e\xa8\xef\xa7\xca\xfb\x1d\x51\xe3\xec\x45\xef\x5f\xc1\xeb\x3d\x51\xe7\x12\xa5\x53\x0c\x3e\x19\x30\x90\xa0\x0f\x87\xbb\xcc\xb3\xf8\x0b\xa3\x05\x01\xe6\x8f\x06\x7b\x4f\x70\xf8\x70\x98\xa7\xbf\x9f\xf5\x7e\x62\xe2\xc7\x8c\xf0\x5a\xfc\x43\xb8\x3d\x1d\xf2\x24\xfd\x36\xfe\xf7\x3b\xe8\xfe\xcf\x87\x38\x9e\x85\x4a\x1e\x8f\x8a\xe6\xb7\xb3\xa5\xec\x00\x93\x02\x86\xf1\xbe\xa0\x8e\x4b\xc9\x3f\xf2\xfa\x11\x18\x46\xf4\x34\x01\x26\xc0\xea\x8b\x9a\x25\x58\xac\xcf\xe1\xe7\x51\xc1\x5d\xe6\xa5\x9e\x64\xd5\xfa\x5b\x1b\x25\x01\x11\x46\x28\x02\x78\xff\xb5\x3c\xaf\x91\x38\x7b\x48\xa3\x15\x55\xa0\x61\xf9\xd6\x3b\x59\x82\x34\xf6\x0b\xc3\xfc\x3a\x1b\x39\x30\x40\x02\x30\xc1\xb6\x1a\xdf\x6a\x37\x73\x92\x83\xca\xf0\x17\x26\xef\x5c\xd4\x0a\xda\x04\x7b\xc5\x79\xa6\xf0\xfb\xcd\xd7\x13\x93\x27\x72\x71\xc5\xf5\x3d\x2b\xfc\xfc\x87\x4c\x8e\x57\xe8\xf0\xf1\xf7\x4b\x44\xd5\xd2\xd5\x23\xb8\xf3\x9f\x20\x29\xf9\xed\x6e\xbd\x8d\xe6\xc6\xab\x6b\xfe\xdb\x0f\xe5\xff\x72\xeb\x9d\x34\xa7\x29\xe1\xd4\xc7\x37\xc8\x02\xa2\x2e\x2e\x0f\x9f\x5c\x2d\xbd\x7b\x15\xf6\xc2\x8d\x09\x7e\x29\xe0\xce\xdb\x60\x22\xc1\x1b\x4f\x22\xc1\x3b\x3c\x23\x14\xfd\xf6\xe1\x7b\x73\xae\xd0\xbb\xba\xf9\xfa\x09\xbf\x0f\x09\xf5\xc7\x50\xc4\x6d\xde\xbf\xf9\xfc\xfe\x84\x5d\xb7\xb3\xb1\x0f\xaf\x78\xfa\x1b\x45\xfe\xcc\xa5\xf9\xff\xe5\xfd\xff\x58\xde\x15\xee\x6d\x18\x5a\xf6\x54\x68\x2c\x3f\x9f\x5f\x2a\xb8\xbc\x28\x7c\x6d\x7f\x7b\x5c\xba\x7f\x7d\xf2\xeb\xa2\xfd\xe9\xda\xbb\xbc\x30\x72\xe5\x4a\xdd\xb9\x4c\xfd\x67\xa1\xdf\x74\xac\xc2\x4b\xe2\x43\xe0\x1e\x18\xf6\xf7\x8d\x74\xe1\x64\x9d\x0c\x75\x98\xa4\xcb\xb1\xfe\x03\xd4\xc1\x0b\x1d\x5c\x44\xfe\xe1\x85\x0e\x7e\x3b\xfa\xff\x0d\x00\x00\xff\xff\x2c\xca\xe7\xea\x4c\x7a\x00\x00")
+var _staticComparison_templateHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x58\x5d\x6f\xdb\x36\x14\x7d\xcf\xaf\xe0\x54\x14\x4d\xb1\x48\xb2\x93\x34\x49\x5d\xd9\x40\xfa\x01\x6c\x45\xdb\x20\x6d\xba\x76\x7b\x29\x28\x91\x92\x38\x53\xa4\x46\xd2\xb1\x5d\xc3\xff\x7d\x97\xa4\x64\x4b\x89\xbd\x66\x7b\x1a\x90\xc4\x12\x79\x3f\x0e\xcf\xbd\x3c\xa4\x93\xfc\x44\x64\x66\x96\x35\x45\xa5\xa9\xf8\xe4\x20\xb1\x1f\x88\x63\x51\x8c\x03\x2a\x82\xc9\x01\x8c\x50\x4c\x26\x07\x08\x25\x15\x35\x18\x65\x25\x56\x9a\x9a\x71\x30\x33\x79\x78\x11\x6c\x27\x04\xae\xe8\x38\xb8\x65\x74\x5e\x4b\x65\x02\x94\x49\x61\xa8\x00\xc3\x39\x23\xa6\x1c\x13\x7a\xcb\x32\x1a\xba\x97\x23\xc4\x04\x33\x0c\xf3\x50\x67\x98\xd3\xf1\xf0\x08\xe9\x52\x31\x31\x0d\x8d\x0c\x73\x66\xc6\x42\xde\x0b\x4c\xa8\xce\x14\xab\x0d\x93\xa2\x13\xfb\xf2\xaf\x19\x36\x52\x50\xf4\x29\x53\x94\x0a\x5d\x4a\x83\x5e\xc9\xaa\xc6\x8a\x69\x29\xee\x05\x29\xa8\xa0\x0a\x1c\xd4\xae\x10\xb7\xab\x55\xf4\x1b\x55\x1a\x32\xac\xd7\xf7\x5c\x95\x4c\xa5\xd1\x1d\x3f\x21\x99\x20\x74\x71\x84\x84\xcc\x25\xe7\x72\xee\x5d\x0c\x33\x9c\x4e\x56\xab\x39\x33\x25\x52\xd4\x72\x71\x63\x87\xd6\x6b\x08\xbf\x5e\xa3\x10\xad\x56\x54\x90\xf5\x7a\x27\xe2\x24\xf6\xfe\x36\x12\x07\x42\x20\x02\x1f\x07\xda\x2c\x39\xd5\x25\xa5\x40\x6b\xa9\x68\x3e\x0e\x4a\x63\x6a\x3d\x8a\x63\x6d\x70\x36\xad\xb1\x29\xa3\x54\x02\x3c\xa3\x70\x9d\x11\x11\x65\xb2\x8a\x37\x03\xf1\x69\x74\x12\x0d\xe3\x4c\xeb\xed\x58\x54\x31\xb0\xd2\x3a\x80\x44\x08\xaa\x61\x68\xa1\x98\x59\x42\xaa\x12\x9f\x5c\x9c\x86\x45\x71\xb5\xfc\x38\x60\x5f\x5f\xa5\xef\xaf\x6f\x4f\xbe\xb2\xba\xc2\x27\xa7\xef\x5f\xff\x4c\x7e\x89\x87\xf9\xf5\xf9\xc5\x69\xfc\xe7\x59\xf6\x7b\xcc\xde\xde\x5c\x7f\xbe\x2a\xb3\x2f\xea\x7c\xf1\xfc\xed\xad\xfc\xb8\xb8\x39\x7e\xff\xc7\x7c\x78\x03\x3c\x29\xa9\xb5\x54\xac\x60\x62\x1c\x60\x21\xc5\xb2\x92\x33\xed\x39\x72\xeb\x41\xb6\xeb\xc6\x81\xa1\x0b\x63\xb1\xb9\x19\x84\x72\x40\x48\x15\x5a\xb9\x17\x84\x52\xa9\x08\x55\xd0\x16\xf5\x08\x0d\xeb\x05\xd2\x92\x33\x82\x54\x91\xe2\xc3\xc1\x11\xf2\x3f\xd1\xf0\xf8\xd9\xd3\x17\x8d\x43\x85\x15\x64\xf4\x0e\xcf\x06\xf5\xa2\x1d\xaf\x31\x21\x4c\x14\xfd\x41\x9b\x3b\xc4\x9c\x15\x62\x84\x32\x28\x2a\x55\xed\x4c\x0e\x55\x0e\x35\xfb\x4e\x21\xed\xf1\xd6\x21\x93\x5c\xaa\x91\xcd\x7f\x78\x76\x71\x84\xfc\x6f\x93\x7b\x7d\xd0\x5d\x40\x84\x67\xa6\x84\xe5\x7f\xc7\xb6\x63\x37\xeb\xa9\xf0\xc2\x6f\x81\x11\xba\x18\x74\x90\x78\xd8\x23\x34\x40\xe0\x27\xd1\x09\x4c\xb9\xa7\x5d\x48\x39\xcd\x4d\x3b\x3e\x2f\x99\xa1\xa1\xae\x71\x06\x48\x6b\x05\xfb\x0b\x8a\xdb\xc3\x63\x7b\xa1\x69\xae\x0e\x0a\x47\x12\x34\xb4\x91\xd5\xc8\x65\xdb\xeb\x13\x65\x58\x91\xd0\x6a\x40\xa7\x2c\x73\x28\x4b\x98\x2a\x8a\xa7\x23\xe4\x3e\x00\x1b\xdf\x1b\x82\x55\xc5\xd6\xd3\x2f\x7e\x38\x18\x3c\x7e\xd1\xab\xf1\x83\xea\xbb\x0b\x9f\x90\xa0\x22\x9b\xad\xb4\xba\x5b\xef\xa1\xa5\x19\x0d\x7e\x5c\xf2\x14\x76\x52\xa1\xe4\x4c\x90\xb0\x29\xf3\xa3\xfc\x22\x7f\x9e\xe3\x3b\xc5\x7f\x74\x96\x9d\x3f\x3b\x27\x7d\x40\x52\xf0\x65\x08\xd2\x28\x0a\x4a\xba\xf0\xa2\x99\x68\x47\x5b\x64\x84\xe9\x9a\xe3\xe5\x08\x74\x43\xd0\x36\x0a\x6c\x8a\xd8\xed\x0a\x50\xe0\xd8\x0b\xee\x41\x92\x4a\xb2\x74\xfb\x45\xe0\x5b\x94\x71\xac\x35\xa8\x0e\xbe\x4d\xb1\x42\xfe\x23\x24\x58\x4d\x51\x5a\xb8\xcf\x66\x03\x25\xb8\x6f\x0a\x65\xc2\x82\xb4\xba\x81\x1b\xb1\xfb\xe6\x95\x29\xb2\x6a\x1f\xf4\xd5\xea\x9d\x2c\xe4\x7a\x9d\xd8\xa2\x69\x95\x8d\x03\x27\x5b\xe0\x4f\x59\x51\x82\xec\x9d\x0c\x82\x36\x01\x09\x99\x00\x91\xa2\x61\xca\x65\x36\x45\x8e\x55\xbb\xf3\x50\xa5\xc2\xe3\x00\xde\xc1\xdc\x06\x77\x72\xb7\x57\x11\x61\x9e\x6b\x78\xb9\xbc\xfe\x7c\x79\x73\xf5\xe1\x4d\x63\x9f\xc4\xd8\xad\x3d\x86\x65\x4c\x2c\xcb\x09\x61\x1b\x16\xac\x08\x63\xc8\xac\xc2\x9c\xcf\xa0\x61\x2a\x13\x9e\x06\x88\x11\x3b\xd3\x52\xdf\x2a\x4a\x52\x1e\x4f\xf6\x68\x2d\xcc\x78\x93\xba\x0d\xec\xda\xa3\x9a\x19\x4a\x1a\x6f\x04\x72\xdd\x54\x70\xeb\xa9\x41\xc7\x65\x0e\x33\x9c\x0a\xb4\x6d\xbe\xbe\x41\x8d\x0b\xaa\x51\x5b\x7d\x8f\x0b\x1e\x1c\x0b\x49\x26\x89\x3d\x24\xda\xd1\x2f\x30\x68\x97\xec\x86\xa3\x26\xf1\x4d\x49\xa1\x59\xf2\x9c\x2a\x2a\x32\x8a\x98\x46\x06\x46\x40\xa0\x15\xb5\xd9\xdd\xcb\x76\x5d\xa6\xc4\xa6\xcd\xf6\xa2\x49\xee\x72\xe1\xae\x15\x13\xc8\xb6\x2a\xb2\xe7\x1d\x9c\xbd\x02\xd9\x60\x76\x33\xb6\xae\x3e\x79\x12\xd7\x0d\x33\x5d\xd2\x67\x1a\xe4\x22\xb4\xdc\x2b\xc9\x51\xfb\x5a\xd2\x6c\x9a\xca\x05\xaa\x52\xa8\x41\x4b\x5a\xc2\x44\x3d\x33\x8d\xc4\xb7\x26\xc1\xee\x48\xa1\xb3\xf5\xe5\xb3\xe0\x5e\x79\x24\x41\x13\x0a\x01\x58\x0f\x0e\x3a\x4e\x66\xb3\x0a\x76\x6d\x54\x50\xf3\x86\x53\xfb\xf8\x72\xf9\x2b\x39\x7c\xd2\x29\xfb\x93\xa7\x91\x4b\xf3\x8e\x69\x13\x19\x59\x14\x9c\x1e\x3e\xe9\xee\xcf\x27\x47\x40\x16\xd3\x91\x83\x45\xc9\xd3\x2d\x68\x8e\x53\xca\xf7\x80\x74\x73\x01\xc8\xbb\xea\xa3\x9c\x5c\x59\x3e\x81\xdc\xf9\xa6\xd6\x8e\xfc\x24\x76\x1e\x0d\x8d\x31\xf0\x38\xf1\x5a\xb1\x5a\x29\x6b\xb6\xaf\x6f\xee\xd3\x0e\xfa\x8b\xba\x22\xbe\x62\x39\x8a\x9a\xf4\xeb\x75\x93\xb4\xdd\x45\x1b\xbd\x69\xb6\xd1\x76\x71\x77\x42\x36\x92\xbe\x99\x47\x3e\x30\x37\x28\x7a\xbd\x6d\xba\x41\x34\x68\x20\xb9\x18\x70\xd0\x88\x36\x48\x8a\x09\xac\xc2\xfd\x0d\x35\x05\x9a\x40\x84\x96\xc1\x64\x26\xa6\x42\xce\x61\x73\x59\xdb\x6e\x70\x8b\x0f\xf5\xa0\xff\x38\x2e\xb1\x96\xca\x6a\x48\x0d\xd7\x43\x93\xa3\xe0\x71\x34\xcc\x83\x2e\xc4\xf5\x7a\xd3\xbb\xbb\x73\x3e\x08\xff\x2c\xcb\xa8\xbd\x83\xfc\xe7\x44\xa2\xb7\x1e\xdc\xc8\x2d\x08\xdc\xe7\x8f\xef\xac\x7c\x1a\x38\x72\xed\xad\xf9\x5b\x0a\x77\xeb\x69\xe0\xef\x75\x42\xca\xda\xde\x48\xe1\x28\x00\x73\xaa\x94\x5f\xab\xf7\x69\x04\xb0\xd3\x3e\x7b\x0a\x69\x8f\x89\x4e\x19\xbb\xf3\xaa\xb9\x95\xee\x9a\x83\xe3\x2c\xac\x48\x78\xd6\x33\xb0\x72\x79\x36\x79\x49\xa1\xcd\x29\xd0\x55\xc1\xb1\xbe\x4b\x1a\x01\xa3\xb7\xf9\x64\xb0\x99\x69\x0b\xd6\xd9\x4e\x40\x53\xcf\xfa\xf1\x7c\xbb\x7a\xeb\x0e\x45\x77\x68\xca\x19\xa7\xb0\xea\xad\xe1\x3d\xc6\x26\xdd\x13\x69\x87\xb9\x3b\x6d\xfc\x6b\x80\xb8\xc4\xf6\xf4\x1f\x07\x1c\x7f\x07\x6e\x3a\x54\xee\xe9\x8c\xbb\xe4\xf4\x2e\x15\xc1\xe4\x83\xec\x6c\xd7\x5e\x35\x76\x37\xc0\x9d\x92\xfd\x0b\xea\x2f\x73\x7b\x7b\xfc\x47\xe6\x9d\xc9\x43\x89\x77\xc6\x0f\xe0\xbd\xb1\x7b\x20\xed\x1b\x6b\xc7\xba\x7b\xfb\x1f\x92\xde\xdf\x36\xdb\x97\xce\x63\x0f\x53\x52\xdb\x9c\x9d\xa3\x73\x9b\x1e\x06\x40\x05\x40\xfd\xe1\x7a\x68\x0f\x51\xb8\x3a\x97\xee\x00\xd5\xd1\xe6\xac\xdc\xe2\xd9\xca\x7d\xd2\x7c\x1d\xb0\x67\x9b\x7f\x0c\x5a\x63\x57\x9d\xee\x97\x84\x1d\xda\xdf\xfb\x12\xe1\x8b\xdf\xf7\xe8\x2f\x65\x43\xc7\xee\x0b\x4d\x7b\xc3\xea\x7f\xd3\x6d\xf0\x27\xb1\xc7\x67\x2f\xa0\xfe\xe2\x69\x6f\xa2\xee\x7f\x02\x7f\x03\xf4\xc0\x24\x5a\x24\x10\x00\x00")
+
+func staticComparison_templateHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_staticComparison_templateHtml,
+		"static/comparison_template.html",
+	)
+}
+
+func staticComparison_templateHtml() (*asset, error) {
+	bytes, err := staticComparison_templateHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/comparison_template.html", size: 4132, mode: os.FileMode(420), modTime: time.Unix(1792141859, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticReport_templateHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x7d\xe7\x7a\xe3\x38\xb2\xe8\xff\x79\x0a\x8e\x66\x76\x65\x1f\x59\xa2\x72\x70\xb7\x3d\xab\x64\x39\x29\x58\x92\x2d\xd9\x3d\x7d\x66\x28\x06\x89\x32\x83\x44\x52\xb1\xaf\xdf\xfd\x22\x91\x04\x83\x82\xdd\xdd\xbb\x7b\xbf\xef\xee\x39\xd3\x16\x49\xa0\x50\x28\x14\x0a\x55\x85\x42\xe1\xf3\xaf\x82\xce\x5b\x9b\x99\xc8\x4c\x2c\x55\xb9\xfc\xe5\x33\xfc\xc3\x28\x9c\x36\xbe\x88\x88\x5a\xe4\xf2\x17\xf0\x46\xe4\x84\xcb\x5f\x18\xe6\xb3\x2a\x5a\x1c\xc3\x4f\x38\xc3\x14\xad\x8b\xc8\xc2\x92\xe2\xc5\x88\xfb\x41\xe3\x54\xf1\x22\xb2\x94\xc5\xd5\x4c\x37\xac\x08\xc3\xeb\x9a\x25\x6a\xa0\xe0\x4a\x16\xac\xc9\x85\x20\x2e\x65\x5e\x8c\xa3\x87\x33\x46\xd6\x64\x4b\xe6\x94\xb8\xc9\x73\x8a\x78\x91\x3a\x63\xcc\x89\x21\x6b\xaf\x71\x4b\x8f\x4b\xb2\x75\xa1\xe9\x01\xc0\x82\x68\xf2\x86\x3c\xb3\x64\x5d\xa3\x60\x97\xe7\x0b\xce\xd2\x35\x91\xe9\x8a\xa8\x55\x7f\x2d\x6e\x61\x4d\x74\x83\xaa\xd0\x94\x41\x07\x44\x85\xb9\x16\x35\x43\x7e\x35\x45\x8d\x39\x99\x58\xd6\xcc\x3c\x67\x59\x6b\x25\x5b\xa2\x91\xe0\x75\x95\x55\x41\x29\xbb\xc0\x69\x00\xe8\x58\xd4\x44\x03\x34\x6b\x84\x21\xb2\xfc\xf6\x2d\xf1\x24\x1a\x26\xc0\xf3\xed\x2d\x50\xd5\xd0\x47\xba\x65\x52\xf5\x34\x5d\xd6\x04\x71\x7d\xc6\x68\xba\xa4\x2b\x8a\xbe\xc2\x55\x2c\xd9\x52\xc4\xcb\x6f\xdf\x00\x4a\x13\xc6\x40\x7d\xeb\xc3\x57\x6f\x6f\x00\x3c\xfc\x47\x54\x4c\xf0\xe0\xeb\x3e\x78\xad\x09\x6f\x6f\x9f\x59\x5c\x1d\x02\x52\x00\x55\x01\x00\xe5\x22\x62\x5a\x1b\x45\x34\x27\xa2\x08\xc6\x66\x62\x88\xd2\x45\xc4\xee\xb8\x69\x71\xfc\xeb\x8c\xb3\x26\x89\x91\x0e\xb0\xb3\x0c\x6e\xc6\x0b\x1a\x22\x84\xf3\x82\xcd\x26\x32\x89\x14\xcb\x9b\xa6\xfb\x2e\xa1\xca\xa0\x94\x69\x46\x40\x43\x0c\x18\x52\x4b\x1c\x1b\xb2\xb5\x01\x4d\x4d\xb8\x4c\x31\x1b\x1f\x8f\xdb\x9b\x6e\x52\x1e\x56\x47\xcd\x87\x65\x66\x28\xcf\x54\x2e\x93\x6d\xd6\x62\xc2\x35\x9b\x92\x1e\x0a\xc5\x2c\x3b\xcd\xf3\xcf\xac\x7c\xdb\x7f\x78\x6c\x4f\xf8\x81\x51\x58\x97\x6e\x97\x7a\x77\xdd\x4f\x37\x5f\x56\xa9\x3e\x20\x93\xa1\x9b\xa6\x6e\xc8\x63\x59\x03\x63\xa9\xe9\xda\x46\xd5\x17\x66\xe4\xe8\x9e\xc1\x6e\x4c\x4d\x41\x54\xe4\xa5\x91\xd0\x44\x8b\xd5\x66\x2a\xbb\x94\xcd\xa9\x19\x07\x4f\x2b\xdd\x78\xfd\x57\x36\x91\xce\x26\x0a\xac\x20\x9b\x16\xfc\x72\xa8\x4f\x93\x65\xbe\xd7\x2f\x37\x16\xaf\xd9\x79\x7f\xa5\x1a\x9b\xab\xd1\xcb\x4b\x5f\xcb\x3c\x18\x8d\xee\xe6\x65\x90\x32\xf5\x6a\xe9\x8e\xad\x6d\xf2\xc5\xad\x59\x34\x17\xa3\xca\x55\xfb\x31\x5f\xb2\xc6\x6c\xa3\xf1\x22\xbd\xde\x54\x46\xfb\xfb\x84\x7a\xc2\xc0\xe9\x78\x11\xb1\xc4\xb5\x05\xe9\x8d\xbe\x30\x8c\x04\xa8\x2e\x1a\xcc\x37\xf4\xc0\x30\x23\xdd\x10\x44\x03\xcc\x97\xd9\x39\x93\x9a\xad\x19\x53\x57\x64\x81\x31\xc6\x23\xee\x24\x79\xc6\xe0\xff\x4f\xa4\xd2\xb9\xd3\x4f\xa4\x82\xca\x19\xa0\x45\x5c\x21\x97\x9c\xad\xed\xf7\x33\x4e\x10\x64\x6d\xec\x7d\x09\xdb\x8e\x73\x8a\x3c\xd6\xce\x19\x1e\xf0\xa9\x68\xd8\x5f\x24\xc0\xb8\x71\x53\xde\x8a\xa0\xd9\xb4\x5b\x81\xd7\x15\xdd\x38\x87\xed\x9f\xe4\x8b\x67\x0c\xfe\x8f\xb4\xfd\xf6\x0b\xdd\x01\xce\xe9\x02\xa9\x23\x6b\x13\x11\x90\x98\xf9\x55\x56\x21\x0f\x73\x9a\xe5\xc1\x42\x10\x79\x1d\x4c\x36\x30\x9d\xce\x99\x05\x98\x2a\x06\x18\x77\x31\x0c\x70\x02\xcf\x75\x79\x8b\x0a\x3b\xad\xa8\xdc\x1a\x0b\x9d\x73\xa6\x98\xa4\xba\x88\xe9\x71\xce\x24\x19\x50\x4f\x67\x32\xe0\x13\xfa\x15\x46\x02\x45\x94\x1c\xa4\x56\x13\x20\x25\xe2\xe6\x8c\xe3\x01\x09\x66\x06\x90\x68\x60\x26\x78\xf0\x49\xf0\x9c\x01\x46\x14\x08\x99\x6f\x5e\xda\x83\xa9\x6f\xe9\x2a\x4d\x69\x7f\x8d\x38\x80\xad\xfa\x09\xf4\x5b\xa6\x98\x11\xb2\xa9\x43\x63\x13\x0e\x2b\x31\xe3\xc6\x62\x1c\xbc\x13\x1c\xb0\x84\x1a\x99\xe4\x8e\x01\xa7\x7b\x6b\x53\x29\x9d\x03\xe4\x49\x41\x1a\xe5\xec\x5f\x76\x11\x30\x73\x66\x0a\xb7\x81\x03\x09\x87\x26\x3e\x52\x74\xfe\xd5\x8b\x92\x09\x18\x4c\x11\xe3\x18\x15\xc0\x40\x1c\x28\x67\x50\xa8\x9d\x1d\x2e\x06\x17\x21\x20\x55\xe3\x16\x37\x02\x33\xe4\x9b\x7f\x10\x01\x4e\x08\x39\xf2\xc3\xdb\x3c\x02\x00\x56\x0f\x51\xd4\xcc\x89\x6e\x51\xb0\x6d\x38\x33\xdd\x94\x31\x8b\x01\x81\x02\xf8\x67\x29\xda\xbd\xd3\x97\xa2\x21\x01\xb1\x7c\xce\x4c\x64\x41\x10\xb5\x4f\xde\xf9\x67\x0f\xe9\x11\x53\x70\x07\x36\x0e\x0e\x40\xa2\x6a\x36\x16\xe8\xb7\xa4\x1b\x60\xfc\x72\x26\x23\x72\xa6\x18\xd7\x17\xce\xa0\xf0\x0b\xc3\x84\x8c\xb1\xd5\x75\x35\x2e\x3b\x28\x91\x71\x4d\x25\x93\xff\xd8\xc1\x11\xb0\xe3\x86\xae\xc4\x01\xdb\x2e\xcf\x76\x7c\xd3\x00\x27\xf8\x59\x25\x77\x0c\xc0\xb8\xcc\x53\xd3\x6e\x04\x96\x94\x31\x28\xa5\x09\x71\x59\x05\x3d\x06\x93\xd7\x50\x4e\x22\x02\x67\x71\xe7\xe8\x05\x6b\x2e\xc7\xb1\xb5\xaa\x9c\xfd\x23\xc3\x83\x9f\x0c\xf8\xa9\x99\x17\x51\x28\xb9\x81\xe0\x5e\xad\x56\x89\x55\x26\xa1\x1b\x63\x36\x9d\x4c\x26\x61\xe1\x28\x23\xc9\x8a\x72\x11\xfd\x47\x3a\x93\xe7\x0b\xb9\x82\x10\x65\xa0\xb2\x51\xd1\xd7\x17\xd1\x24\x98\xc6\x45\xa6\x18\xfd\x47\x46\x04\xe0\xe0\x52\xc6\x08\x17\xd1\x66\x2e\x91\xce\x31\x49\x25\x9e\x65\xf0\xff\xa5\x12\xb9\x38\xfc\x2f\x8d\xff\x63\xc8\xdf\x38\x79\xbf\x8d\xb2\x18\x00\x6c\x0e\xfc\x8a\x9c\x1e\xe8\x36\xa4\xd5\x7f\x61\xb7\xd3\x89\x02\xea\x36\xe8\x12\xec\x32\x43\x75\x15\xfd\xb6\xdf\x67\xe3\xe8\xff\x8e\xee\x36\xd0\x54\x64\x1e\xea\x3d\x26\xa3\xc8\x61\x5d\xb6\x05\x16\x46\xd4\x0b\x65\xc4\x09\x63\xff\xc4\x8d\x83\x55\x70\x62\x01\xfe\x0a\x9d\xb1\xe1\x53\x7e\x27\x97\x87\xd4\xb1\x5c\xa1\x87\xd6\x2d\x89\x53\x65\x05\x48\xaa\xb2\xbd\xea\x32\x1d\x43\x3f\x63\xaa\xba\x06\xe6\x2e\x67\x9e\x31\x4d\x51\x53\xc0\x8b\xa6\xae\x71\x3c\xf8\x7b\xbf\xe0\x65\x81\x23\xdf\x45\xf0\x2c\x8f\x44\xbc\x16\xc1\x22\xa0\x40\x4d\x9c\x72\x4f\x0b\xa6\x07\x66\x2b\x79\x53\x91\xa1\x6e\x24\x72\x2a\x03\x94\x40\x8e\xfe\x52\xd5\x17\x86\x0c\x64\x4e\x4b\x5c\x9d\x31\x2a\x78\x85\xd6\x10\xa0\xf9\x82\xd5\x4f\x3a\xa2\x2b\x09\xfc\x22\xbe\xe4\x94\x05\x45\x0e\x20\x87\xe2\x23\xd0\xe0\xeb\x39\x83\xfe\x00\x29\xae\x1c\x23\x7d\xbf\x7d\x58\x90\x1d\xb1\x9e\x8d\xc1\x9a\x38\x79\x97\x9c\x0d\x0c\x2b\xc3\x4c\x44\xcc\x1d\x85\xe0\xb2\x8d\xd5\x98\x34\xf5\x1e\x77\xe3\x5d\x82\x18\x21\x19\x82\x1a\x37\x02\x00\x16\x96\x83\x1a\x6a\x2b\x69\x3f\xc1\xd5\x91\x7a\xdc\x83\x77\x90\x45\x31\x59\x14\x9d\x83\x1a\x57\x1c\x2e\x2d\x60\xe1\xfc\xb7\x60\xc0\x30\xdb\x38\x32\x34\xce\x99\x12\xf8\xdf\xa7\xdd\x73\x57\x42\xff\x3b\xac\x08\x12\xbd\x91\x8c\x44\xee\xa8\x9e\x26\x66\x86\x3e\x36\x44\xd3\xf4\xcb\x01\xdc\x25\x5a\xfd\xf2\x0a\x08\xfa\x8b\xbd\x26\x05\xbb\x9b\x09\x95\x23\xce\x0c\x9a\x24\x4c\xa8\x5f\xd2\xc2\xc4\x5e\x49\x67\xba\x4c\xf7\xcd\xa3\xe3\x69\x7a\x50\xc3\xf3\xc0\x15\xf0\x7c\x05\x82\xfe\x3d\xb3\x72\x25\x2a\x4a\xfc\x15\x00\xd7\x76\x08\xab\xa0\x92\xfd\x11\xa8\x60\x65\x0e\x53\x85\xb3\xde\x39\xb5\x8e\x3b\x34\xa4\x3f\x1c\xa1\xeb\x3a\x3a\x1c\xd1\x6b\x44\x45\xe4\x2d\xd1\xd6\xe8\x3c\x74\x32\xbc\x45\x28\x09\xb4\x8e\x03\xeb\x4a\x80\x4a\x56\x12\xfd\x5f\x06\x4c\xe2\xdf\x92\xc9\xc2\x48\x92\xf6\xb6\x26\x29\xdc\x78\x0c\x20\xc1\x25\x4a\x20\x02\x73\xdf\xba\x04\x18\x3b\xc3\xfb\xd6\x25\xa0\x83\xad\xe2\xaa\x0e\x3a\x37\x5a\x00\x71\xa6\xf9\x59\x33\x60\x30\x1d\x12\x7e\xbf\xb9\xba\x5d\x53\x17\x38\x65\xb7\xc6\x17\x32\x73\x43\x19\xd2\x05\xcc\x69\x4d\xe8\x4b\xf8\xe6\xb7\xdd\xb2\x50\x27\xcf\xbb\x38\x52\x0c\x94\x4c\x14\x0d\x51\xf5\x02\x9a\x2f\x38\xa0\x60\x5a\x40\x34\x0b\xd7\xba\x69\x99\xdf\x0d\xd0\xe2\x5e\x45\x38\xc9\x43\x20\x15\x3d\x90\x9c\xae\x88\x9c\xc1\x4f\x6e\xb4\xd9\x22\x40\x8e\x4c\x3a\xb0\x9c\x38\xe0\x09\x2b\x25\xc4\xa5\x0c\x74\x71\xfe\x30\x6b\xef\xe1\xe0\xb0\xf9\x04\xdf\x78\x9b\x9e\x00\xfa\x00\x1b\x14\xac\x63\x8a\x09\x44\x48\x60\xec\xb2\x49\x1f\x91\x56\x64\x22\x69\x40\x7b\xe7\x14\x9f\x7d\x1c\xaa\x19\x79\x9b\x10\xf6\x08\x11\x1a\x3d\x86\xf9\xcc\x22\x77\xc1\xe5\x2f\x9f\x59\xec\xa2\xfb\xe5\xf3\x48\x17\x36\xc8\x91\xa0\x71\x4b\x86\x07\x2a\x8d\x79\x11\x01\x3f\x47\x9c\xc1\xe0\x3f\x71\x71\x3d\xe3\xc0\x8c\x50\x05\xfb\x85\xc0\x19\xaf\xcc\x68\x8c\xfe\x12\x57\xc3\x67\xce\x5b\x17\x20\x01\xea\xd8\xbe\x95\xdf\x22\x5e\xbf\xd4\xbd\x3e\xd6\xdf\xde\x3e\xcb\xea\x98\x31\x0d\xfe\x22\x82\x1c\x54\x11\x22\x94\x2f\x22\x99\x64\xc4\x86\x06\x74\x62\xca\x44\x64\xd0\xb2\x02\xe7\x17\xa3\x1a\xf1\x74\x04\x3c\x83\xe2\x10\x38\x72\x62\x1d\xf6\x7d\x3d\x3c\x96\xfb\xed\x56\xdd\x71\x7a\x71\x04\x7b\x32\x8f\xbd\x5d\xb0\xf4\x31\x50\x82\x8c\x08\x71\xae\xe0\x32\x11\x06\x2a\xe6\xe4\xdb\x45\x04\x0c\x92\xc2\xcd\x4c\xd1\x7e\x0d\x26\x3a\x74\x74\xfe\x86\x41\x00\xdd\x70\x11\x21\x43\xc3\x19\x32\x67\x5b\x01\xa6\xb7\x04\xfe\x86\xc9\x2c\x0a\x17\x11\x89\x53\x20\x44\xf4\x56\xe1\x46\xd0\x5f\xd5\x47\xed\xc1\x01\x90\xc7\x48\x9b\x24\x74\x87\x0e\x20\x50\x2d\x1c\x73\x64\x67\x44\x2e\xc1\xa0\x83\x22\xa4\xa7\x2c\xee\xc6\x25\x66\xa4\xcf\x82\xec\x0c\xba\xdd\x15\x7b\x94\xdd\xae\xc9\x82\x0d\x19\xa1\xeb\xb4\xbc\x50\x7c\xed\x42\x16\x02\x03\x03\x97\x5e\xa7\x14\x72\xbb\x51\xe5\xb0\x8f\x41\x30\xf4\x19\x90\xde\x1a\x55\xcc\xc7\x44\x71\xe4\xac\xb3\xcb\x91\x2e\xb9\x0c\x85\x90\x42\x6b\x45\xcd\x06\xc5\x00\xca\xee\x1a\x27\xa7\x3d\xaa\x39\x32\x26\x13\xce\x9c\xe9\xb3\xc5\xec\x22\x62\x19\x0b\x71\xc7\x60\x5c\x7a\xea\x75\x60\xbb\x34\xe2\x36\x23\x91\x47\x8a\xaa\x4e\x07\x54\x77\xa4\xd1\x98\x2a\xa2\x30\xda\xf8\xbb\xe0\x6d\xc6\xa5\x87\x03\x05\x12\xcf\x21\x02\x8b\x2a\xb3\xa3\x0d\x10\xb3\xc0\x4a\xe1\xa0\xd7\x31\x72\x59\xd9\x30\x3d\xe7\xd1\x87\xd9\x7b\x60\x42\x19\x63\x22\x70\x48\xe0\x7f\x07\x24\x54\x0c\x41\xaa\xc2\x5f\xdf\x01\x09\x39\xa9\x11\x24\x34\xbd\xbf\x03\x12\xd0\x1e\x0c\x51\x88\x83\xb2\x22\xe9\x65\x0f\xbd\x61\xca\xe8\xcd\x47\x21\x63\xc3\x29\x72\xd9\x43\x7f\x31\xa3\x04\x61\x85\xf1\x07\x78\x07\x16\x28\x03\x4e\x57\xf0\xf3\x43\x8d\xa3\x32\xac\xa2\x03\x5d\x23\x72\x79\x0f\xff\xec\x42\xe0\x3d\xf0\x90\x87\x55\x89\x5c\x76\xd0\xdf\x0f\x03\x43\x68\xc5\xa1\x83\x0a\x90\x7b\x00\xe5\x34\xc6\xf0\x0a\xbe\xf9\x28\x50\x49\x06\x56\xea\x62\x06\x8d\x06\x1b\xea\x15\x78\xc5\x3c\xe2\x57\xef\xa2\x3c\xd0\xfe\x80\x9e\x09\xd7\x1a\x20\x7d\xde\x33\x0c\xde\x8a\x7e\x56\xb3\xbf\xf1\x13\x4e\x03\x2f\x22\x97\xc0\x98\x67\x74\x83\xa9\xa2\x67\x01\xcc\x55\xa8\x94\x54\x48\xb1\x63\x09\x71\x5c\x9b\x63\x5d\x03\xbc\xd8\x80\xbb\x3d\x7b\x9b\xf1\xf5\xf5\x33\xab\xc8\x7b\xc5\xf7\x01\xa9\xed\xe2\x43\x64\x48\x50\x80\xfc\xb8\x26\x70\x97\x91\xf1\x08\xba\x0a\xff\xfc\xa4\x86\x5c\x3b\x09\x70\x1a\xfc\x7d\x07\x7f\xff\xa4\xc6\x1c\xf5\x35\x72\xd9\xb7\x7f\xfe\xa4\xa6\x24\xe8\xa3\xd3\xc6\xa0\xa5\x2b\xf2\xeb\x7d\x0d\xfd\xa0\xb5\xdc\x02\x2b\xe3\x58\xfc\x0f\x2c\xe6\x7d\xd4\xf0\x8f\x59\xcd\x7d\x9d\xf8\x98\x4c\xc3\x16\x2a\x18\x0e\x62\xaa\x7e\x4c\x86\x13\xaa\x22\x92\x5d\xa3\x7d\x08\x04\x07\x2c\x4d\xc0\x7a\x65\xf0\x9b\x7f\xd7\xfa\x84\x71\x01\xc3\x00\xf5\x72\x44\xa2\xc8\x65\x1d\x3d\x11\xea\x23\xa9\xfd\xc1\x2e\xe2\x3d\x40\x1b\xec\x8d\x7a\x18\xac\x8c\x6c\x48\xac\xd5\xc3\x15\x24\x08\xe7\x0a\xbd\xe5\x78\x5e\x9c\x01\x6d\x3e\x31\x35\x75\xed\x8c\x9b\xcd\x14\xe8\xcb\x06\xca\x37\x0b\x5f\x50\x36\x8a\x86\xe4\xec\x77\xd2\x90\xd6\xe3\x3d\xfd\x8d\x43\x8f\x1a\x76\xab\xa9\x0b\xe8\x05\x31\x81\x95\x08\x16\xe4\x29\x0b\xac\x3c\xb8\x9f\xc0\xc2\xbd\x14\x19\xfa\xa6\x21\x07\x7d\x1e\x19\x97\xd2\x39\x03\xd9\xe8\x8c\x59\xa3\x4d\x28\x91\x36\x01\x0e\x8a\xfc\xcf\xec\x42\x71\x7e\xa3\x1d\x25\x82\x15\xfc\x4d\xec\x31\x4c\x32\x6c\x92\xc3\x15\x9c\x56\xf7\x31\x79\xe9\x3a\xc4\xec\x61\xe8\x87\xb8\xa9\xda\x96\x15\x06\x43\x83\x44\x56\x7e\x84\x99\x29\xc0\x08\x9f\xe8\x0a\xa0\xd9\x45\xa4\x87\xbe\x30\x48\x05\x34\xcf\x98\xc7\xee\x3d\xf8\xd7\x12\xf9\x89\xa6\x43\xdd\x02\xbe\xd3\x74\x0b\x70\xb8\xc7\x70\xc2\xb5\x5c\x9b\x85\x85\x38\xd8\xb6\x10\x21\xc1\x67\x16\xc8\x28\x64\x11\x7d\xfb\x26\x4b\x70\x71\x4e\xb4\x67\x38\x5c\x83\x49\x40\xef\xc9\x1b\xb2\x9d\xe1\x88\x22\x14\x89\x4f\xc5\x61\x00\x60\x0a\x2b\xd0\x72\xf5\x3a\xc6\xa9\x11\x23\xcd\x23\xe8\x0e\xe8\xb7\xb7\x1e\x00\xa4\x81\xf1\x1c\x6d\xe0\x36\xbe\xa1\x6b\x63\x60\xc9\x52\xdf\xa1\xb5\x4e\xde\xc2\x8a\xb0\x38\x5c\x49\xdf\xde\x18\x60\xab\x52\x35\xdc\x0f\x54\x0d\x64\xe1\x32\xc8\x20\x0e\x0f\x34\x21\x40\x2d\xce\x32\x41\x41\xce\x62\x20\x24\xf8\x04\xff\x35\x00\xd6\x65\x2b\x01\x87\x16\x7c\x89\xa4\x93\xc9\x7c\x3c\x99\x8a\x27\xd3\x4c\x2a\x77\x9e\xcc\x9e\x27\x73\x4c\xb3\xd7\x8f\x20\xd3\x1a\x9b\xde\xe8\x0f\xd5\xcd\x9b\xda\xdb\xdb\x3f\x55\x20\x66\x74\xeb\x13\xd3\x13\x4d\xd8\x28\x8d\x34\xfc\xee\x47\x97\x54\x37\xa0\x66\xc4\xfc\xfe\x2a\x6e\xce\x98\xdf\xf1\xde\xc5\xf9\x85\x3d\x12\x0e\xcc\x6f\xdf\x60\x89\xb7\xb7\x73\x0a\x2a\x2e\x4d\x01\x66\x5c\xc8\xce\x70\xdb\xaf\xd0\x4f\x44\xa0\xc4\x83\xcf\xbb\xe5\x1d\x70\xbf\xef\xcb\x19\x78\x0e\x18\xd9\x56\x7c\xc5\x19\x1a\x58\x36\xbd\xa3\x4f\x86\x9c\x02\xcc\x70\x12\x8c\x39\x00\xec\x6f\x8a\xfc\x02\x6e\x64\x00\x5e\x56\x45\x7d\x61\x01\xce\x45\x68\x58\x13\x51\x36\x18\x43\x54\x39\x19\x01\x84\xf2\xc8\x64\xc0\xd2\x85\x98\x9d\x31\x5f\xe5\xd9\x4c\x14\xce\xbd\x54\x22\xb1\x40\xbf\x43\x55\x0b\x91\x89\x8c\x2c\xfe\xf0\xf6\x76\x66\xf7\x97\xa2\xd2\x24\x94\x59\x0e\xd0\xc8\xd6\x43\xd0\x9a\xe1\x25\x90\xab\xad\x78\x29\x23\x40\x14\x8d\x50\xc2\xb8\xd8\x28\x60\x1d\x02\x48\x63\xb6\x11\xe7\xcc\x09\x7a\x71\xca\xa4\xde\xde\xa0\x38\x63\x00\x03\xf2\x13\xd1\xb4\x7d\x38\x68\x91\xc4\x2f\x6d\x26\x07\x74\x63\x6c\x14\x18\xa0\xce\x80\x36\x67\x86\xac\x59\x8c\x2e\x31\x1c\xdc\x2c\x83\x61\x64\x09\xa7\xbb\xb6\xc3\x2a\xa8\x6b\x79\xb1\x47\x6a\xd2\x65\x57\x84\x7b\xa7\x1e\xf8\xb4\x92\x14\x46\xb1\xcf\x70\x00\x89\x02\x03\x7f\x46\x5c\x17\x0b\xd9\xdd\xc2\xb2\x0e\xac\x28\x36\x35\x0c\xc0\x06\x70\xa3\x0e\x34\x05\x16\x07\xfa\x09\xb5\x01\xa1\x20\x01\xf5\x99\x44\xae\xc0\xea\xf8\xa7\x47\xb6\x94\xe9\x78\x16\x32\x9f\xe8\x95\xc8\x13\xef\x02\xfd\x66\xfe\x1a\xd4\xb2\x40\xcf\xc9\xcf\x33\x1b\x02\x2d\xd4\x6c\x77\x9a\x57\xae\x30\xce\x0c\x55\x39\x41\xc4\x9c\x8d\x16\x37\x67\x85\x40\x3e\x48\xe4\x70\xd2\x8d\x73\x60\x73\x7f\xa2\xbd\xa0\x23\x20\xee\x23\x97\xff\xfc\x2d\x9f\xcb\x65\x32\x9f\xc8\xc2\x85\x44\x24\xe7\x8b\xd4\xa2\x23\xee\x60\xe4\x19\x58\x4e\x88\xfb\xed\xaf\x91\xc2\xc1\xb1\x23\x91\x7b\x4e\xc3\x4e\x04\x1f\x1c\xbc\xcf\xec\x8c\x10\x7f\x76\x19\x80\x0d\x77\xd5\x47\x8b\x8d\x2a\x72\xbc\x2e\x49\xa2\x18\x08\xf1\x0b\x36\x06\xdd\x99\xd4\x02\x8b\x1c\x9b\xd4\x26\xfe\x4c\x1b\x7f\x82\x86\x59\x3e\x7b\x26\x3f\x55\xda\xdd\x55\xf2\xae\x31\xd6\xcb\xe0\x7f\xad\xde\xe3\xa4\xfe\x38\x06\xbf\xee\xd0\xb3\x52\x2d\x3f\x83\x3f\xb5\xde\xeb\xf5\x5d\x07\xbe\x68\x0c\xbb\x57\x83\xeb\x6e\x7f\x94\x7e\x49\x0a\xe9\xab\xcd\xcb\x43\xa5\xf2\xd2\x28\xc9\x2f\xbd\xca\xed\x68\x70\xa5\xbd\x3c\xdd\x2a\xcf\x83\x6e\x8e\xe7\x15\x05\x56\xa8\xb6\x2b\xb7\xdd\xfa\xd5\xa3\xd8\x32\xcc\x61\xb3\xd4\x79\xaa\xf3\xbc\x96\x4a\x3e\xdd\x36\xd2\x4f\xeb\x5a\xdf\xea\xf5\xa5\xfa\xec\x46\x68\x0c\xc4\x5c\x23\x2b\xdc\x25\x6f\xd9\xba\x34\x6f\xd5\x9e\x9b\xb1\xbb\x14\xc7\x57\xd9\x72\x7d\xb3\xbc\x9d\x57\xaf\x4b\xea\x4d\x55\xb3\x66\xb5\xd7\xe2\xd3\x8a\xd3\x66\xe3\x69\x32\xd5\x2c\xe7\x9f\xd3\x9d\x67\xf5\x66\x66\x9a\x77\xcd\x59\xa6\xb3\x6a\x4b\xeb\xcc\xe0\x5a\x4c\xb3\x62\x7a\x51\xb4\x0c\xf5\xb1\xb8\x19\x0c\x47\x22\xdb\x99\xb6\x85\x42\x61\xcb\xf6\x07\x9d\xfb\xde\xb8\x63\xb5\xb8\x69\x6e\xde\x36\xcb\xe3\xbb\x76\xc5\x7a\xaa\xea\xa3\xb2\x7e\xb7\x9a\xb7\xc7\xe5\xfc\x68\xba\x55\xfa\x3d\xfd\x6a\x58\x7e\x14\x9b\xad\xa7\x4e\x63\xca\x97\x17\xad\x07\x79\x5e\x17\xee\xd6\x52\xaf\xde\xaa\x36\xc7\xfd\x9b\xbb\xed\xb6\xc2\x5d\xdd\xde\x65\xeb\x5a\xb9\xaf\x5d\x55\xcb\x4f\xa9\xd6\xcb\xb4\x30\xae\x6d\x0a\x65\x7e\x58\x5a\x55\x5f\x6f\xb8\xc7\xaa\xf8\xd8\x37\x5e\x36\xe2\x34\x96\x1e\xb5\x34\x6b\xde\xaf\x4c\x1e\xcc\xe1\xa8\xfc\x7a\x53\x6c\x5f\xbd\xde\xae\x44\x56\x10\x17\x83\xb4\x35\x7d\x7e\xec\x64\x4a\x2c\xaf\xe4\xa5\x41\xaa\x35\x1c\x59\xe9\xbe\x90\x66\x25\x38\xee\xf9\xb4\xb2\xe4\xd9\xfe\x2a\xdd\xc8\x4c\xa7\xed\x66\xfe\x85\x1d\x5c\x3f\x56\x53\x03\x6b\xa0\xf5\x67\x99\x5e\x77\x2c\x8f\xac\xd7\xc7\xd1\xa8\xb4\xb4\x9e\xb8\x0c\x7b\x57\x31\x3b\x0b\x85\x35\x62\xba\xde\x6e\xdf\xe7\xf4\x45\xf2\x45\x18\x28\xb3\x5e\x3f\x97\x2d\x3e\xf2\xcb\xfb\x4d\x89\x03\x4d\x6d\xb3\xcd\xab\x47\x96\x6b\x25\x0b\x42\x2c\xaf\x6f\x72\xfc\x72\x10\x4b\xe6\x3b\x8d\x15\xf8\xa7\x39\x99\x0d\x9f\x33\xa5\x89\x31\x2e\xac\xea\x42\xab\x6e\xae\x58\x31\x59\x99\x5c\x77\x63\x92\x92\x6d\xd5\xca\x1b\xbd\x18\x93\x3a\x83\xe2\x55\x6b\x9c\x5c\x0c\xef\x95\xd7\x4c\x79\x98\xac\xdc\xe5\xc7\xd2\x56\xd6\x52\xcf\xca\xdd\x4c\xeb\x0f\x94\xad\x99\xae\x67\x1e\xe6\xd5\xf4\xe2\xf9\xc1\x78\xea\xf6\x9e\xf2\x25\x71\xc4\x69\xcb\xc2\xa2\xb0\x58\xbd\x48\x99\xee\xb8\x98\xcc\x8f\x85\xa9\x29\x65\x2d\x79\x32\x34\xc7\xf7\xcf\x55\xd9\x6c\x67\xf9\x1b\x21\x5b\xcd\xe4\xb6\x5a\xa6\xb9\x9c\x5f\x59\xa3\x41\x7a\x56\x10\x53\xe6\x53\x75\x3c\x7c\x4a\x95\x44\xd0\xe7\x55\xf6\x59\xb4\x26\xd6\xbc\xfe\x34\x2f\x14\x17\xf3\xe5\xfd\x15\xb7\xd4\x2b\xec\xf6\x65\xf1\x50\x7c\x5c\x3d\x73\xc2\xeb\x3a\x3b\x7e\xb8\xc9\xd7\xea\xb1\x8e\x9c\x4d\x09\xf3\xa9\x9e\x6f\x0f\x4c\xbe\xdf\x52\xb7\xd2\x53\xba\x35\x79\x7e\xbd\x7f\x61\xc7\xbc\x76\xdb\x1b\x2d\x86\x7c\xa6\xb5\xad\x8d\x56\x7c\x63\x32\xdf\x2c\x6b\xdc\xe2\xb9\x90\xbd\xb2\x9e\xf2\xcb\x79\x6a\x6e\x81\xf5\xee\x4a\xb7\x06\xe5\xf6\xd6\x2c\x3c\x0e\x7a\x9d\x64\x8a\x5f\x28\xa9\x61\x2e\x99\xc9\xa6\x4a\x4f\x8f\x8d\x87\x61\x3a\xf6\x54\x7a\x8e\x35\xcc\xfc\xeb\x75\x4f\xe5\xe5\xec\xe2\x7e\x92\x59\x2b\x9d\x7b\xab\x14\xcb\x70\x0f\x8b\xca\x4b\x65\xdb\x7b\xad\xd4\x7a\xe6\xd3\x83\x21\x3c\x8c\xee\x86\xfd\x74\x41\x58\x16\x44\xf1\xa5\x99\x16\x1e\x47\xe9\xd8\xb2\xf3\xa4\x2d\x33\x46\xfa\x5e\x7b\x6d\x3d\xa4\xd8\x42\xb3\x7d\x37\xed\xce\x5b\x43\x2d\xcd\x27\x6f\x1b\x65\xa1\xd9\x4f\xc6\x8c\xde\x7c\x20\x3f\x29\xc2\x50\x2f\xb5\xd8\x42\x29\x5f\xba\x69\xa4\xac\xfa\x55\x2f\x77\xbb\xee\xf7\x46\x33\xa3\xa4\x8c\x07\xa9\x59\x5e\xba\x96\x8c\x5c\x8c\x15\xf4\xbb\x7b\x7e\xc5\xf6\xfb\xc5\x55\xbb\x26\x67\xad\xa2\x1c\xab\x5d\x17\xa6\x33\xf5\xba\xb9\x50\xf5\x64\x6c\xfd\xba\x6a\xf5\x9f\x94\x56\xbf\xfe\xdc\xae\xd5\xd7\x49\xbe\xf6\x38\x52\xb3\x66\x6b\xa4\x1a\x99\x61\x86\x93\x79\x76\x91\x31\x92\x23\x30\xa1\x85\x62\xad\xa5\xbd\xa4\x25\xeb\xba\xae\x15\x57\xb5\x66\xa6\xd8\x19\x76\xb5\x76\x4f\x6a\x4e\xa6\x8d\xe1\xd5\xc3\xb8\x52\x5d\x89\x79\x25\x73\xaf\xac\xe7\x56\xee\xaa\xd1\x5a\x08\x02\xe8\xcb\xb6\x9b\x8f\x2d\x8d\xf4\xa4\xaa\x4d\x47\x95\xc6\x36\x95\x8f\x49\x77\x8a\xf6\xa2\x8e\xc6\xcb\xf6\xf4\x4e\x2f\xdc\x2d\xa4\x3b\xb6\xa7\x0c\x62\x8f\x85\x41\xa7\x78\xd3\xb7\x1a\x8d\x79\x59\x88\x4d\x64\xb5\x05\x48\xc4\xa7\x59\x63\x2a\x94\xe6\xcb\x35\x98\xa1\x85\xd8\x54\x9b\x56\xb8\x4c\xe9\xf9\xa5\x36\xd8\x5e\xaf\x86\xfc\xe3\x55\xbe\xa2\x3d\x0f\xae\x2b\xed\x2d\x9b\x7f\x56\xf3\xd3\xed\x20\x59\x98\xde\x08\x72\xa6\x5a\x2d\x99\xc6\x4d\xaf\x33\xe0\x4b\xb1\xf6\x5d\x7b\x3b\xe0\xf5\x46\x55\x00\x96\xc8\xf3\xb8\xab\xa6\xd7\x2d\xa3\x7f\xdd\xa9\x2b\xa5\x45\xbd\xb0\xa9\xf6\x1f\xba\xd9\x9b\xc5\x6b\x6d\x35\xb4\x36\x43\x76\xb0\x91\x32\x65\xed\x6e\x5c\xbb\x7f\x54\xb6\xe3\x07\x91\xdf\xa4\xe4\xec\x64\xaa\xc9\xb1\x5b\xb5\x6e\xc9\x52\x71\xd5\x9f\xdc\x3e\x55\x4d\xc5\xe0\x2a\xbd\x72\xb3\x3e\x66\xcb\x49\xb5\xa7\x72\x93\xfe\xf4\x6e\x38\x1e\x9b\x0d\x73\x9c\xd1\x73\xfc\xd5\xa6\xf2\x94\x5f\xdc\x0e\x94\xd8\xe8\x66\x5e\xa8\xe8\x2b\xa5\xf2\xbc\xb8\x52\xb3\x7c\xca\x9c\xc4\xae\xd6\x42\xaa\x58\x15\x4a\xcf\xfc\x6b\x32\xf6\x58\xaf\x14\x3b\xd5\x6b\x6b\x39\xbe\x8d\x6d\xda\x7c\x2f\x77\xf7\x58\x2c\x95\x2b\x39\xb9\xf6\xb4\x1e\xf6\xe5\x1b\x7e\xb2\x59\xd4\x33\x5d\xa5\x3b\xba\x16\x66\xe3\x51\xec\x6e\x50\x4e\x0f\xc4\xa4\x34\x69\x3d\x5c\x75\xe4\x97\x66\xcf\x68\x1a\x4f\xb9\x98\xd4\x9e\xde\x6c\x9e\x97\xa9\x47\x6e\x78\x23\x76\xae\xc7\x0f\xea\x93\xa0\xde\xb6\xbb\x99\x6d\xb9\x95\x7f\x95\xcc\xab\xd7\x9a\xfa\xa0\xdf\xb0\xf7\xad\x91\x32\x4e\xd6\xc5\xbe\xbc\xcc\x3d\x57\x4a\x2f\xe5\xd6\xaa\xb2\x6d\xdc\x35\x9a\xeb\x79\x6d\x36\x29\x2b\xf5\x4e\xe1\x21\xd5\x90\x5f\xd6\x52\xbf\xaa\xcd\x2a\xaf\xdd\xf6\xf5\xe4\xfe\xf6\x5e\xb9\x6b\xdd\xb7\x1a\xf2\xfd\xf6\xa5\x6e\xdd\x36\xd3\x66\x99\xcd\x76\xae\xa7\xeb\x54\xbd\x20\x6c\xd8\x9b\x21\x60\xe2\x65\xf3\x85\xaf\x35\x6a\xdd\x89\xda\x9c\x8c\xc6\x35\x6b\x69\x64\x85\x62\xaa\x31\x2a\x77\xcd\xe7\x5c\xae\x09\x4a\x8e\xcd\xbe\x31\xe7\xcb\x99\x76\x35\xd9\x9b\x8c\xaf\x6e\xe5\x4a\xed\xf9\x85\xed\x2e\x5e\x36\x0f\x1b\xf9\x99\xad\x67\x27\xe3\x46\xd1\x62\x7b\xa9\x85\xd0\xd2\xcd\x4a\xf9\xa9\x6a\xc9\xbc\x55\x58\x70\x0f\x15\x75\x35\x6e\x6d\x3b\x8b\x87\xe6\xb4\xd5\x9d\x35\x62\x2f\x93\xb5\x55\xba\x7d\x5c\xdf\x67\x52\x19\x76\x9c\x8a\x8d\xaf\xa5\x6c\x6d\x51\x9f\x8c\x04\x71\x39\xdc\x16\x1f\x5b\xf7\xaf\xc9\xb5\xa4\xe6\x72\xb5\xeb\xc6\xac\x10\x6b\x2d\xe7\xdb\xeb\x74\x6d\x9b\x7d\x35\x8b\x42\xe9\x09\xe0\xc4\xe9\xa5\x8d\x10\xbb\x2b\x17\x57\xb7\xb1\xd2\xd0\x10\x46\xe9\xdc\x42\xd0\xc6\x6c\x61\x3e\x6e\x48\xf7\xad\xae\x54\xea\xa8\xd3\x74\xf5\x56\x9f\x96\x86\xf7\x4d\x7d\x9d\x1b\x59\xcf\x77\x39\x41\x2b\x55\xb4\xb1\xfa\x24\xa5\x4a\xec\xf4\xba\xd6\x57\x92\xf3\x7e\x7f\x98\x7d\x7e\x51\xc4\x5c\x47\xab\x9a\xd3\x54\xf6\x21\xd6\xbc\x57\x17\x83\xd8\xed\xf6\xb6\x24\x4b\xb7\xb3\xf1\x62\xac\x75\x2b\x59\x6d\xdd\x4d\xca\x56\xee\x96\x4f\x16\x62\x7c\x2a\x36\x9a\xa6\xf4\xdb\x4a\x0c\xbc\x14\xd4\xd8\xe4\xb5\xbb\x50\xae\xa4\x81\x9e\xb9\x7b\x62\xd3\x0f\xf3\xe4\x53\xec\x6a\xc6\xb6\xf8\xce\xc8\x4c\x73\xa3\xd9\x5d\x7a\x36\xe7\x26\xcd\x32\x5f\x50\x38\x75\x90\xd2\x2b\xaa\x22\xea\x8f\xea\x43\xbe\x3e\x5a\xdf\x3c\x66\x47\x0f\x4f\xcb\xdb\x36\x27\x97\xd2\x75\x8e\x13\x5a\xd5\x9b\x4d\x45\xbe\x15\x26\x2c\xdb\xbb\x62\x6b\xad\x51\x73\xb5\x1c\xa8\xdb\xeb\x6a\xae\xa3\x56\x1f\x27\xda\x70\xda\x6e\x73\xbd\x2b\x73\xcd\xe7\x6a\x4a\xfa\xf9\x35\xcd\x49\xd2\xe8\x6a\x91\xca\xa5\x2a\x1d\xe1\xb9\x5d\x5a\x81\x25\xa7\x2a\x09\xd3\x4d\xa7\x3f\xbf\x59\xa9\x4d\xb0\xa2\xc7\x8a\xf5\xd6\xf3\x4d\xf7\x31\x95\xd6\x53\x40\x5e\x5c\x73\xb5\xeb\x8c\x50\x6b\xde\xe8\xaf\x9d\xa5\xa6\x95\x5f\xc0\xea\x57\x7e\x2d\xd5\xf5\xbe\xf1\x3a\xba\xae\x5f\x8d\xf8\xee\xe6\xa5\x31\xa8\x0d\x1e\x1e\x5e\x6e\x1f\x17\xd6\x43\xbd\xb0\xa8\xc8\xd2\xa6\x6d\x0a\xaf\x43\x2d\x37\x1d\xe5\x5e\xd2\xfc\x43\xe9\xfe\xbe\x35\xac\x17\x1b\x5c\x6f\xb5\x9d\xa4\xee\x0d\xa5\x34\xef\x6d\xd5\x85\x9a\x7d\x2d\x0f\x4b\xeb\xf1\xd4\xd8\xf4\x06\x0f\x9d\xe2\x7d\xaf\x95\x6f\x73\xa3\x66\x6e\x56\x4d\xcf\xea\xd5\x55\x36\xd5\x60\x33\xcd\xb2\xf9\x5c\xed\x89\x95\xc1\x83\x78\xa5\xaf\x5a\x95\x74\x53\x5f\x56\x1e\xe6\xcd\x9b\x5c\xf3\xa5\xd1\x9f\x77\xe7\x8d\xd8\x4a\xeb\x3d\x19\x8d\x0e\xb7\x19\x48\x1b\xe9\xba\xbb\x4e\xa6\x1f\x0a\xa5\x5b\x69\x0b\xe6\xe6\xbc\xfd\x52\x32\xea\x8b\x8e\x3e\x6b\xd4\x56\xcf\xf7\xca\xa2\x2a\x5a\xb3\xcd\x54\x6d\x5f\x97\x63\xd5\x5e\x41\xac\x8c\x1e\x1b\xcb\x05\xcb\x65\x0b\x37\xcf\x7c\x7f\x9d\xbd\x53\x4a\x7c\x71\x5a\x91\x47\xd9\xc2\xf8\x6e\xb6\x58\x54\x7b\xf2\xa8\xfb\x94\x4c\xf5\x93\x2d\x6e\xb8\x4e\xae\xa6\xf3\xfb\x7c\xb5\x38\xac\x8c\x67\x2d\xae\xbf\x4d\x6d\x5a\xbd\x01\x57\x1b\x2d\xa7\x77\x9d\xf9\x55\xba\xf2\xdc\xb8\x5e\x75\x86\x53\xb3\x52\x78\xec\xf5\x32\xc6\x68\x7a\xc7\x66\x53\xed\xc5\x2a\x26\xf4\x17\x53\xa0\x99\x95\x5e\x3a\x45\xab\x55\x92\x3a\xf5\xd2\xeb\x56\x79\x54\x0a\xc2\xb3\xb4\x5e\x2d\x73\x92\xf1\xb0\xb5\x06\x9b\xd9\x95\x79\xb7\xcc\x2d\xc5\xf6\xf4\xb6\x52\xe9\x5d\xa5\xeb\xf9\xfc\x63\xa9\xd3\xab\xcb\x72\x49\x52\x8b\xe9\x9c\x58\x2d\x8f\x07\x4f\xc9\x66\xb5\xd2\xdd\xea\xc2\xd8\x4c\xdd\x2b\xb9\x41\x63\x75\xd7\xa8\xb3\xad\x07\xb0\x20\x6f\x07\x85\x5e\x45\x6b\x81\x95\x8e\x2b\xcb\x92\xa0\x66\x6f\xc7\x60\x21\x98\x1a\xb7\xa6\xbc\x66\x8d\x31\xdf\xb4\x8c\x7b\x6b\x70\xdd\x52\x2b\x96\xc1\xcb\xc5\xde\xb0\xc6\xdf\x94\x3a\xda\xa0\x67\x89\xd7\x39\x2b\xad\x55\x3a\xd5\xe6\x83\x3c\x69\xb5\x7b\xa5\xa7\x79\x7d\xa0\xbc\xcc\x24\x2e\x63\x3c\x8e\xb9\x56\xeb\x4e\x6f\x25\x63\x0f\x52\xca\x1a\x88\x0b\x69\x69\x75\xf2\x46\x5e\x6c\x25\xa5\x58\xa6\xbb\x9c\xc4\x9e\xd8\x6b\xe5\xa5\xd8\x2e\xdf\x17\xee\x24\xb3\x5e\xa8\x08\xe9\x46\xf7\xb6\x3f\xb3\x5e\x46\x59\xf3\xd6\xa8\x8c\x5e\x5b\x8d\xd2\xb6\x5c\xb9\xe9\xe4\x92\xd5\xbb\x6a\x71\x9d\x6c\xe5\x32\xb1\xab\x86\x24\xdc\x2c\x07\xcb\xbe\x54\x94\x32\xca\xeb\xea\xf5\xb9\x5f\x7f\xc9\xc5\x86\x79\xb5\x03\xc4\x4e\x83\x2d\x0e\x63\x63\x56\xb8\x1b\x0e\x36\xa3\x4d\x47\x9c\xc9\x2f\x3a\xbb\x29\xf2\x6c\x49\xbe\x96\x95\x49\x3d\xa5\x83\x69\xb0\xd4\xcb\x5d\x65\xbb\x6c\xd5\x4b\xeb\xfb\xca\xe0\x79\x21\xde\x37\x2a\x37\xcb\x76\xb2\xf7\xc2\x4f\x87\xc3\xe4\x6c\xfd\xbc\xac\x6c\x57\x19\x65\xb2\x50\xa5\x61\x43\x79\xd6\xeb\xa9\x5c\xa9\xfa\x62\xae\xf5\x45\x49\x49\x5d\x6f\xcc\x46\xa3\xd8\x1f\xdc\xe5\xe5\xb6\xca\x3d\xa9\xb9\x1e\xfb\x5a\xcc\xca\x96\x94\x6f\xcb\x0b\x7d\x58\xcc\x35\xd2\x46\xb7\xa2\xb3\xcf\xaf\xd5\x46\xdd\xea\x64\xef\xef\xd4\xcd\xf4\x61\x6c\x66\x26\x05\x3e\xc5\x3e\x88\x8b\x54\x63\xbb\xe1\x17\xf5\xab\xda\xd6\xea\xb4\x9a\xd9\xd6\xb0\xd3\xea\x0b\xd9\x7a\xe9\x9a\x4d\xa5\xb9\x5b\xad\x13\x9b\xe4\xf5\xb9\xf6\x6c\xdd\x76\x96\x31\x9d\x9f\xb7\x53\x43\x23\x95\xbf\x12\xea\x72\xa1\x78\xd7\xb9\xc9\x54\x2b\xe5\x41\xe3\xf1\x6a\xcd\x66\x8d\xd5\xeb\xcd\x6d\x71\xde\x6a\x6c\x81\x1a\x21\x66\x1a\x99\xc9\xe3\x43\x1f\x00\x98\x3f\xe6\x5a\xe3\x72\x6a\x29\x2c\x62\x9d\x7a\x4c\x29\xf0\xdc\xfd\x68\x55\x1e\x8d\x73\x5d\x6e\xf6\x24\x95\xab\xbd\x7b\x41\xaa\x9b\xd9\xfb\x55\x19\x68\x97\xa3\x9c\xb9\x9a\x88\xe5\x58\x25\x5b\x19\xcd\xe6\x79\xfd\xa9\x7e\x1f\xdb\xb2\x33\x33\x5f\xae\xea\xaa\x55\x1d\x8e\xb5\xcd\x8b\xb8\x9d\x4e\xef\xc7\xc3\x59\xef\xba\x9c\x11\xbb\xad\xd8\x6d\x23\x39\xee\xb0\x75\x71\x50\x5f\xb5\xba\xb9\x6c\xfd\xa5\x32\x9d\x5e\x59\x95\x8c\x54\x7a\xca\x6c\xaa\x66\x79\xf4\xfa\xf8\x68\x4e\xb4\x58\x43\x4b\x8e\x5b\x1b\x4e\xdc\x3c\xc5\x1a\xcb\xa4\x54\x7e\x78\x2e\x4f\xc7\xd7\x23\xf3\x31\xdd\x9b\xa4\x1e\xa0\x59\x50\xee\x3d\x3e\xb5\xbb\x77\xb9\xea\xf3\xcd\xcd\x05\xed\x0b\x47\x51\x11\x95\xc5\x86\x69\x8a\x4c\x99\xa9\x22\x03\x26\x62\x5b\x5d\x76\xd4\x16\x3a\xc2\x40\x1d\xa0\x20\xd1\x29\xfe\xd7\xd0\x57\xe9\xd8\x4a\xd0\x7b\x06\x6d\x4e\x6c\x8a\xe2\xc3\x55\xd8\xd0\x71\x4e\xcf\xe8\x82\x98\x98\xce\x17\xa2\xb1\x41\x26\x13\xfe\x19\xcf\xc0\x93\x40\x09\x53\x91\x55\x74\x58\x66\xba\xf3\xac\xcc\xbc\x28\xb3\xc3\x58\x29\x9f\xab\x6d\xdb\x49\xa3\x5f\xe0\x46\x77\xd9\xd4\x6d\xcf\x7a\xb8\x29\xcf\x9f\xc6\xdd\xa7\xed\x6c\xb4\xd5\x73\xa6\x3a\xbc\x9b\x65\x9f\xa5\xee\xf2\x3a\x56\xe4\x46\x56\xbf\x9e\xea\xc8\xf9\xa9\xbc\xd5\x31\xdc\x5d\xe7\x65\x80\x35\x89\x70\xbe\xdc\x89\xbe\xa0\x4d\xcd\x04\xaf\xe8\x0b\x41\x52\x38\x03\x9b\x7d\xdc\x94\x5b\xb3\x8a\x3c\x82\x5b\xa1\xb3\x99\x68\x00\xf4\xd9\x54\x22\x05\x8f\x00\x2d\x54\xc1\x7e\xb9\xbf\x5f\x8f\xed\xb4\xd8\x4f\x56\x67\xd7\x73\xa1\x77\xfb\x90\x9f\xdc\x5a\x9b\xdc\xdd\xd3\x6c\x62\x75\x26\xdb\xc1\xb4\x34\x68\xa7\x78\xe5\xba\xdf\x6c\x70\x99\xdb\xda\xcb\xca\xd0\x1e\xe6\x59\xf3\xaa\x98\x17\x6e\xae\x5b\xb5\x6d\x72\x90\xfa\xce\x7e\xbd\xe3\xb8\xd6\xd4\x7f\x5a\x6b\x77\xa7\x6e\xa7\x3d\xf5\x69\xbc\x11\x92\xb3\xcc\x6c\x58\x49\x19\x5d\x79\xf4\xf2\x58\x7e\xd6\x6f\x6e\x36\xf9\xb6\xf1\x90\x7f\x32\xa6\x37\x75\xee\x4a\x62\xb5\xdb\xc6\xf6\x66\x7d\x55\x03\xc6\xc7\x3a\xb9\xbe\x69\xc6\x2a\x40\x89\xec\x36\xbf\x7f\xb0\x82\x27\xb5\xd0\x79\x1f\x93\xd7\x0d\xf1\x5f\xa9\x44\x09\xf4\xc7\x7d\x11\xdf\xdf\x9b\x1c\x50\x79\x8d\x52\x2f\xcb\x8d\xe7\xbd\xcc\xe0\x6e\xd9\x31\x26\x57\x77\xb7\xdc\x78\xf6\xbc\xb9\x6e\x57\x4c\x29\xc3\xd6\xd6\x8b\xda\x5d\xbb\xbb\x99\x57\x97\x69\xf3\x59\x34\x4a\x3c\x5b\x5f\x0b\x93\x4e\xfb\xbe\x58\x6d\x4c\xde\xd1\x9b\x5f\xe3\x71\xa6\x26\x2e\x45\x45\x9f\xa9\xa2\x66\x31\x4b\xec\x3b\x81\xfe\xaa\xa7\x05\x71\x99\x4c\x44\x65\x26\xc1\x08\x1b\x1c\x49\xce\x28\xfa\x18\xc0\x1c\xbf\x8b\x18\xcb\x85\xf8\xaf\x74\x22\x9f\x48\x25\xc9\x61\xb5\x85\xb8\x87\x00\x25\x20\xa1\xb7\x23\x76\x62\x14\xc5\x54\xb6\x71\x7f\x2d\xe6\xfa\xf5\xb6\xd1\x97\xaf\x33\x0f\xd6\x2a\x57\x1b\xa6\x5f\x56\xa5\x21\x3b\x2e\xf0\xf3\x69\x31\x35\x48\x37\xf9\x7a\x73\x9d\xab\xde\xb5\xcd\xed\x5a\x18\x15\xa7\xe3\x23\x09\xc0\xc4\xe3\x97\xdf\xdd\x8b\xfd\x43\x59\xb4\x62\x1c\xd0\x3b\x1e\x9f\x34\x2d\xd7\xeb\x74\x1a\x6c\x6b\x24\xbe\x54\xaf\xf3\xfd\xc1\xcd\x12\x28\xef\x2a\x3b\xae\x8d\x16\x56\x77\x69\xd5\xc5\xba\xb2\x5d\xaf\x07\xdc\x4b\x2b\xd6\x60\x5f\x6e\xea\xc2\x0d\x2b\xc5\x36\x3f\x6e\x28\xbb\xc8\x93\xf7\x43\x47\x34\x8e\xbd\x83\xff\xca\x24\x92\x89\xbc\x43\x11\xf2\x76\x0f\x51\xfa\xdd\x4a\x7d\xd9\x7a\xee\x4a\xda\x6a\x2a\xac\x36\xec\xe4\xf1\xa9\x2e\x0f\x1e\xda\xca\x28\x29\x74\x5a\x1b\x39\x56\x4d\xb2\xed\xc5\x4b\xfb\x79\x7b\xdf\x59\x96\x3a\x85\x66\xda\x7a\x49\x4f\xe7\x77\x62\x7b\x18\x7b\x9d\xf5\x32\x3f\x71\x78\xf7\x77\x69\xff\x58\x8b\xad\x5e\x63\xf9\x5c\x1e\xe9\x8f\xac\x29\xb5\xb3\x42\x63\x99\x9a\x17\xab\xb9\xa2\x6a\xb4\x6e\xcd\x52\x66\x51\xd1\x37\x1a\xfb\xf4\x90\xeb\x15\x63\x77\x15\x76\x38\x57\x65\x9d\xaf\xd7\xca\xaf\x63\x81\xab\x36\xda\xcd\xfe\xcf\x10\x42\x87\x8f\x8b\xee\xee\x8f\xce\xbd\xde\x5d\x0d\x07\xd6\x62\x3a\xba\x1d\x16\x56\x8d\x97\xeb\xf4\x4d\x66\x9b\x6a\x0e\xe7\xc5\x57\x3e\xd9\x9d\x4b\x4d\x6d\x73\x55\x79\xe6\xad\x4a\xa5\xc9\xa6\x1a\x39\xa3\xf4\x32\xbb\x6f\x14\x44\x53\xcc\x4b\x7d\x61\x91\x3d\xb6\x3f\x54\x87\xa8\xc3\xa3\xeb\xb8\x25\xaa\x33\x85\xb3\x44\x37\xc2\xae\x4a\x0e\xf3\xf4\xed\x2f\xce\x66\x1a\xe5\x59\xc6\x01\xcb\x4e\xdc\x59\x9c\x57\x16\x26\xda\xee\xb0\x0f\x36\x82\xc5\x5f\x00\x40\xcf\x21\xd4\xa8\xfd\xf6\xaf\x28\x13\x03\xed\x90\xfd\x7d\x14\xbf\xbc\xe4\x94\xe0\x3e\xfd\x67\xdd\x09\x35\x0c\x39\x5a\xe4\x0d\x3c\x50\x64\xe6\xdc\x13\x8c\x19\xfd\x2d\xd0\xdc\x12\x06\x22\x5d\x44\x4e\x20\xd6\x0d\xf0\x6d\x06\x8f\x97\x0b\xe2\xfa\x14\xfc\x41\x9b\xa8\xe6\x8d\x86\xde\x9b\x11\x02\x0c\xa1\x1f\xb7\xf4\x8b\x08\x2a\x08\x5e\x13\x7c\xbe\x31\x51\x8e\x87\xbb\x39\xd1\x73\x0c\x83\xb9\xb8\xb8\x60\x92\xcc\x1b\x24\xb6\x27\x74\x82\xd5\x15\xea\x89\x8e\xbc\x74\xbb\xa4\x39\x0e\xfd\x7d\xc5\xd0\x26\xf8\xbb\xfa\x70\x18\x59\xef\x66\xb4\x7b\x04\x94\x34\x83\xb6\x62\x08\x60\x04\x15\x22\x30\x02\x30\xce\xe1\x1b\xfc\xdd\x79\xf5\x2a\x92\xc8\xc6\xc4\x62\x01\xc8\x0d\xd5\x47\x1b\x5e\xc8\x1e\x74\x68\xd4\x48\xe8\x79\x41\xd0\x11\xec\xa6\x0f\x19\xd2\x90\x78\x11\x34\x66\x00\x11\x58\x73\xcf\x66\xfb\xee\xa3\x89\x64\x2b\x19\x1f\xe3\x24\x21\x25\x97\xc1\xbd\x74\x1f\x3c\xd3\x88\xeb\x9a\xb2\x89\x5c\x76\xc8\xb6\x7c\xd8\xee\x3b\x77\x79\x5c\xb7\xe1\xfe\xfe\xc7\xba\x8d\x6a\xbe\xa7\xdb\xce\xd1\xc4\xef\xec\x76\x0b\xc0\x39\xd0\x65\x7f\xf4\xc1\xc4\x60\xd8\xc0\xa6\xfc\xfb\x24\x55\x07\x4b\x2a\xc1\x27\xa5\x7c\x13\x48\x60\x1c\x4e\xb4\x67\xb6\x7d\x12\xc7\xe6\x58\x43\xf1\xcc\x17\xfa\xd4\x48\x14\x1e\xb3\x85\xf1\x21\x09\xf2\xe2\x8b\x5d\xe5\x2b\x98\x42\x80\xfb\xe1\xc9\x10\x3b\x0a\x08\x1d\x13\x21\x71\x36\xff\xe7\xff\x30\xbf\x92\xb7\x98\xaa\x6e\xc5\x50\x69\x4a\x1f\x4e\x41\x3b\x6e\x60\x0c\x34\x1e\xf5\xf5\x1c\x05\x40\x50\xc8\xba\x64\xfc\xfd\x1b\x63\xbf\x65\xde\x7e\x09\xa1\x74\x50\x60\x87\x9c\x70\x86\xfd\xd0\xb5\x73\xb8\x5e\xa0\x1d\xcf\x8b\x08\x3c\x34\xdc\x73\x4a\x7a\xbe\x2f\x60\x56\x0f\x6d\x77\x01\x15\x40\x80\xfb\xa9\xf2\x58\x7b\x01\x85\x60\x84\x66\x15\x9d\x57\xf1\x04\x8c\xa8\x63\x50\x45\x96\x48\xa7\x26\x9c\x49\x03\x3b\x47\xeb\x2d\x0a\xd4\x7d\xec\xde\x23\x71\x97\x70\xf1\xee\x00\xa3\xe6\x34\xe2\xa1\x1b\x04\xe7\xeb\x1d\x80\x82\x8c\x62\x77\x84\x11\x8a\xbc\x22\xf3\xaf\x17\x11\x7d\x26\x6a\x3d\xef\x09\x9c\x88\xcd\x8f\x14\x82\x70\xff\xf9\x43\xdb\x7a\x22\x7c\xac\x9b\x95\x72\x13\x6e\xeb\xcd\x92\xd7\xa9\x19\xda\xd6\x4b\x55\x9a\x4f\xf5\xa1\x9c\x8d\x3d\x66\x3b\x8f\x8d\xcc\x62\xb4\x69\xbd\xde\x76\x9a\x5b\xab\x2a\xcf\xee\x84\x8c\x98\xc9\xb5\x1e\x9f\x9e\xe4\x17\x75\x9e\x29\x0e\xef\xe6\xb0\x4e\x75\x58\xb9\x19\x0c\x21\x9c\x42\x1d\xfc\xd3\x5e\x97\x1b\x4f\x77\xab\xec\x08\xfc\xbe\x1a\x25\x95\xfa\xc3\x53\x37\xab\xb5\x33\xcf\xfd\x27\x69\xd4\x9d\xf4\xae\x8b\x7c\x7d\xb9\xaa\xdc\xf4\x6b\xd5\xd5\x15\x27\xdc\x2c\xf8\xc1\x44\x56\xb4\x5b\x5d\xdd\x14\x2c\x6d\xde\x7f\xc9\xce\x9f\xaf\xee\x57\x75\xa9\x3e\x1b\x3d\xb4\xda\xd5\x4e\x66\xb8\x5c\x6e\xeb\xe3\xed\x6a\x70\x55\xd1\xaa\xb9\xbc\x66\x15\x73\x66\x2f\x33\xdb\x9a\xa6\x34\x1d\x3c\xe4\xb6\xe3\x7a\xf9\xfb\xfe\x57\xcb\x2e\x33\x0a\x9f\x57\x17\x85\xd7\x5b\x69\x50\x28\x4a\x9d\x3c\x9b\xee\x0b\x79\x36\xb5\x94\x86\x72\xce\x50\x1f\x3b\xad\x1c\x5b\xcc\x59\x83\xd6\x72\xf4\xa4\x2d\x72\x0f\x9c\xb4\x68\x18\x99\xb5\xbc\x7d\x28\x09\xc9\x45\x63\x92\x12\xb3\x9d\xe7\x52\x69\x39\x97\x1b\x4a\xee\x55\x1a\x15\x9b\xe2\xeb\x88\x6b\xcf\xab\xda\x63\x5a\xa8\x4d\xf4\xb9\xfc\x5a\xec\xb7\x4b\x37\xc3\x94\xf4\x6a\xf5\x9f\x62\xcb\x6d\x2c\x56\xbd\x5f\x0c\xad\x52\x56\xd0\x3a\xaa\x70\x9f\xcc\xe7\x1f\xa7\xdc\x48\x1b\x64\x6e\x87\xb7\xc6\xa8\x99\xb9\x52\xda\xc9\x3e\x37\x9c\x19\xd2\x68\x6a\x0c\x2d\xf6\x79\xaa\x64\xfa\xd9\x7c\x7a\x9d\x96\x06\xaa\x25\x35\xb9\xf6\x8b\x92\x49\xa9\xc5\x64\x4a\xea\xa6\xcd\x74\xf1\xe5\xd9\x7a\x8d\x19\x73\xe9\x35\xdf\xc8\xcc\xb7\xd3\x4a\x52\x7b\xcc\x4c\xc6\x60\x10\xb3\xd9\x27\x49\x7b\x1a\x66\x5f\x06\xe6\xcb\x7c\x7d\x9b\x64\x63\x42\xbd\x7d\x9f\xeb\xe4\x4a\xb5\xd2\x72\x99\x5f\x49\xda\x9c\xab\x24\x57\xb9\xe1\xeb\xb4\xd3\x93\xe6\x6c\x21\x3d\x59\xa4\xcd\x81\x71\x9d\x59\x17\x3a\x55\x71\x6b\x18\xcd\xa6\x94\x9a\x75\xca\x02\xff\x54\x2b\xd5\xd9\xea\xa4\x95\x6a\x76\xb6\x0f\x62\x4c\xc8\x4c\xb6\xc3\xa4\xfe\x90\x53\x63\xcb\xda\x3c\xdf\x28\x4c\xe6\xcb\x42\x6f\x78\x6d\xd5\xca\xdc\xb3\x30\xcb\xb6\x9e\x34\x8e\x7d\x7c\x18\x27\x6f\xa5\x4e\xac\xf0\xdc\x9d\x64\xb3\xa9\x2b\xf5\xda\xca\x9a\xf7\x6c\xc3\xe8\xf4\x0b\xd3\x19\x1b\xbb\x2b\x25\xe7\x5c\xee\x7a\x6a\x48\x72\x63\x90\xb6\xfa\xcf\x1a\xdf\xd8\xb0\x8f\xf9\x87\xeb\xae\x5c\x58\x36\xcb\xc9\xe2\x5d\x3b\x53\x55\x85\xbe\x62\x3c\x27\x9f\x16\x99\xfe\x76\x75\x77\xdd\xbe\xd3\x46\x77\x93\x87\x41\x7a\xd6\x7b\xec\xd7\x94\xce\x66\x94\x4f\x3e\x0c\x9a\xa5\x62\x87\x63\xd3\xcb\x66\x75\xcd\x72\x95\x9b\x5a\x76\xcd\x67\xd4\x3a\x17\x6b\x56\x34\xe5\x61\x2d\x73\x13\x75\xa1\xcc\xd9\x64\xe7\xa1\xc8\xe7\xe7\xeb\x5a\x7e\x98\xea\x8e\x85\x74\xab\x57\x2c\x3d\xe4\xab\x59\x33\x3f\xaa\x6d\x97\x26\xa8\xfb\x92\x54\xb4\xe1\xe0\xb9\x62\x14\x56\x83\x41\x7a\x08\xba\x68\xac\xb2\xcf\xd6\x64\xbb\x5e\xcd\x3b\x2d\x4d\xbc\xbe\xba\x4f\xcb\xcf\x6a\x3d\x56\xc8\x15\x1e\xb9\x7c\xbd\xdd\x69\x37\x6f\xe7\xfc\x64\xaa\x56\x1e\xd8\x45\x36\x36\x5f\x96\x07\xcf\xc2\xed\x73\x4b\x99\x0c\x8a\x0b\x2d\x25\xae\x14\xf5\x36\x33\xbb\xbf\xae\x9a\xe6\x2a\xb7\xbc\x9a\x4c\x9e\x2b\xb9\xe7\xdb\x58\xd2\x9c\xdf\x2f\x5e\x9e\x58\x36\x99\x9c\xf3\x0b\x5e\x1b\x35\x73\xe3\xc7\x56\x41\xd8\x82\x6e\xa7\x79\xe1\x56\xbf\x9e\x6a\xc5\x54\xdb\xb0\x8a\x6c\x95\x4f\x6f\x56\xf7\xd7\xed\x82\x75\x7b\x5d\x5d\x6d\x79\xd5\x9a\xd7\x47\x80\x32\x86\xc6\x1a\xfd\x47\x73\x38\x32\x1e\xd6\xeb\x79\xc3\x2c\xc6\x46\xaa\xf9\x52\xd1\x3b\xc3\x0c\x7b\x97\xd6\x96\xaa\xb2\x4c\xd7\x1a\xf5\xeb\xe9\xbc\x24\x00\x5a\xf4\x06\xed\x5c\x87\x9d\x6f\x8d\x9e\xf4\x38\x2c\xbe\x0e\xb3\xaf\xe5\x41\x5b\x18\x65\xa6\x1b\xe9\x51\xba\x1f\xbf\xf2\x33\xb6\xf6\xb0\x6a\xe4\x1e\xb7\x63\x8d\xcf\x2f\x16\x43\x49\xd8\xcc\x9a\x83\x7c\xa6\xba\x56\xac\xb9\x5e\xcc\x15\xe7\x8d\x65\xa1\x18\xeb\x95\x96\x37\xd7\x6d\x69\xd9\x9f\x3c\x74\x0a\xa5\x55\x7f\xc0\xb5\x9a\x2b\xeb\xaa\xd8\x50\x4d\xf3\xce\x04\x34\xec\x4f\xe7\x7c\xbe\xd6\xea\x5c\xf5\x27\xed\x2c\xdf\xa8\xe4\x46\x4b\x76\xa4\x56\x5e\xba\x7a\x31\x56\x65\x37\x1d\x95\xed\x8c\x1f\x47\xc3\xa1\xfc\xc4\x2e\x6f\x1f\x97\xf9\x5e\xb6\xae\x99\xd2\x60\x6c\x5e\xb7\x0c\x19\xa0\xaa\x41\xbc\xa4\xf9\x92\x1f\xa9\x59\x63\x33\x28\x6c\xd4\x7e\x95\x97\x9e\x06\xe3\xa7\xd4\x52\xad\xb2\x33\xf5\xc5\x94\xd2\xf7\x62\x66\x31\xec\xf5\x57\x80\xa7\x7a\x83\x9a\x70\x3d\xe9\xb7\x59\xa5\xdc\x12\x0b\xdd\xe7\x86\xfe\x72\xdf\x79\x30\xf9\x7c\x7e\x5d\x6b\x0c\x2a\x6b\x30\xce\xb7\x25\x4d\x92\xad\x58\x33\x63\xde\x77\x46\xf9\xba\xc2\xb5\x26\xd3\x76\x2d\xb6\x1d\xa9\xb9\xe6\x2b\xdf\x7a\x99\x5c\x8f\xc0\x2a\x16\xab\x3c\xe7\x4b\x0b\x6d\x64\x69\xdc\x54\xea\xc9\x4a\x53\x02\x64\xaf\x3c\xe5\x0a\xc5\x6e\x6b\xfd\xfc\x22\x36\x9e\x3a\xb7\xd3\xd5\x5d\x36\xbf\x7e\x9a\xa4\x7b\x73\x5e\xd3\x06\x2f\xc2\xf0\x4e\xde\x2e\x36\x25\xf5\xe5\x21\x75\xd3\xd8\xd6\x16\xcb\xf2\x7c\xcd\x2a\xd5\xe9\xfa\xb9\xc8\x26\x97\x57\xa3\x99\x71\x35\x2f\xe4\x21\x9c\xd4\xaa\xb4\x1d\x0c\x6a\xe3\x92\xfe\x1c\xbb\x93\xb4\xc2\x70\x39\xee\x3e\x17\x66\xeb\xd9\x86\xed\xf3\xdb\x47\x80\x1b\xf8\x6f\x2a\x1b\xb0\x4f\x82\x58\xad\xbc\xa8\xdb\x97\xb6\x51\x5a\x8f\x92\xcd\xe7\x5c\x71\x09\xfa\x3a\x14\x5a\xab\xa9\xf9\x32\xbd\x9f\xbc\xde\xf7\xee\xf2\xb5\xfe\x8a\x9b\xbd\x2c\x4b\xfa\xb0\x9c\xb2\xf2\xaf\xe3\x51\xb3\x9d\x2f\xd6\x62\xb1\xe6\x6a\x98\x11\x1e\x6e\xad\xeb\x75\xf1\x25\x5b\x7b\x69\xa5\xb4\xde\x68\x59\x2d\x65\x6a\x6c\x31\x23\xce\xd3\x1d\xb9\xdb\xa9\xcc\x53\xd7\xdc\xcb\xab\x59\xec\xa8\x15\x6b\x94\x79\xe9\xbd\xbc\x24\x53\x6a\x5d\x88\xdd\x27\xef\x87\xbc\x2a\xe5\x32\xc3\x54\xba\xd4\x67\x87\xf5\x55\xed\x29\x33\x1c\xe8\xd2\x2a\x77\x35\x51\xb3\x31\xf1\xfa\x66\x64\x1a\x6d\x36\xaf\x3f\x4d\x1e\x72\x9b\x86\x36\x6a\x34\x67\x5a\x8a\x6d\xd6\xb8\xe5\xe4\xba\x97\xea\x17\x3b\xc9\x55\xde\x58\xb5\x1b\xea\xa2\xd1\xbf\xee\x28\xca\x72\x5c\xbc\x4d\x0b\x23\x20\x43\x5e\x52\x40\x1b\x6a\x5e\xb1\xda\xe4\x21\x36\x2b\x8e\xb6\x7c\xa6\xca\x4a\xdb\x4a\x2d\x96\x4f\x0f\x8b\x8b\x0c\x37\xbf\x66\x97\x4f\xd5\xac\x02\xd8\x62\x5b\xec\x6c\x87\xbd\xfa\x75\x6c\x39\x8f\xa9\x85\xae\x14\x53\x1e\xd4\x65\xa9\x99\xe2\x5b\xb3\x09\xe0\xab\x66\x2a\x93\x15\x5a\xa3\x51\x3a\x2f\x6b\x7a\x29\x9f\x6d\x58\xe3\x46\xac\x17\x9b\xbd\xce\xaa\xd2\xb4\xb8\x9d\xc8\x83\x47\x76\xc2\xad\xee\x3a\xb7\xf7\x95\x42\x7a\xa1\x65\x67\xc9\xb6\xd6\x4f\xa6\x85\xe9\x34\xa7\x2f\xae\x8a\x79\x8d\x2f\x48\x45\xbe\xd0\x15\xf8\x74\xfb\x55\xb3\xb4\xed\x36\xfb\x5a\x78\x5a\x96\xfa\xaa\x58\xe8\x97\xdb\xda\xf5\x13\x57\x59\xad\x24\x96\x5d\xa7\xb4\xd9\x28\xd7\x66\xbb\x57\x2f\xcb\xae\xf1\x1c\x5b\x24\x81\x38\xba\xef\xcd\xfa\xdb\xda\x64\xd2\xb8\x2e\x75\x7b\xb1\xa1\x0a\x24\x53\x2d\x3b\x14\x32\x92\x58\x88\x0d\x17\x52\x37\x59\xfd\xce\x35\xa9\xd8\x62\xb3\x57\x99\x4c\x51\xde\x0a\x8d\xf5\x60\x50\x0c\xba\xd7\x0f\x69\x18\xf8\x59\xd3\x3d\x4a\x07\x7b\x79\x48\x0b\x43\xe0\xe0\x11\x4c\x5a\x1f\x9a\xe4\x3c\x9f\xf1\xa1\x27\x5a\x43\x82\xff\xf4\xf1\x51\x28\x5b\xe7\x73\x5e\x31\x6f\x9f\xd9\x49\xee\x08\x68\x50\x9d\xb9\xfc\x2c\xaa\x97\x2d\x1d\x07\xd5\x7e\x66\xc1\x83\xbf\x72\xde\x53\xd9\x5c\x8c\x50\x51\x46\x1d\xc5\xd3\x74\x48\xb2\x4f\x49\xc5\xb8\x1a\x22\xf4\xbd\x8a\x02\xc2\xab\x26\x4b\x92\x68\x98\x27\xa7\x3e\x15\xd6\x53\x08\x86\xdb\xe1\x47\x86\x33\xcf\x1d\x85\xd6\x53\x06\x75\x30\x4f\xe1\x38\xf3\xf6\xcf\x6f\xf6\x60\x23\x05\x63\xb4\x4b\x7b\x77\x43\x79\x51\xca\x11\xf4\x6f\x7c\x26\x2b\x0a\xf9\x49\x42\x3c\x23\x97\x57\xf7\xe5\x46\xa3\x5e\x23\xe6\x4d\x08\xe8\x80\x7a\x7f\x00\x32\x3e\x43\x7b\x7d\x53\xab\xd5\x5b\x21\x50\x11\x1c\xfb\x3c\x8f\x6b\x97\x44\x03\xd0\xa0\x3d\x88\x1e\xd1\x11\xbb\x2b\xdd\xb0\x8f\xfa\x00\x82\x3b\x4c\x62\x03\x4a\x58\xfa\x23\xdc\xb4\xa8\x82\xe7\x93\x53\x48\xd0\xf0\x86\x51\x6b\xcc\x3f\xff\xc9\x50\x4f\xbf\x5e\x5c\x30\x51\x92\x8b\x2e\x7a\xa8\x77\x28\x2c\xda\x6d\x1f\x43\xd8\xd9\x9c\x64\x70\xaa\xd8\x96\x8e\x03\xea\x70\x51\xf4\x0a\x56\x83\x0e\x57\x48\x03\x0f\xa0\xcb\xab\x6e\xb9\x59\xdf\xd5\x9c\xcd\x55\x75\x30\x11\x56\x13\xf0\xeb\x50\xc3\xb2\x26\xe9\x98\xd3\xd1\xd9\x6a\x0a\x85\xea\xc4\xd0\x01\x0e\x10\xa0\xc0\x2c\x66\x30\x9a\xda\x41\xc6\x6e\xe6\x11\xda\x6a\xdd\x7a\xab\x56\xef\xd6\x6b\x4c\xfd\xbe\x57\x1f\x5c\x83\x9f\x1e\xec\x76\x8f\xaf\xdb\x2c\xfe\x09\x0f\xa5\x07\x07\x1d\xc6\x6f\x2f\x4c\x7a\xc8\x4d\xf4\xc6\xa5\x39\x67\x3b\x74\x2c\x6e\x6c\xfb\x73\x12\xe0\xb7\xe9\x38\x19\xc0\x43\x02\x9f\x10\xf2\x45\x38\xee\xa4\x8e\x87\x24\x9e\x1e\xc4\x21\x86\x10\x20\x34\xdc\x11\x52\xe8\x01\x9e\x67\x78\xf3\x39\x04\x66\xc7\xc9\x4a\x4f\xd0\xab\x37\x90\xd7\x45\xd0\xd2\x18\xf0\x1f\xcc\x7f\x85\x8e\xc1\xcd\x0c\x60\xab\x19\x1b\xf4\xce\x54\x19\x04\x07\xf7\xd0\x6f\x05\xd6\xf0\x91\x7a\x6c\x02\x5e\x3e\xc1\xc0\x5f\xf2\x0a\x62\x4b\xf9\x69\xfc\x4d\x98\x22\x98\x12\x42\x58\x23\x8c\xa4\xe8\x9c\x85\xb3\x92\x38\x34\x76\xed\x50\x7f\x14\xe9\x93\x6c\xca\x16\x3a\x97\x43\xd1\x87\x22\xc9\x87\xfd\x23\xb0\xc9\x6b\x9c\x1f\xa8\x0f\x33\x22\xf8\xfd\x24\x38\x4d\x82\x1d\xe5\x8b\xd3\x6f\xc0\x7f\xe3\x26\x90\x6c\x33\x28\xe2\xd1\xd3\x04\x05\x41\x93\x2f\x2a\x13\x4c\x3b\xe4\xfa\x33\x2c\xf8\xde\x81\x08\x1f\x6c\x79\xe0\x0e\x9e\x65\x78\x44\xb5\x35\x61\x4c\x5e\x9f\xe1\xe0\x60\x20\x16\x11\xe0\xcf\xac\x35\xd9\x57\xea\x09\xc6\xfc\x7b\x0b\x81\x27\xc3\x25\x9e\x65\xa7\x23\xc5\xb5\xed\x8c\x07\x0e\x0a\xf6\x94\x20\x0e\x17\x30\x2b\x48\x8f\x5c\x76\xe6\xc9\x04\xc3\x18\x9d\xe0\xef\xa7\xde\x75\xc6\x72\x3a\x4b\xd2\x2e\xc1\xfc\x9d\x88\xe9\xf1\x73\x02\x3e\x43\xbe\xb7\x84\xfd\xf5\xd0\x21\x06\xba\x22\x3e\x03\xe1\xab\xe9\xeb\xa3\xdb\x2b\xf0\x00\x07\xe2\xa3\x4c\xd2\x15\x05\xd9\x10\x79\xab\x3a\xe1\x64\x6d\x8f\x37\x0d\x0d\xbd\x41\x0a\xc3\xe3\xad\xb2\xe6\xf5\x65\xd9\x0e\xea\x89\xee\x71\x4d\x83\x47\xd3\xab\xed\x5c\x7a\xfc\x88\x7b\x84\x2f\xa6\x89\x3e\xf3\x4b\x35\xe6\x33\x8c\x3b\xb0\x3f\x22\xf7\xd7\x67\x14\x8a\x80\xa6\x2c\x99\x73\x64\x4c\xa1\x0f\x09\x94\x3a\xdd\x29\xde\xc8\xe9\x2a\x83\x5b\xe1\xc8\x07\x8f\x3e\x14\x92\x66\x8b\xf8\xbc\xc9\x4b\xd0\x0e\x40\xc1\xe6\x1f\xdb\xf3\xed\xa9\xf1\xa3\x67\x75\xb9\x73\x53\xd3\xf9\x05\xdc\x7e\x34\xfd\xe3\xe5\xa6\x68\x50\x64\xd3\x8a\x2f\x34\x14\x05\x42\xbc\xa0\xdc\x4c\x8e\x0b\x76\x4d\x77\xec\x14\xd9\x1e\x3a\xf0\x11\x8e\x58\xb0\x8c\xcf\xf5\x7b\x68\xc8\x00\x80\x84\x39\x13\x79\x67\xc0\x68\xe9\x4d\x86\x07\x96\x09\x93\x88\x38\x5f\xab\xa6\x43\xf1\x0c\x26\xa7\xa6\x83\xd2\xa2\x61\xa0\x53\x74\xf6\xa8\x93\xba\xce\xa8\x7b\x97\x16\x6a\xdd\x87\x05\x2d\x47\x71\x76\x9e\x40\x45\x5f\x21\xb2\x8d\x1b\xb9\x64\x48\x39\x7b\x5f\xd7\x59\x48\x83\x1d\x71\x6b\xa3\xb4\x7a\x40\x6d\xfa\x55\x36\x7b\x16\x40\x58\x38\xb1\xdf\x9e\x3a\x4b\x80\xe3\xd0\xa4\x3e\x1d\xc5\x91\x54\xc7\xe0\xfb\xe0\xc1\x0c\x46\xc0\xd9\x52\x50\x1f\x11\x78\x7d\x46\x72\xd0\x99\xd0\x13\xfd\xe5\xeb\x69\x62\xaa\xcb\xda\x49\xf4\x8c\x89\x9e\xc2\x37\x51\x60\x02\x50\x65\x20\xab\x88\x42\x14\xf5\x15\x36\xe1\x32\xac\xbd\x9f\x65\x1f\x11\xfc\x08\xbb\xa2\x03\xff\xef\xe2\x53\x92\x34\x20\xc8\x9f\xe8\x70\x22\x60\x50\x6f\x01\xc6\x15\x07\xf0\x43\x42\x15\xad\x89\x2e\x30\x6f\x8c\xfd\x02\x6e\x81\xe9\xc8\x29\x1f\x3d\x31\xa1\x4c\x86\xad\x9c\x46\x1d\xf6\x79\x17\x93\xdb\xa6\x01\x19\x7e\xd4\xc0\x84\x33\x3b\xa0\x0e\xcc\xbd\x13\xb9\x9c\x91\x5f\x01\x8e\xf9\x38\x70\x78\x3c\x15\xa7\x38\x88\x5c\xc2\x03\xac\x0c\x4e\x81\xf0\x91\x16\xd0\x1c\xf5\x81\xaf\x9a\x86\xd4\xd7\x5f\x61\x2e\xef\x6a\xaf\x7b\xc5\x58\xf0\x77\x10\x78\x38\xf7\x61\xae\x43\xa0\xd0\x41\x50\x87\xe5\x54\x6e\x76\x82\x8f\x86\x5e\x5c\x32\xf8\x17\x5e\x11\xe1\x38\xfc\x01\x18\x31\xc6\x44\xcf\xd1\xb6\x16\xfa\x04\xb9\xc8\xc3\xa7\x3f\x87\x1b\x5b\xf0\xc8\xe8\xbb\xb8\x11\x1f\x32\x0d\xe1\x46\xf8\x01\x72\x23\x29\x70\x48\xa3\x77\x15\x64\x53\x5c\xc2\x74\xc6\x9b\x3e\x40\xf5\x04\xd6\xee\x91\x17\xe8\xe1\x14\x6b\xf3\xc1\xf7\xee\xf2\x47\x3e\x3b\x8a\xf5\xf7\x12\x06\x67\x31\x81\x4a\xe8\x9e\xf5\xdf\xd0\x57\x4c\x68\x9e\xc8\xc8\x8e\x5d\x6e\x5d\x89\x67\xbd\x1a\x13\xbd\xcb\xec\xdf\x4b\x0e\xdf\x34\xf6\x6f\x1c\xfa\xe0\x17\x43\xe0\xef\x5f\xad\xf1\x8e\xd3\x31\xcb\xf5\x8f\x5b\xb0\xcd\xca\xc6\xcd\xd0\xb3\x83\xca\x0e\x7f\x4d\xd2\xce\xa1\x70\x9c\x35\x39\x9e\xc5\x06\x17\xce\xad\xe8\x3b\x73\x3c\x1b\xc5\x33\x91\x4b\x74\x44\x13\x9e\x99\xa3\x13\x01\x4d\xd2\x3e\xed\x0c\x4e\x79\x12\xa6\x71\x83\x62\x01\xe2\x4c\x8a\xf9\x8c\x98\xdc\xad\x57\xc5\x05\xcc\x84\x22\x6a\x63\x6b\xe2\x84\x1d\x78\x2a\xca\x50\xca\xe0\x72\x7d\x1d\xe6\x17\x88\xf8\x55\x26\x27\x0c\x84\xd0\xdf\x26\x45\xb0\xa1\x2f\x7e\x94\xbe\xe2\x20\x02\x9a\x45\xcc\x77\x54\x46\xe5\xe9\xe8\x58\x7f\x8c\xc2\xf1\x28\x78\xcc\x55\xba\x57\xe1\xa6\x2b\x49\x2a\xf6\x2f\x62\x5f\x7a\x29\xc4\xc4\x2e\x98\x54\x0e\xee\x41\xcb\x26\xe4\x32\x21\x50\xe0\xf2\xe2\xd0\x50\xf8\x6c\x51\xda\xcc\x55\xc6\xe8\x0f\xce\x9a\xe6\xcf\x57\x48\x72\x40\x34\xc1\x1b\x37\x1f\xd8\x8f\xe0\x6a\x74\x3a\xfa\xa7\x32\x34\xc9\x24\xf3\x1e\x5e\xb6\xf1\xfa\x49\x1c\x6c\x83\x0f\x61\x9a\x70\xae\xdd\x53\xe1\x20\xaf\xee\x6f\xec\x3f\xc2\x9f\x01\xf2\xfe\xf7\x70\xe5\xe4\x67\xb1\x63\x90\x0b\xbd\xe7\xa3\x49\x55\x4a\x29\x22\x2c\x0a\x31\xc2\x31\x59\x84\x5c\x38\xfe\x2a\x02\x7d\xf0\x08\x5b\x66\x25\x1a\x22\x63\xe2\xc4\x10\x09\xda\x2b\x46\x59\xd4\x30\x45\xe9\x0c\x9b\xd2\x0e\x38\x13\x0c\xa2\x08\x73\x17\x23\x30\x78\x88\xa0\xb1\x81\x58\x07\x55\xf0\x71\xc8\x24\x6b\xa3\xac\x5a\xbe\x75\x19\xa8\x13\xb8\x06\x84\x85\xfe\xc1\x9e\x0b\x8f\x53\x7d\x8f\xf6\x87\x2b\x23\xe6\xb0\xbb\xf9\xc6\x84\xbf\x87\xdd\x4f\x31\x7f\xe0\x30\x9b\x28\x73\x8e\x7f\x98\x41\xdb\x83\xb6\xe4\x10\x11\x4c\x7d\x61\xf0\x48\xdf\xa2\x70\xc5\x2f\x89\xca\x79\xbc\x73\x9a\xc0\x0a\x5a\xa7\xec\x24\x4b\x7b\x8a\x76\xba\xc8\x54\x86\xce\xc8\xe9\x73\x0b\x79\xfd\x4d\xae\xcf\x09\x32\xc4\x09\x85\xbc\x3c\x73\x74\x65\x42\x9d\x4b\xc8\x1a\x9e\x9a\x1e\xef\x97\x01\xc5\x54\x59\x10\x60\x7a\x64\x98\xc6\xc7\xeb\x26\x23\xbe\x25\x6c\x25\x3b\xce\x18\xd9\xe3\x8b\xf1\xb6\x1e\xf1\xf8\xc8\x71\x7c\x20\x42\xe1\x8c\x21\x94\x01\xb4\x92\x67\x94\x99\xed\xf5\x64\x79\x7d\x52\xfb\xba\x3a\xb3\x8c\x8f\xf4\xb5\xd3\xef\xee\xea\xa5\x97\x67\x21\x7c\x9f\xed\xf0\x51\x54\x39\x53\xfb\xd0\xb0\xf4\x5a\x3b\x07\x84\x9a\xca\x00\xba\x6f\x1c\x60\x7b\xb0\x3a\xe8\x10\xf8\x99\xd0\x16\xea\x08\x88\x92\x37\x8f\x1b\x03\x7d\xb0\x9d\x8b\xf6\x03\xe5\x0d\xdb\x37\x39\x61\xf1\x99\x21\x4a\xf2\x1a\x4f\x4a\xf8\xcc\xeb\x0b\xcd\x02\x32\xda\x99\x74\x58\xe4\x7e\x9c\x68\xd0\x59\xd3\x41\xd9\x4b\xde\x4f\xb9\xf6\x0c\x26\xa6\x82\x95\x77\x11\xd0\xf7\xca\x27\x18\x50\xfe\x27\x2f\x4d\x1d\x74\x0e\x89\x04\xd7\x8d\xaf\x1a\xf1\x14\xde\x45\x81\xe0\x3c\xfe\x21\x92\x50\xa4\x2d\x51\x3d\x3e\x43\xe5\x80\xa5\xc6\x42\x71\xb2\xbb\x80\x6b\xf1\x8e\xa0\x88\x37\xa2\x3b\x1d\x4a\x0e\xc1\x8f\x1c\x02\x2c\x14\x71\xcb\x7e\xa9\x88\xdf\x3a\x62\x11\x2f\x0a\x51\xf2\x9a\x98\xa0\x38\x49\x8a\x73\x23\xd6\xde\x69\x08\x49\xe2\xf6\x33\x41\x48\xb4\x4f\xfe\x5c\x52\xc5\x71\xcf\x3f\x20\x45\xb0\xda\x26\x1a\x96\x2c\xc1\xc8\x6f\xaa\x9b\xd4\x4b\xd3\xee\x1f\xf5\x2e\x61\x4e\xb8\x74\x2e\x7f\xb0\x63\x55\xb7\xca\xd1\xbc\x07\x3a\xe6\x69\x69\x31\x9a\x8a\xbc\x85\x26\xec\xae\x59\x28\x9b\xe6\x02\xa7\x7d\xf2\x55\x46\x1f\x8c\xd0\xb5\xef\x08\x8f\x8e\xbb\x5b\x21\xae\x67\x32\xf4\x3a\x52\xb0\x4f\xe1\x22\x6b\x6f\x39\xc3\x0c\x39\x68\xb1\xa5\x16\xc2\x28\xe2\xf6\x5d\x35\xc9\x7b\x54\x69\xc9\xc1\xbb\x12\x80\xc4\x90\x95\x28\x96\x21\x74\x1f\x34\xdd\x2a\xc3\x44\x47\x90\x12\x70\xeb\x48\x1b\x43\xad\x24\x95\x3c\x0d\x2e\xaf\x61\x56\x7c\x20\xcd\x99\x2d\x5d\xe8\x36\x04\xcd\x6c\x81\x79\x14\x2a\x5d\x7c\xe8\xd8\x45\x7d\xab\x41\x20\xfd\xda\xbb\xa7\x9a\xed\xed\xa1\xe6\x19\x72\xfc\x90\x49\x76\x88\xd5\xa0\xdf\x69\xcf\x5a\x86\xa0\x1f\xb1\x6a\x79\x36\x7e\x7c\x9b\x3f\x3b\xac\x17\x48\xcd\x10\x4d\x0c\x12\x8f\xb6\x6d\x1c\x9d\xd1\x67\xc2\x50\x55\x69\x1f\xcd\xf1\x76\x06\xa5\xa0\x86\x18\x19\xf4\x57\x60\x61\x04\x14\xe6\xff\x42\xab\x17\x6d\xbe\xff\x54\xab\x97\xa4\x4d\x7e\xbf\xbd\x41\x63\x18\xb4\x38\x70\x2a\xad\x09\xb7\x04\x52\x44\x14\x89\x5c\x01\x13\xe7\x08\xcb\x83\x06\xec\x3d\xf6\x11\x6a\x6b\xe4\x28\x5b\x23\xe3\xcf\x13\xea\x1c\x6e\xf0\xa4\x1d\x86\xab\x92\xcb\x6b\xb8\x21\x34\xb9\xe1\x2f\x14\x08\x64\x7e\xf1\x7d\xff\x0a\xe7\x9e\xef\x9d\x2f\xce\xe0\x23\x96\xcb\x0c\x27\xc9\xf4\x89\xe4\xb0\x8d\xc1\x30\x1f\xc1\x0f\x9a\x47\xdf\xcb\xa4\x88\x62\x3f\x95\x49\x49\x46\xee\xf7\x33\x29\xda\x82\xdb\x65\x15\x63\x1e\x45\xd9\xba\x19\x0e\xc7\xa9\x85\x30\xa7\x23\xd3\x68\xe7\x4f\x10\x6e\xc4\xc3\xc7\x8e\xe7\x07\x3d\x85\xf8\x7d\xde\xc7\xb6\xa8\x39\xc4\xb6\xa2\x06\x15\x9b\xc7\xee\x4d\x55\x57\x67\xba\x06\xfa\x7d\x42\xe1\xf2\xc5\x8b\xe8\xd7\x04\x98\x36\xc8\xc5\xbf\xaf\x0c\xde\xa6\x44\x3b\x56\x1a\x09\xd7\x3b\x8d\xbe\x87\xb5\xf7\x01\xa7\xf9\x7d\x17\xbb\xef\x81\x1e\x1c\xc6\x40\x0b\x4b\x78\xa0\x48\x03\xe6\xc0\x25\x03\xb4\xea\x93\xfd\xe8\xd8\x85\xdf\x18\xfb\xd7\xe9\x87\x67\xdf\xbe\x76\x82\x73\xf2\x20\x91\x76\xf9\xe6\x0e\x34\xf3\x7d\x8e\x39\x9a\x3d\x43\x56\x4c\xcf\x67\xb0\x64\x86\xb1\xfd\x7f\xdd\x9a\x89\x93\xed\xe3\x5c\xfb\x3f\x77\x07\xc4\x9b\xd5\xff\xfd\xd2\x09\xed\xd3\xef\x92\x4e\xf8\xfa\x00\x06\x0a\x27\x7c\xa1\x00\x58\x48\xad\x15\x5c\x4b\x05\x14\xee\x0a\x4f\x84\x23\x8d\xe6\x58\x99\x15\x6c\xed\xc7\xc9\xac\xdf\xbf\x51\xd0\x83\x12\x06\x8c\xd9\x9b\xd3\x8b\xcd\x81\xd2\xb6\x53\xd0\x15\x1b\xa8\x9b\x47\x09\xa3\xde\x75\x39\x0e\xac\xb1\x03\x2d\x60\x93\xcd\x67\x45\xe4\x4f\xdf\x3e\x20\x99\xf6\x35\x23\x71\x4b\x78\xe4\xf1\x9a\x33\x27\x40\x30\x91\x27\xa0\x13\x99\x93\x03\xf8\x51\x15\xdf\x3e\x2e\x9f\xf6\xb5\x10\x94\x4f\xfb\x4a\xef\x95\x4f\x07\x9a\xf9\xb7\xca\xa7\x30\x16\xff\xef\x91\x4f\xee\xd6\xf7\x4f\x91\x4b\x64\xe5\x43\x2b\x39\x6a\x04\x2e\xe2\xbe\xdd\x2c\x97\x18\x71\xac\x6f\x3b\xbf\xa0\xa3\x59\x85\x70\xc8\xb1\xdf\x31\x3e\x88\x4d\xe7\xbb\x86\x31\xab\xf4\x78\x92\xb1\xf2\x5e\x61\xe4\xb6\xe0\xfa\x0c\x50\x96\x5b\x28\xd9\xa2\x63\xc0\xc9\xa2\xb1\x89\x22\x8f\x01\x8c\x66\xb5\x9d\x62\xd8\x65\x10\x16\xf5\x1a\x8d\x38\xdc\x00\x06\x17\xe2\x70\xe2\x80\x01\xba\x4d\x03\xff\xf4\x0e\xd1\x47\xd1\x43\xf6\xed\xf7\x22\x87\x81\x9c\xc2\xdb\x15\x46\x8a\xe8\x47\xcc\xcb\xee\xef\xb1\xb5\x76\x29\xb0\x12\xbc\x54\xce\xb3\x08\xd0\xf7\xdc\x61\x00\x81\x2e\x92\xc0\xfb\x50\x13\x9d\xde\x50\xb4\xed\x07\x7f\x7c\xa1\xbb\xce\x04\x07\xd7\x1f\xa4\xe1\xf6\x21\xb0\x7f\xea\x5f\x88\xdc\x42\xb6\x21\x18\x58\x86\xe0\x54\x73\x43\x43\x02\x5b\xa6\x5f\x3c\xed\x84\x6c\xf0\x87\x97\x0b\xe6\x17\x08\x87\x04\x7d\xa9\x6e\xeb\xbb\x83\x47\x7c\x72\x8c\xea\x4a\x88\x18\xa3\xbf\xda\x5b\x9f\x3f\x4f\x7e\xfd\x40\xdb\x2f\x34\xda\x9b\xe6\xef\x8f\x47\x7e\xfb\x43\xbe\x8f\x0b\xfa\x0e\x84\x7d\x07\x42\xba\x9d\xc0\x47\x72\xf9\xa6\xbb\x67\xaf\x2b\x0b\x15\xed\x98\xe0\x5f\x26\x35\xb5\x41\xd9\xca\xe6\x04\xbf\x27\x36\xd5\x2f\x41\x57\x2d\xfe\x8c\xf7\x4b\x3c\x7b\x2a\xb0\xfe\x9d\xb8\x41\xb3\xc4\x05\x42\xb6\x07\x0d\xab\x6c\x82\x89\x0f\x13\x7f\x43\xc1\xf3\xe7\x22\x9d\xab\xa4\x91\xc4\x41\x3f\xab\xd1\x70\xe7\x66\xd0\xc1\xe7\x8f\x5d\xef\x73\xe3\xc0\x36\x87\xd7\xc7\xe7\x0b\x5f\x0f\xdb\x50\xa4\xbc\x92\x30\x28\x0b\x50\x07\x62\x2c\x0a\x5d\x7d\x45\x6d\x0c\x83\x4f\xfe\x7d\x61\xf0\x2a\xe1\xa6\xe9\x08\xe4\x43\x80\x9f\xfd\xe9\x10\xf0\xf8\x93\xb8\xc4\x60\x3e\x04\x52\xe5\xdd\xe9\x10\xec\x7a\xfe\x84\x15\x6e\x6c\xbc\x8d\x56\xe4\xd2\xb5\xbd\x5d\xfc\xc3\x8e\x52\x80\x91\xa3\x0b\x60\x4f\x50\x88\x4b\x15\x7b\x5c\x61\x51\x93\x9f\x88\x6a\xb8\xdf\xd5\x2d\xe4\xec\x76\x84\x14\x39\x14\xf3\xb7\xeb\xc0\x0e\x6a\x1c\xfd\xac\xea\x82\x78\xea\xc5\xdd\x7f\x84\x27\xac\x65\xcf\x12\x65\x38\x1b\xdb\x10\x06\xe4\x96\x9e\xbc\x3d\xd4\x2d\x3b\x10\x7a\x6f\xd7\x69\xaf\x5a\x58\xb9\x5f\xc2\xf7\xe5\x42\x8e\xde\x39\x03\xfe\xa3\x4f\xde\x1d\x0b\x38\xec\xe0\x9d\x1d\xc3\xed\x90\xde\x9f\x8a\xc2\x17\xba\xed\x0e\x91\x3f\x1f\xc5\xb1\x47\xa7\x3c\x91\x08\x2e\x14\xc4\xa9\xfe\xa3\x5a\x4e\x6b\xff\xf9\xe3\x5a\x61\xfb\x25\x01\xb1\x45\x8b\x29\xdf\xb6\x84\x7f\xe9\x75\x65\x13\x5c\x79\x73\xc9\xa4\x67\xe9\xa5\xbe\x82\x95\x97\x92\x6d\xff\x7d\xe6\x03\xbc\x24\x0c\xdd\x0b\xf6\x33\x8c\x07\xf7\xd6\x31\xe6\xb1\x7b\xf3\x21\x6f\x06\xdc\x83\x75\x43\x08\x29\x35\xd5\xbd\xdd\x0c\xc1\xc6\x71\x48\x92\x08\xaf\xde\x10\x12\x0c\xbc\x61\x06\x27\xb1\x8b\xc7\xa9\x92\x96\x8e\x8b\xc0\x1b\x38\xd4\x10\x17\x07\x3a\x62\x1c\x72\x50\x37\x70\x33\x0e\xb9\x56\x73\x31\x52\x64\x73\xe2\x6c\x8d\xfa\x90\x7d\x03\x48\x8d\xec\x97\xe7\xbb\xe2\x82\x4e\xe0\xda\x4e\xc7\xb8\x20\x37\xc5\xce\xd0\x16\xf7\xb6\x0a\x5b\x08\xd8\xdb\xd5\x76\x4c\x05\x3e\x47\x04\x23\x01\x6c\xf4\x50\x13\xa7\x61\xe1\x42\xb3\xf0\xd0\x64\xbc\x68\x1a\xa2\x39\xd3\x35\x53\x5e\x8a\x3e\x65\xe8\x43\xfa\x97\xff\xda\xee\xc0\xca\x79\x8c\x22\x16\xaa\x8c\x85\xe9\x29\x03\x40\xf9\x1e\xa2\x7c\xd8\xa6\x65\x50\x81\xb3\x37\x49\x21\x0d\xdd\x31\xa0\xa8\x1a\xb2\xf3\x19\xdc\x6d\xf5\xab\x3e\xbb\xe2\xa9\x02\x31\x10\xf8\x14\x1f\x61\x15\x5b\xdb\xc1\x8f\xe8\x10\x74\x24\xac\x07\x02\xa5\x59\xd0\x65\xc3\x14\x0b\xea\xfb\x2e\xbd\xc2\x5e\x99\xc3\x49\x11\x28\x0a\x0b\x13\x79\xe1\x99\xab\x60\x31\x93\xcd\x2f\xb0\xd6\x57\x68\x10\x06\x5e\x26\x90\x69\x19\x0a\x10\x32\x21\xb9\x23\x7a\x07\xc4\x04\x39\xc8\xbd\xa3\x3a\x9c\x5a\x0b\x15\xc9\x52\x7f\x68\x41\x00\x14\x0a\x13\x40\x13\x9b\x8a\x2f\x40\xcf\xe6\x82\xe7\x45\xd3\xf4\x06\x18\x84\xd7\xa7\xc3\x0c\x36\x76\x18\x20\xc6\x60\x27\x86\xfe\x31\xa3\x7a\xb7\x4b\x2d\x0c\x2d\x19\xb2\xc4\xd1\x47\x09\x0c\x31\xbc\x26\xa1\x20\xac\x0d\xcb\x84\x0f\x03\x4b\xc6\x61\xc7\x67\xce\xce\x2e\xb5\xb3\x27\x60\xf9\xe2\xec\x43\x4b\xc7\xf5\xd3\x23\x6b\xc9\x10\x44\x2e\x37\x21\xd7\xfd\xf9\xfc\x00\xc4\xd0\xd2\x2c\x8e\xb7\xdc\x59\xe4\xef\x32\xf8\xe8\x0f\xb3\xf4\xed\xa5\xda\x20\xc2\xa3\x2d\xc8\xfc\x26\x1c\x1f\xfa\x95\x08\x6c\x44\x98\x90\x26\xfe\xa9\x09\x9c\x39\xf9\xb4\x33\xa8\x24\x64\x42\x86\x8a\x98\x80\x35\xc5\xee\x3e\xf6\xf1\x63\xcc\x72\xe7\xc2\xa9\x9f\xa1\x24\x50\xd7\x85\x7e\x60\x2b\xd6\xae\xbc\xcb\x8f\x85\x2f\xe3\x12\x8e\xbe\x72\xeb\x3b\x35\x02\xa4\x81\xfa\x50\x7a\xfb\x08\x2a\xbb\xd5\x05\xc0\xa6\x92\x0c\x0c\x07\xde\xa3\x34\xb8\x6f\xdf\xa3\x3a\x1c\x17\x9d\x45\xe9\xda\x6e\x33\xe8\xec\x97\xfb\x78\x6a\x4f\x21\xf2\xec\x39\xeb\x85\xc2\x35\xcd\x2f\xee\x57\x14\xf8\x90\xfc\x0f\x2b\x24\xce\x40\xfd\x3b\xf4\x11\x9c\x20\xe1\x08\x4d\xe4\xb2\x87\x19\xe0\xb8\xc2\xd5\x16\xca\x17\x72\x4c\xd1\xfa\x12\xd3\xfe\x48\xc0\xce\x58\x1d\x57\xbe\x2b\xaa\xa2\x20\x23\x91\xff\x33\x75\x25\x3b\xab\x2b\x75\x05\x5e\x20\x79\xeb\x01\x4d\x69\xaf\xff\xe5\x90\xef\x85\xf2\x2f\x10\xcb\x16\xe3\x61\x87\xac\x86\xf9\x19\xfc\x71\xed\x4e\x3e\x17\xa7\x32\xef\xc4\x46\x87\xbc\x77\xe3\x4e\xdf\xb5\xbe\xec\xd6\xef\x6c\xc5\x88\xf0\x03\x75\x2c\xdd\xdb\xba\x5d\x60\x4f\xe0\xeb\xce\x20\xd3\xa3\x23\x3f\x77\xcb\x16\x1f\x29\x7c\x92\x66\xd7\xd7\xdd\x41\x9b\x81\x7d\x03\xb7\x3a\x3c\xa4\x2a\x91\xab\x72\x23\x3b\x03\x3b\x83\xcd\xd2\xf5\x76\xaa\x0d\x7b\x18\xc2\xcb\x93\x0e\xd8\x50\xef\xc8\x1e\x96\x73\xf2\x1d\x90\xe9\x17\xce\xb8\xff\x25\xba\x84\x7d\x1d\xe5\xcf\x50\x25\xdc\xfb\xc0\xdf\xaf\x49\xd8\x78\x05\x15\x09\x1c\x25\x8b\xfc\x0b\x9c\x00\xf3\x25\x59\x3a\xc3\x69\x1b\x3c\x1a\x07\x35\x86\x7d\x67\x70\xec\x36\xe3\xc4\x64\x08\x59\x88\x8e\x5d\x86\x42\x16\xa1\xe0\xc2\x82\x0f\x63\x87\x47\xf1\x7a\xcb\xba\x94\x3c\x5c\x96\xdc\xc9\x1d\xd8\x39\x08\x46\xfe\xfa\x05\xfe\xee\xa3\x46\xc4\x30\xc6\x08\x63\xa5\x1e\xfd\x96\x45\xca\x3a\xc6\x5f\x23\xa1\x31\xfc\xef\x96\x3e\x9e\x33\xed\xf6\xc3\x29\xb1\xbe\x08\x1e\x6f\x7b\x64\x2b\x59\x15\x4e\x46\x1b\x9b\xce\x5f\xec\x7a\x5f\x7d\x61\xdf\x6f\x7b\xea\x23\x8f\x35\x54\x97\x5c\x1c\x7e\x50\x58\x35\x65\x2c\xd1\x84\x45\xd6\x78\x2f\x40\xdd\xa8\xc3\x9b\x34\x71\xbc\xac\xe9\x3f\x91\xf7\xb3\x89\x4e\x9f\x6d\x7b\x87\x7a\xf8\x51\x05\x31\x84\xb1\xc2\xb4\x13\x0f\xcb\x12\xaa\x41\xc2\x86\x31\xc2\x0e\x37\x8a\x77\x37\x44\xd3\x61\xae\x34\x7a\xdb\x88\x40\xdd\xbf\x75\xe4\x2f\xb4\x4f\x85\x09\x36\x8a\xdd\xeb\x34\x1c\x4f\x82\x86\xd0\x25\x8c\x0d\x91\x39\x6c\xd8\x94\xf6\xf3\x62\xc8\x6d\xee\x3f\x78\x95\xc1\xb7\xb2\x1f\x5a\x63\x8e\x5c\x1a\x60\xaa\x12\xb4\xa5\x73\x8f\x7f\x30\x18\x3a\xb6\xbe\x12\x09\xcf\x1a\x40\xed\xe3\x9b\x76\x2c\x01\x52\xd8\xbc\x61\x10\x80\x55\x17\x8a\xe5\x46\xa2\x93\xbc\x7c\x4e\xae\x4b\x37\x6a\x00\xdd\x47\xe5\x8b\x10\x30\xa9\x38\x81\x0f\x93\x68\x0c\x98\x6c\x72\x20\x65\x06\x2a\x13\x92\x28\x23\x50\x86\x02\x19\xb9\xdc\x99\x10\x0f\x83\x23\xe4\x8c\xc3\xc9\x06\xd6\x75\xaa\x2a\xa1\x6f\x9b\x7c\xa0\xa3\x04\x33\x0e\xf1\x51\x49\x44\x75\xf0\x32\xd4\x58\x9c\x19\xfa\xd8\x40\xde\xa3\x1d\xe2\xc2\x2e\x10\x1f\x71\x40\x91\x18\x93\x9c\x32\x2e\x51\xec\xfa\x24\x6a\xc9\x2e\x0e\x4a\x93\xd8\x25\x94\xdf\x4c\x83\x7b\xc5\x49\xfa\x8d\x0a\x2f\x2f\xf1\xbe\xe1\xd6\x17\x91\x34\xdc\x1f\xba\xfc\xd1\x2c\x3f\xe5\x96\x1c\x7e\x4b\xfa\x29\x2d\x34\x9c\x27\x68\xc6\x19\xa6\x48\x6e\xb0\x07\x52\x15\xfd\x3d\x65\xbe\x91\xb6\x14\xd1\x42\xd7\x2e\x30\x17\xce\x2b\xc6\xbe\x05\xe8\x9c\x21\xc5\xed\xf4\x51\x67\xd4\x1d\xd8\x9c\x65\xba\xdf\xd1\xa3\xfb\x15\x71\xe5\x39\x58\xe4\xdc\x57\x28\xbe\xd2\xfb\x6a\xac\x6b\x38\x52\xcd\xfb\x3a\x3c\x6b\x04\x2c\x43\x8a\xd8\x07\xb9\x81\x88\x85\xb7\xad\xe3\xdc\xb5\x8f\x40\xc4\x21\xc5\x00\x23\x84\x30\x38\xa5\xba\x04\xfb\x48\x22\xc5\x67\x0b\x73\x72\xe2\x29\xf8\x85\x40\xf8\x7a\xfa\x69\x57\x1b\xce\x31\x72\xaa\x11\xd4\xa7\x40\x23\x38\x60\xd6\xd3\x08\x7a\xf5\xc5\x06\xb1\xa7\x95\x90\x9e\x38\x54\x0a\x34\xe4\x7c\xf1\x36\xe6\xbc\x3e\xa2\x57\xd0\x44\xf6\x93\x2d\x48\x7b\xba\x65\x58\xcb\xbe\x1c\x87\x66\x19\x06\xc1\x3a\x47\xff\x9e\x51\x6f\x1d\x56\x70\xde\xb9\xe7\xf0\x03\xdd\xd6\xa5\x03\x98\x7c\x81\xe0\xbf\x9e\x7a\xda\x25\xd8\x1c\x31\xb8\x21\x28\x38\x6c\x11\x92\xa7\x04\x81\x22\xd0\x03\x24\xdc\x57\x11\x6e\xf1\x9e\x9c\x70\x67\xcc\xe8\x14\x26\x8b\x72\x91\x35\x44\x6b\x61\x68\x0c\xe7\x3d\xa2\x13\x67\x46\x9e\x17\x4e\x53\x4e\xa3\xa4\x1e\x6c\x13\xbf\x7a\xc3\xb7\xce\xb0\x2c\x73\x0f\xac\x43\x13\xda\x20\xfa\xc2\x82\xc9\xa9\x60\x3e\x2d\x1c\xd1\x6d\x88\x40\x8c\x03\xed\x07\x7e\x84\x4e\x46\x43\x44\xa1\x1f\x0b\x0d\x14\x00\x25\x18\xb4\xf9\xf3\xd8\xbd\x67\x64\xd3\x06\x36\x06\xc5\x9d\x8d\x52\x5c\x3e\x0e\x8b\xc1\xc0\x95\x84\x57\xaa\x50\x17\x5a\x58\x13\x77\x40\x64\x89\x39\xf9\x15\xe5\x8f\x03\x4a\x2e\xfb\xbf\x5f\xb8\xf8\xf6\x2b\xfc\x27\x19\x2f\xc5\x12\xf1\xaf\xff\x73\xce\xca\x40\x8b\x30\x2d\x5c\xed\x34\x48\x1b\xf8\xde\x4f\x6b\xc4\xa9\x80\x3d\x2e\xd0\xd7\x04\xb0\xff\x64\xeb\x24\xca\x46\x71\x52\xae\xe0\x71\x15\x3b\xef\x16\x28\xf1\x89\xc2\x0b\x77\x08\x26\x16\x06\x78\x87\x34\xed\xf9\x9e\x00\x4f\x0a\xc7\x8b\x27\xec\x9f\xec\xff\xfc\xce\x9e\x31\x10\x1a\xd0\x51\x21\x25\x9c\x4f\xff\xfb\x27\x1b\x83\x9f\xa2\x01\xf6\x20\x20\x41\x69\xff\x80\xd9\x39\xf5\x48\x73\xc0\x94\x9c\x88\x60\x78\x0c\x34\x46\x28\x1b\x1a\x67\xa1\x7e\x82\x92\xcc\xab\x38\x43\x9b\x18\x78\xfc\x90\x3e\x2b\x02\xd9\x0b\xea\xdb\xe0\x56\x80\xf3\x2c\x7a\xd0\x70\xa1\x38\x2a\x94\x60\x2a\x30\xf8\x00\xf0\x24\xc3\x73\x5a\xd4\x02\x30\x38\xec\x71\x46\x9f\xcf\x18\x53\xb7\xd1\xb0\xe1\xa1\x3c\x85\x02\x23\x19\xc0\x8c\x06\xa6\xf6\x2b\xa9\x88\x52\xc1\x02\x46\x92\x01\xc2\x88\xc5\x7c\xec\xe0\x64\x0a\xf4\xb2\x03\x21\xc3\xaf\x1e\xd2\xc2\x7d\x40\x7b\x43\x28\x01\x56\x53\x4b\x07\x86\x23\x0e\xef\x84\xa0\xcf\x51\xe4\x28\x42\x50\xb8\x42\x4d\x4d\x38\x13\xc3\xf5\xd3\xd2\xcd\x89\x49\x5a\x32\x51\xe7\xe0\x33\x10\x25\x88\x68\xdc\xca\x2e\x85\x1d\xea\x48\x09\x03\x72\xc7\xce\xfc\x89\x52\x6e\xda\xf0\xc8\xc8\xd8\x15\xf0\xb9\x08\x18\x29\x80\xc8\x81\xe8\x86\x25\x0b\x18\x13\x30\xd5\x39\xe1\x0c\x8c\x9e\x0c\x74\x3f\x80\x21\x0a\x22\x00\x24\xd2\x1d\x68\xfd\x89\x28\x1b\x8c\x8e\x0f\x54\x03\x9c\x30\x40\x34\xa4\x50\xf2\x41\x70\x8a\x2c\x89\x96\x8c\xd3\x3f\xbb\x73\x14\x8d\x0b\x78\xb4\x01\xd9\x9a\x1a\x6c\x03\x30\x07\x0f\x4f\x0b\x58\x13\xc0\x1e\x00\x11\x68\x4b\x6c\x18\x9c\xa4\x99\xe1\xc1\xf8\x5a\x00\x98\x26\x32\x33\xb4\x9b\x80\xb9\x49\x85\xa7\x7b\xf1\xbd\x6a\x80\x37\x2c\x42\xdb\x6b\x87\x78\x26\x98\x59\x9a\xb8\x62\x9a\x60\x36\x11\x1a\x3b\x03\x4b\xa5\x1d\xb5\x79\xcb\x37\xdb\x9d\xa1\xb7\xbf\xdb\xf9\xc8\x3a\x3b\xa6\xb8\x1b\x7f\x14\x56\xc1\x3f\x93\x50\x1b\x01\x8c\x11\x4f\x1c\x6c\x10\x0a\x0e\x64\xb3\x5c\x30\x7f\xff\xfe\xcd\x29\x8e\x23\xd2\xde\xfe\xd4\xfe\x06\x13\x3a\x00\x85\x4e\xf8\x47\x52\xea\x02\x21\x0e\xea\x53\x69\x70\xdf\x60\x72\x77\x3a\xbb\x2d\x04\x66\x8b\x1d\x57\x1a\x30\x41\x52\x27\x40\xdf\x43\x11\x3f\x83\x2c\x92\xc0\x23\xd8\x46\x3c\x03\x49\x04\x87\xa5\xa2\xe8\xa3\x93\x2f\xb0\x1f\x5f\xcf\x98\x6f\x50\xe7\x23\x5b\xd3\x2c\xe0\x0a\x59\xfb\xc4\x4f\xa0\x86\x67\x5d\x2c\x2c\x29\x5e\x8c\xbe\x9d\x9e\xee\x92\x46\x41\x64\xc6\x3b\x90\x09\xcc\x34\x38\xff\xe1\x64\x64\x66\xa0\x8b\x96\x89\xe5\x01\x60\x2d\xbf\xd0\x3a\x83\x73\x8b\x84\x3e\x99\x40\x5a\xc0\xe1\x73\xca\xb8\x0b\x4d\xa8\x48\xf3\x89\x14\xbb\xc9\x13\xf8\xe3\x8c\xf1\x0a\x16\x38\xb2\xb8\x3b\x60\x6c\xbd\xc2\xc7\xee\x3c\xac\x96\xe0\x2c\xcb\x38\x89\x42\xbb\x39\x7a\x66\x57\xf8\x83\xd1\x16\x60\x06\x9d\xfb\xd6\x30\x57\x3d\x48\x58\xfa\x18\x18\x57\x28\x54\xf0\x24\x6a\x07\x72\x39\x00\xa8\x82\x18\x3c\x52\xf0\x03\xc5\x50\x40\x81\xb1\x40\x69\x81\x60\x83\x81\x6a\xc8\xbc\xf3\x14\xbf\xdb\x29\xee\xcf\xf1\x3e\x36\xbd\x90\x23\x89\xcf\xcd\x17\x9c\x05\xe7\x3b\x94\x53\x50\x40\xa3\x50\x79\xd9\x72\x1a\xf5\x0f\x24\x4a\x18\x89\x0f\x4d\x73\xae\x57\x05\x88\x1f\x68\xf5\x9c\x01\xb9\xbc\x3a\x63\xe0\xde\xcd\x42\x85\x23\x39\x91\xc7\x93\x04\xa9\xe3\x2a\x67\xce\x30\x22\x71\x34\x03\xc8\x43\xa9\xe3\xfa\xe4\x18\xc0\x56\x48\x08\xd9\x62\x13\x88\x41\xc8\xb6\x1e\x19\xe4\x96\xbe\x60\xbe\x44\x61\x43\x30\xbb\x01\x6e\x1a\xfe\x02\x98\xc0\x3f\x10\xad\xe8\xd7\x4f\xbf\x78\x79\x23\x24\x9b\x24\x2d\x93\x90\xb3\xc2\xf5\x98\x05\x24\x90\xe7\xfb\x8e\xc9\xf2\x8d\xc1\x81\x20\x60\xae\x11\xe4\x48\x2c\xe7\x39\xc6\x8d\x79\xfb\x82\x7d\x22\xa0\x63\xc8\xa5\x87\x51\xf5\xd0\xdb\xc1\x37\xdc\xb3\xe5\x5f\x26\xbf\x21\x7a\x03\xf8\x24\x04\xc5\x1e\x09\xf0\xc6\x8e\x58\x46\x23\x04\xd3\x4c\xe1\x18\xc1\xe8\x19\x1a\xb7\x73\xd2\x38\xc0\xc9\xe3\x65\x8c\xe2\xd4\x18\x7e\x1e\xe8\xa3\x85\xcc\x75\x5a\x40\x4d\xc3\xd6\x23\xe0\x2d\x7a\x3e\x8d\x91\x73\xc6\xdb\xe6\xb6\xbf\x70\xed\xbf\xb0\xcb\x63\x6a\x42\x7d\x50\x13\x18\x68\xca\x03\x46\xd6\x35\x32\xe5\x0d\x34\xce\xb0\xa4\xab\x88\xd8\xa0\x04\x1d\xa9\x15\xa2\xe5\xe8\x41\x01\xcd\x03\xc7\xe6\x21\x6d\x03\xad\x88\x50\x9a\x98\xcc\x68\x21\x2b\x16\x2a\xe5\x72\x61\x60\x5d\x86\x4a\x95\xc6\x10\xd7\x02\xac\x28\xc1\xf0\x15\xcc\x7f\x48\x74\x20\xac\xf0\xc1\x8b\x0b\x34\x51\xfc\x1c\x06\xeb\xf6\xdc\x52\x27\xbe\x45\x8f\x02\x40\xf3\x97\x17\x2e\xea\xc0\x89\x23\x65\xa2\xbb\xc9\x17\xa5\xa4\x0f\x94\x3f\x00\x7b\x47\x2e\x7b\x4d\x08\x07\x03\x47\x6c\xeb\xaf\xa7\xbe\xef\x0c\x20\x09\x8c\xa4\x87\x8b\x47\xdd\x30\x74\xe3\xc4\xb7\xf4\xf5\xc1\x20\x53\x8b\x94\xd7\x24\xf2\x68\xc4\xa4\x1a\xc4\xf1\xc4\x53\xe3\xcd\x83\x30\x0f\x83\x19\x4e\x4e\x90\xb9\x73\xe2\x45\x46\x92\x45\x45\x80\x16\x60\x14\xda\x6e\x70\x4a\x03\xa5\x18\xfe\xb1\xc5\x1f\x58\xca\xf8\x89\xa6\x2b\xfa\x18\x08\x03\xf8\x8c\x76\x58\xa2\x5f\xcf\x3c\x60\x80\x5a\x6f\xc8\xd0\x92\xa4\x0c\x79\xb8\x42\x23\x7d\x0e\xb4\xfa\xc5\x47\x01\x67\x07\xf8\x2c\xf4\x83\xa1\x84\xbe\x77\xae\xa1\xf1\x7f\x25\xfb\x8f\x14\xa6\xb4\x9a\x00\xdf\xa3\xae\xc3\x1f\xf8\x74\x46\x8c\x89\xa2\x70\x79\xfc\xca\x4e\xbf\x0d\xe7\x24\x18\xeb\x04\xe8\x8b\x7a\x72\x6a\x2b\x0c\x7f\x82\xf1\x0f\x6f\x90\x4a\xc9\x82\x5b\x42\x89\x55\x40\x4b\x8e\x3b\x96\x86\xe1\x01\xf1\xf5\xd4\x33\x5a\x41\xfe\x22\x17\xa8\xfa\x99\xcb\x71\x0b\x5c\x10\x8f\x26\x1e\xc0\x04\x7a\x68\x4b\x27\x78\x18\x7d\xdc\x43\xf8\x05\x57\x20\x43\x45\x8c\x33\x98\x98\x0b\x71\x05\x71\x10\xa0\x37\xd8\x8e\x3f\x43\xca\x19\x79\x05\xda\x51\x80\xf5\x7c\x72\x82\xf4\x29\x20\xd7\x10\x33\xc9\xe8\xc2\x16\x58\x9a\xee\x28\x58\xa0\xef\xf5\x95\x7b\x01\xcc\xa9\x8f\x35\x77\xe9\x3f\xee\xec\xf4\x88\xc4\xdf\x4f\xa2\xbf\xd9\xdf\x80\x0d\x0d\xe0\x83\x49\x7a\x12\x95\x74\x7e\x61\x22\x89\xeb\x11\x05\x04\xba\x5b\x09\x66\x04\x47\x75\x4e\xa2\xe6\x62\xa4\x82\xc5\xf7\x0c\xaa\xe5\x9a\x45\x13\x17\xbd\x80\x99\xcb\xe0\xdf\x9a\x28\x71\x0b\xc5\x72\x67\x14\x24\x3a\x72\xed\x02\xaa\x07\xb1\x01\x14\x39\xb1\x79\x86\x36\x68\x51\x0d\xcf\xf2\x86\x6f\x9c\x46\x8e\x8b\x6f\x48\x63\x02\x2b\x03\x8b\x81\x01\xa4\x50\xf9\x73\xb0\xcc\xcc\xcf\x49\x6b\x6f\x80\x58\x9e\xc9\xfb\xcd\x4f\x3c\xf8\x6c\x0b\x5a\x7c\x2a\x1d\x1a\x31\xf8\xcc\x82\x80\x75\x10\x28\x7f\x5f\xc5\xcd\x48\xe7\x0c\x30\xbe\x53\xb4\x14\xbc\x02\x62\x2b\xd0\xee\x81\x1f\xd1\x9a\x02\xde\xda\x70\x66\xe4\x62\x59\x34\xe7\xce\x18\x89\x81\xa7\x2a\x90\xb6\x08\xab\xae\xc1\x2a\x28\x20\xe5\x31\x81\x57\x2a\x0b\xe5\xca\x72\x8c\x61\x47\x25\x05\x26\xa4\x02\x15\x3f\x28\x01\x60\x45\x18\xe4\x3d\x12\x61\x88\x26\x0a\xb1\x07\xeb\x16\x4c\xb9\x81\x30\x45\xab\x88\x6d\xac\x01\x50\xaa\x68\x00\xf4\x5d\x6d\x94\x2c\x78\xf6\x2a\x42\x8c\x69\x72\x32\x83\xd2\x5a\x08\xb4\x0b\x78\x39\x7a\x42\x1f\x41\x9d\x0c\x2a\x7d\x8e\xc8\x23\xc7\x43\x00\x8d\xdf\xec\x09\x8d\xcf\x75\xd0\x6f\xdc\x93\x44\xe7\x0c\xba\x29\xda\x4b\x68\x67\x09\xc2\x8d\x91\x1e\xde\x89\x9b\x93\x80\xc2\xe0\xac\x28\x09\x82\x2a\xde\x45\xa3\x7c\xb2\xf0\x5f\x48\x8c\xb2\x15\xae\x98\x40\xde\xee\xa3\xba\x27\x3b\x94\x6b\x9a\xcc\xd0\x5e\xb8\x01\xd6\xe8\x49\x10\x35\x0f\x63\x12\x3d\x99\xe2\x4c\x44\x70\xd2\xd0\x6d\xaf\xdd\x4a\x20\xaf\xb4\x5d\xd0\xe5\x39\x06\xed\x8e\x84\xd7\xa3\xe5\x94\x43\x68\x6a\x39\x20\x02\xc4\x5e\x11\x66\xd4\x79\x9d\x53\xcf\x5a\x61\x2f\x01\x1e\xa1\x6b\x8f\xd3\x01\x80\xb8\xd8\x0e\x78\xbf\xec\x12\x41\x01\x7d\x10\xe8\xdf\x41\xb2\xd3\x94\x36\x77\x52\xfa\x8c\x41\x04\xc4\x69\x05\x64\x69\xe3\x14\x01\xd3\xe4\xc4\x31\xfe\xde\x76\x30\x13\x2a\x14\x50\x3c\x7f\x09\xd0\x15\x9b\xa0\xf0\x0c\xa1\x79\xe2\x3d\xfb\x44\x51\xcd\xa6\x59\x48\x61\x42\x27\x9b\x0a\xe1\x48\xd1\xa3\x8b\xa6\xb9\x8b\x99\xeb\xa2\xc6\x7e\x61\xfc\xdd\xc6\x81\x2c\x89\x34\x87\xc1\x19\x09\x6d\x6b\x2f\xb2\x67\xb0\x3e\x58\x67\x80\x1d\xb6\xc7\x0b\xee\x69\x62\xe2\x1c\xf2\xdb\xdf\x02\x2e\xb7\xbb\x81\xc0\x08\x20\xa3\x92\xf4\x16\x90\x0a\xd5\xf4\xaa\x95\x18\xf2\x17\xf0\xf1\xeb\x17\x78\x08\xcc\xdf\xba\x00\x64\x2a\x18\x3f\xaa\x18\x06\xb2\x73\xfa\x78\x51\x76\x6b\xec\xa0\x08\xcd\x96\xe1\x23\x86\x85\x6b\xa8\xc4\x18\x29\xfa\x88\x38\x92\xb0\xc7\x62\x1f\x9b\x9e\x21\xa5\xfb\x8c\x49\x9f\x42\x9f\x06\x43\x9c\x1a\xdc\x6c\xa6\x90\x00\x2a\x16\xa9\xc5\xd4\x44\x82\x4d\x20\xaf\xc3\x85\x13\xe0\x44\xbc\x25\x75\x45\x84\x4f\x40\xbf\x8e\x7a\x8d\x7f\x68\xf6\x83\xe2\x61\x7e\x15\x88\xac\xb7\xb0\xa0\xaf\x34\x28\x0d\x41\x05\x57\x51\x27\x43\x8d\x70\xb1\x4b\x3b\xad\xc3\xad\xed\x04\x40\x59\xd4\x84\xea\x44\x56\x04\xe4\xa8\xf0\x02\x45\xe7\xc3\x4e\xbc\xef\x0c\x74\xab\xf4\x2e\x02\xcb\x2a\x45\x60\xb8\x6a\x79\x89\x6c\x10\x87\x14\x22\x33\xf4\x8e\x74\xf1\x8d\x4e\xd4\x56\x01\x72\x4a\xe9\x76\x5f\x4e\x7c\x5b\x10\x50\x1d\xa3\x05\xe9\x0e\xc1\x4c\xc0\xe0\x8d\x69\xaf\x7a\x15\x64\x12\xcc\x7a\x48\x8d\x60\x4e\x44\xaf\x35\xc2\x29\xa2\x01\x86\xe6\x51\xc3\x61\x16\x3a\xe9\x20\xbd\x2e\x9f\x23\x45\x59\x4c\xa8\x60\xe1\x82\x37\x99\x7c\x0a\xec\xd3\xbc\xf9\x7a\x07\xff\x94\x91\x15\x83\x49\xb4\x4f\xe4\xf5\x51\x6c\x84\x19\x14\x7a\x40\xcf\xfa\xe2\x39\x31\xfc\x15\xa8\x5a\x44\xe4\x47\xcf\x97\xb2\x29\xa3\x1c\x0b\x40\xd7\x2c\x1b\x06\xb7\xd9\x35\x60\x58\xcf\x81\xaa\x51\xd9\x3a\x91\xbd\x06\x21\xf2\x3f\xe2\xf6\xc1\x50\xf8\xf0\xa1\x17\x4c\x52\xc8\x13\x6d\x16\xf4\x5b\x84\xed\x8b\xe0\x9a\x10\x3a\x06\xf1\xa5\x09\xb7\x48\x54\x6e\x0d\xcf\x4c\xe3\xdf\x32\xd1\xf5\xcf\x18\x5f\x33\x71\x26\x75\x7a\xfa\xd5\x86\x0a\xe8\x91\xc0\xb7\x82\xa2\x1e\x89\x40\xc9\x27\xbc\x4a\x1c\x61\xbe\x8f\x6e\x3d\x0c\xf6\x34\xc1\x09\xc2\xfe\xa2\xb8\x20\x3c\x6e\xaa\x2b\xca\x0d\xd0\xba\x50\x36\x8b\x6f\x0c\x3a\xde\x08\xd8\x00\xc7\x5c\xb8\xb3\x7e\x37\xad\x4f\x74\x49\x02\x82\xcd\x4b\x6a\x62\xd1\xf8\x09\xed\x98\x2e\x21\x3d\xfc\x92\x74\x77\x47\x83\x23\x89\x06\x22\x0e\x13\x7c\x27\x99\x73\x02\x3e\xc6\x90\xa6\x7d\x26\x84\x2d\x16\xb0\x21\x00\x24\x2d\x14\x28\x61\x96\x00\x1c\x6f\x6c\x0d\xf0\x96\xa1\xc0\x33\xfb\x60\xa9\xc1\x2f\x54\xd1\xe2\x3c\x2f\x38\xc5\x22\xcf\xbf\x93\x3a\x36\xad\x65\x40\x65\x74\xd3\x0c\x36\xa2\x80\x52\xce\x9d\x91\x1e\x44\x4f\x8f\x63\x1d\x9b\x0a\xd8\xe6\x08\xa1\x8c\x43\x18\xa0\x0f\xa3\xa9\x8d\x30\x80\xb9\x09\x28\xf8\x3c\xdc\x46\x8c\x4e\xa3\xf4\x11\x05\x6a\x9c\x52\x1e\xd9\x81\xe2\x8a\x3e\xf9\xea\xbe\xee\xaa\x1b\x3f\xa2\xb2\xe4\xa9\x8c\x94\x4f\xd2\x05\xbf\x57\xc4\xb3\xfe\x46\x89\x7e\x10\x3d\x73\xc8\x90\x80\xc2\x00\x0c\x6c\x82\xec\x17\x7b\x6d\xca\x43\x78\xac\x8f\xc6\xe3\x18\x4e\x75\xea\x7e\xda\xd3\x05\xac\x80\x1c\xdb\x03\xac\x0c\x40\x53\xac\x0f\xd7\x24\xbc\x2e\x84\x08\xaf\x63\xbb\x2d\x60\x1b\x96\xee\x75\x38\xab\xed\xb3\x7c\x1d\xa3\x07\x1a\xbd\xb8\x77\xd8\x32\xc2\xc9\x85\xf0\x5c\x42\x8b\xe8\xfb\x6d\xea\x60\xde\x86\x0b\x27\x6d\x83\xfb\xd2\x95\x62\xde\xf9\x05\x27\xd5\x49\x10\xc4\x1f\x4c\x14\xfc\x12\x19\xf2\x88\xd1\x84\xc7\xfd\xd0\x49\x6c\xcf\xdb\xb0\x2e\xd2\xea\xd3\xf7\xf5\xce\xab\x88\x85\x34\x45\x2b\x12\xdf\xd7\x94\x1f\x1a\x54\x3b\x00\x44\x8f\x6e\xb3\xb3\x69\x52\x18\x35\x3f\x81\x6e\xed\xfd\x22\x91\xac\x10\x78\x8b\xd9\xcd\x1b\x44\xcf\x21\x8f\x86\x14\xac\x45\x4b\x74\x2f\x0b\x92\x52\xf8\x36\x4a\xa0\xe5\x45\x7d\xa8\xc3\xf9\xa1\xca\x6b\xb0\x5c\xda\x8d\xe1\xab\xd7\xcc\x73\xaa\x75\xdb\x9b\xeb\xee\x1e\x51\xe6\x90\xb3\xb3\x76\x4e\xfd\x76\xbf\xdb\xfb\x54\xe7\xce\x2f\xf7\x1b\xbd\xa3\x71\xee\x79\xa2\x82\xae\xa8\x5d\x84\x73\xcf\x93\xdd\x5f\xbb\x2c\xaf\xab\x33\x18\xb3\x78\xee\xd1\xfc\x7c\x4a\x3b\xa5\x0b\xe1\x6f\x21\x8a\x57\x90\x42\xbc\x93\x14\x14\x67\x00\xf3\x5f\x06\x05\xc6\xd7\x6e\xc0\xde\xb7\x06\xd3\xe3\xb7\xbd\x17\x47\x45\x6d\xbc\x81\x81\x03\x0a\x90\x78\xaf\xe8\xef\xdf\xa0\x3b\xf8\xcd\x75\x05\x43\xf9\x76\x12\xb2\x9f\x13\x12\x0e\x44\x32\x2e\x9c\x33\xa9\x5c\xb0\x57\x36\xbc\x99\xa1\xcf\x3c\xa3\xbb\x2b\xf6\x0c\x69\x80\xef\xa1\x89\x73\x95\xd0\x7e\x72\x04\x6e\x1c\xfa\xaf\xa2\x84\xbf\xe3\xfb\xb8\x8b\xee\x50\x80\xc7\xa0\x11\x01\xa3\xc5\xe8\xe5\xc4\x13\xfc\x85\xe3\x1f\x64\x33\x18\xb7\x67\x8b\x07\xec\x7c\xa1\x82\xf0\x88\x69\x12\xd8\xfa\xc0\xef\xbf\x78\xca\x7f\xa5\x83\xc3\x66\x5e\x1b\x23\xd4\x6e\xde\x03\xca\x17\xf5\x46\x30\x04\xb4\xf8\x2b\xb1\xd0\xe4\xf9\x42\xbc\x11\xc0\xd2\x0c\xaf\x53\x21\xf4\xff\x2b\xe8\xdc\x77\xc3\xe2\xe0\xdf\xaf\xbe\xaf\x6f\x3b\x37\x66\xde\x82\x33\xf7\x2f\x2c\xcf\x50\x30\x04\xa4\xc7\x7b\xe7\xf0\x21\x46\x9d\xfc\x4c\x0e\xa5\xb2\xa5\x7f\x07\x7b\x06\x82\x4c\x8f\x66\x5a\x8f\x4f\x1b\x65\x23\x84\xf7\x9f\xa3\x50\x1a\xe8\x0e\x86\xe0\xc8\x76\xbc\x6c\x20\x37\x02\x43\xe2\x68\x9d\xfd\x52\x1a\xd4\x48\x04\x3c\x2d\xd2\x17\x21\x19\x22\x0f\xcf\x25\xc1\x2d\x51\x65\x83\x37\xdd\x21\x5c\x7c\x00\x18\xd8\xc2\x63\x9d\x19\x6d\x12\x1e\xf4\x71\x66\x49\xdf\x0c\x82\xd3\x04\xe7\xa1\x44\xb3\xe8\x53\xd8\x34\x42\xc8\xda\xd3\x28\x10\x99\x8a\xe2\x6d\x71\x02\x4d\xcf\xad\x48\x88\x9d\x43\xd9\x17\xb2\x2d\x02\x7a\xce\x90\x0b\x40\xec\x48\x4e\xe6\x6d\x97\x8a\xf8\xce\x39\xfd\x6b\x70\x56\x63\x1c\x4f\x83\xd3\x9a\x20\x1f\x9c\x8b\x87\x90\xff\xe6\x84\xee\x9e\x33\x9e\xda\x67\x8c\x3c\x23\xfd\xd9\xd9\x39\xff\x64\x74\x06\x02\xb4\x1c\x8a\xd1\xa7\x20\xc5\x0f\xc8\x1e\x64\xfe\x3b\x63\xb6\xd3\x03\x40\x03\x74\xae\x5b\xba\xc0\x1d\x07\xca\x95\xf7\x03\xee\x0f\xb4\xc7\x9d\x70\xa6\x4f\x47\x09\x95\xd0\x70\x67\x32\x26\x21\x66\x8c\x8f\x14\x7b\xa9\x40\xdf\x71\x62\x23\x3e\x3f\xa1\x29\x14\xe6\x6e\xa7\x2f\xf2\x08\xfa\xdc\xe9\xaf\x67\x78\x2f\xe5\x8c\xbe\x3e\x03\x16\x0c\xde\xa3\xb2\xd3\xc2\x71\x44\x2a\x49\xc6\xe7\xc8\x56\x42\x81\x33\xbb\xc3\x97\xcc\x97\x1d\x97\x81\x25\x81\x85\x90\x02\xf6\x40\xf2\x2c\xec\x0e\xb2\xaf\x64\x0f\x93\x89\x9e\x86\x09\xea\xb3\xdd\x6a\xa7\x7b\x27\x0f\x75\x1b\x4f\x98\x26\x77\x12\x72\x65\x0e\x74\x6b\x69\x38\x67\x09\x3c\x21\x8a\xec\x3e\xea\xea\x1b\x88\x37\x82\x47\xa1\xe4\xca\xd4\xd0\x2b\x55\x42\x1a\x86\x0e\xc9\x1a\xf4\xed\x86\xdd\xa6\x72\xca\x7c\x76\x0b\x1c\x5e\xa3\xf0\x66\x1b\x75\x55\x02\xb5\xe8\xc2\xdd\x77\x0d\xac\x4c\xf7\xf0\x2f\x31\xb5\x9c\x65\x02\x26\x5c\x54\xc0\xc7\x0e\xfa\x41\x7d\xe0\x8c\x57\x1c\xba\xd3\x01\xbf\x80\x40\xae\xe9\x2a\x27\x6b\x6e\x01\x11\x86\x49\x80\xcf\x28\x5c\x02\xed\x26\x12\x33\xc8\xd7\x02\x49\x97\x02\x4a\x56\xf1\xaf\xe8\x2f\xc4\x41\xb9\x47\x19\x74\x6e\xd8\xd8\xaf\x0c\x06\x2e\xe2\x38\x76\xa9\xfd\x6e\xe5\x8d\xa2\x74\xb8\x7d\x40\x15\x08\x65\x12\xba\x07\xfb\xd6\xae\xbf\x12\xe8\x27\x98\x5b\xee\xca\x10\x3e\xef\x21\xa4\x53\x2c\xc7\x7c\x2f\x3f\x05\xd1\xa3\xf7\x9f\x28\x54\x4f\x69\xd0\x38\xf1\x1f\x00\x45\xc9\x6d\x7c\xed\x86\x2b\x58\x9c\x32\xbe\x18\x16\xb8\xc4\x44\x11\x60\x57\xa9\x63\x62\x8c\x53\xc5\xab\xdf\x39\xaf\xcf\xf7\x95\x00\x5f\x03\x98\x78\x23\x36\xde\xab\xca\x0d\xe8\xe4\x6d\x3b\x58\x2d\x34\xc1\xdb\xbf\x8d\xcf\x48\x46\xab\x10\x0e\x21\x5f\x02\xea\xcd\x01\x36\x71\xba\x03\x86\x5a\x37\xea\x1c\x3f\x71\xbe\x07\x57\x2b\x74\xe0\xe1\xc2\xd9\xad\xa5\xc2\xf8\x4f\x26\x96\x35\x33\xff\x38\xff\x93\xfd\x93\xfd\xf2\xbf\x7f\xb2\x7f\xfc\xf6\x35\x76\x9a\xc0\x61\xff\xbf\xa7\xfc\xc1\x2e\x04\xd7\x2f\x10\x1e\x56\x41\xe0\xaf\x73\xf4\x2f\xdc\x5d\x93\x4d\xa8\x90\x20\x6f\x1a\x60\x74\x2f\x9e\x30\xbc\x09\x2c\x04\xf0\x16\xa7\xf0\x90\x95\xb0\x75\x88\xb0\x37\x59\x8c\x48\xf3\x60\x72\x44\x61\x8b\xd1\x70\xb1\x8d\xf2\x74\xed\x30\xf6\x21\x4d\x7d\xc9\xe9\x2e\xd1\xca\xe5\xd9\xc7\xa5\x4a\x7d\x49\x7e\x45\x09\x94\x4e\x19\xa8\x22\xbd\x6f\xe5\xf2\xa7\x9d\x3b\x84\x10\x19\x66\x92\x06\x8d\xac\x55\xc7\xe6\x11\x3b\xf5\x1d\xa1\x09\x5d\xc9\x40\xa5\x50\x34\x7e\xfd\x15\x7c\x49\xe0\x52\x26\x6c\xc4\x59\xb1\xa8\xf7\xef\x5c\xc9\x7c\x53\xb4\x4f\xa7\x4e\xda\x31\x45\x43\xd3\x2b\xfd\x94\x29\x7a\x94\x13\xc1\xcd\x24\x04\x1b\x44\x8f\x86\x2a\xe2\x40\x3e\x0d\xa7\xbc\xc0\x4f\x86\x28\xc1\xf9\x1e\xfd\xba\x9b\x3d\x42\xdd\x52\x76\x77\xc3\x99\xd5\x99\x04\x07\x24\x81\x0d\x86\x5a\x33\xbe\xa0\x2a\x54\x07\x1c\xf7\xfa\xce\x64\x22\x67\x8e\x74\xd8\xa1\xaa\xb9\xdc\x84\xb3\x18\xed\x42\x1a\x7d\xb5\xb1\x76\xba\xe8\x5b\xcf\xc2\x30\x78\xdf\xe4\xda\x9d\x80\x29\x0c\x2d\x92\x91\x09\x0e\x1f\x1d\x64\xec\x0e\xa3\x27\xd0\x98\x8c\x27\x78\x47\x25\xa9\x7f\xf3\xa7\x6d\xf2\x84\x18\x1f\x3f\x11\xae\xa8\xbc\x1f\x3b\xe6\x41\x58\x6a\x90\xff\xd8\x34\x70\xc3\xd5\xcf\xa9\xdf\xef\xe3\x74\xbb\x43\x21\xcb\x9f\xfd\x09\xc6\xc2\x7b\xac\xa7\x10\xf6\x27\x11\xb4\x87\x56\xbc\x90\x00\x52\xbb\x82\x1d\x44\xea\x24\x39\x71\x02\x06\xc7\xc4\x48\x3e\x43\x1e\x68\xec\x87\x3e\x73\x3c\xcd\xe7\x61\x71\xf7\x3b\x83\x2f\x29\x4a\xda\x0d\x85\x4e\x24\x37\x33\xc2\xae\xc9\xe4\xd1\x19\x6d\x58\x67\x90\x2f\x71\xbd\x1d\x4b\xa0\x2f\x97\xc5\xde\xc5\xd0\x2e\xe4\x2e\x3f\xe4\x64\x04\x20\x13\x2a\x12\x96\xbf\xe1\x9d\x93\x35\x2c\x99\x47\x68\x7f\x91\x65\x7c\xb2\xb3\x59\x3a\x1e\xd8\xce\x30\xe1\x8e\xa6\x93\xf1\xe1\x34\x64\x31\x3c\x6a\x6e\xf6\x9c\x6c\x09\x3b\x66\x66\x30\x9d\xc2\x0f\x99\x97\xee\xc8\x91\x40\xd5\x1e\x8a\x1d\xfa\xc8\x74\x25\x91\xff\xe7\x28\xcc\xe9\x2c\xe0\x8b\x0e\x3f\x90\xbc\x7f\x99\x82\x16\x45\x38\x0b\x11\x82\xe1\x30\x16\x34\x6a\x08\xff\x3d\xcc\xb1\x82\xa1\x32\x34\x74\xd2\xe1\x28\x09\xd5\x0d\x20\x84\xa2\x97\x04\x4f\xf3\x84\x71\x61\x79\x57\x0f\xd9\xef\x42\xc0\x65\x03\xc2\x07\x8c\x96\x8a\xe2\x53\x1c\xd4\xbd\xf1\xd5\xe4\xc0\x2d\xfb\xa7\x19\x63\x1d\x93\x0a\x56\x42\x93\x03\xfc\xf5\x4c\x79\xdb\xfd\x87\xcd\x3d\x5b\x0b\xf6\x8a\xaf\x40\x04\x39\xfa\x6c\x1f\xd7\xb8\x40\xa3\xf6\xc9\x37\x9c\x9e\xc3\x18\x7b\x23\xd8\x11\x30\x32\xd0\x54\x14\x3b\x42\xdb\x89\x47\x47\x9d\x4e\xa0\xd3\x93\x4e\x5f\x70\x1c\x3a\xdc\xf9\x05\x78\x03\x43\x4f\x00\x42\x03\xf5\xef\xf4\xd4\x1b\xcc\x8e\xcf\x95\xe3\xe2\xf8\x44\x7a\x98\x4e\xe2\xdf\xbc\xf7\xf6\x11\x79\xab\x76\x49\xce\xb7\x9d\x31\xd8\xc4\x1f\x82\x4e\x5e\x21\xaf\x32\xbe\xa9\x0b\xa5\xe6\x45\x77\x6d\x9b\x28\xcc\x19\x48\xfd\x19\xb0\x6d\xe0\x19\x2e\x1e\x99\x25\x8a\xfc\x0a\x9d\xcf\x3a\x30\x81\x60\x74\xb3\x73\xa2\x07\x9d\xa7\x11\x48\xdd\x33\xf0\x8c\xce\x72\x41\x1f\x88\xeb\x03\x44\xca\x0c\xfa\x4a\xb9\xc9\x2d\x7d\x8c\xce\x2d\x27\xfc\x27\xb3\x0c\x95\x53\xe4\x2d\x3e\x46\x81\x2f\x0e\x0c\x84\x5e\x9d\xb8\xb7\x08\xa1\x20\x23\x62\x87\x7d\x49\xc6\x4b\x5f\x63\xec\xf8\xcc\xfb\x1a\xf2\x1d\x7c\xc7\x44\xed\x20\x78\x2f\x7f\x7a\x02\x71\xc2\x3d\x30\xce\xf5\x91\xfb\x3d\x30\x81\x5b\x26\x7f\xe4\x66\x07\x7d\x91\xd4\x4f\xde\x8c\xa3\xee\xd0\xdb\xef\x8d\x81\x5c\x1d\xe6\x93\x71\x15\x55\xdf\x78\x7a\x4f\xc9\x9c\x02\x85\xf9\x84\x54\x40\x61\x41\xa1\x76\xb7\x65\xbb\xf0\x5c\xb5\xd8\xd7\x88\x0f\xac\xcd\x18\xe4\x94\x4c\xe8\x51\x13\xbf\x67\xdc\xbf\x0b\x80\x1a\xdd\xb3\x05\x07\xd0\x3d\x87\xff\xf8\xdf\x93\xe3\x71\xe4\xa6\x58\x13\xd9\xd1\x02\xc3\x41\x27\x26\x3a\xfc\x0e\xcf\x52\x43\x9a\xab\x30\xc0\x10\xb6\xe1\x3f\x79\x05\xdf\x9d\x23\xd2\xae\x01\x69\xb1\x29\x8d\xbd\x51\x67\xf8\x23\x92\x3e\xf8\x02\x58\xf4\xe7\x6b\x00\x37\xfb\xde\x48\x08\xc6\x04\xa4\x77\x00\xfc\x12\xba\x89\x88\xfe\xec\x76\xef\xef\x75\x2d\x50\x3f\x88\x04\xc3\xdc\x41\xb9\xba\x43\x2f\x5b\x4e\x41\xf7\x03\xe8\x5c\x94\x76\x8a\xc7\x83\x45\x4f\x3f\x1a\x53\xe0\xbb\x60\xf1\x40\x54\xc1\x8e\xeb\x18\xff\x1f\x9d\xbe\xd4\x15\x73\x21\xd3\xf7\xbb\xb7\xd3\x9d\xa2\xa8\x1d\x14\xf9\x8e\xb7\x8b\xf0\x6d\x90\xc1\xc0\x77\xb7\xed\x57\x78\xc3\x10\xae\x87\x0e\xbe\xc2\x63\x6e\xe7\x50\xed\xc1\xaf\xf0\xfe\xca\x27\xff\xd4\x44\x5b\x7d\xb0\xaa\xbb\x6d\x7f\x1a\xb2\x89\x4e\xb6\xdb\x61\x90\x7a\xe8\x26\x7b\x70\x92\xa3\x56\xf7\xee\xb3\x33\x24\xb4\xdc\x45\x39\xac\x0c\xc6\xfb\xdc\xd3\x8b\xb0\x72\xd4\xa5\x89\x76\x61\xea\x55\x58\x0d\xe7\xa2\x49\x6f\x8e\xa3\x7d\xf9\x71\xc2\x77\x1e\x83\xcf\xf6\x29\x50\x9b\x66\x64\xde\x39\x9a\x0b\x52\x41\x0e\xd0\xf9\xc0\xde\xe4\x11\x8d\xba\x37\x69\x7a\x1a\x76\xde\x1f\xc4\xc0\x05\xe0\x60\xe1\x56\xfe\xf4\x7d\xa1\x11\x7e\xd9\xe6\x0f\x96\x08\x48\x39\xff\xbd\xa0\x44\xd2\xf9\x25\x9c\xbf\xd8\xbb\xa5\x5c\xcf\x7b\x4d\xe3\x2e\x3b\x2b\xfc\x32\xc7\x1f\x29\xd5\xa8\x6b\xe1\xa0\x50\xa3\x39\x14\x66\x12\x38\x0f\x3f\xfe\xe5\x9e\x3b\x43\xf5\x61\x14\x77\xf4\x14\xad\xda\xf6\xfd\x7c\xdf\x1d\xca\x41\xd6\xd1\x70\x13\x30\xd4\xba\xc1\x57\x23\x42\xac\xbd\x2c\x87\x04\x21\xbe\x41\x10\xf5\xc9\x6b\x58\x84\x1c\xb9\x0a\xeb\xdd\x19\xaa\xfa\xee\x71\xa6\x6e\x99\xdb\xb7\x82\x79\xee\xb8\xfb\x91\xc3\xeb\x5e\x3d\x74\x0e\xaf\x25\xa2\x87\x97\x5c\x18\x77\x4e\xce\x63\xfb\xbe\x38\xf7\xc5\x05\x4d\x68\x72\x75\xdd\xb9\xef\xc8\xf5\x37\xac\x52\x91\xd3\xdd\x38\xde\x22\xfa\xd8\xbd\x8f\xd2\x2e\x19\xba\x20\xbe\xad\xcc\x2d\xdb\xc3\xcf\xbb\x8a\xc3\xbd\x5d\xb7\x30\xdc\xe0\xdd\x0d\xd9\xb9\x8a\x8c\x82\x8e\xde\xed\xac\x62\xdf\x32\xe6\x56\xa8\x80\x37\x0c\x7a\xb5\xab\x8e\x7d\x80\x9d\x54\x40\xaa\xeb\x6e\xf4\xed\x4d\x3e\xb7\x02\x7e\xf4\x48\xae\xaf\x3f\x51\xab\x80\xbc\xb0\xc7\x07\x16\x3c\x52\x1f\x72\x2e\x1c\x25\xe1\x82\xdb\x1f\x38\x6b\x0a\x71\x34\x7d\x0a\x14\x24\x37\xd1\x5d\xa0\x74\x59\x76\x8a\x27\xd7\xa2\x3b\xff\xdd\x9b\x35\x8b\x3e\x76\x8a\x2e\x8b\xbb\x60\xd8\xff\x3d\xf9\x53\x88\x9d\xb2\x09\x71\x2d\xf2\x27\xf4\x45\x72\xd8\x38\x38\xca\x28\xa0\x1c\x9a\xbe\x2f\x00\xaf\xf3\x9d\xa9\x00\x30\xf6\xe7\xe4\x6f\x40\xeb\x06\xac\x77\x8e\xb3\x2a\xde\x80\x39\x8e\x7a\x88\xa2\x31\x00\x62\x27\x76\xc7\x61\x32\x2b\xb4\xa9\x08\x6f\x7a\xcd\x66\x33\xcc\x39\x53\x4c\x06\xf4\x13\x97\x51\xcf\xed\x9e\xff\xe1\x42\xc6\x6f\xbe\xa4\xbe\x9e\xa2\x80\x12\x5f\x5d\x9b\x63\x49\x37\x9c\x6b\xf2\xe0\x55\x0a\x67\xe1\x46\x49\x98\x95\x15\x66\x53\x50\x9b\xd7\x78\xcf\x1d\x17\x3d\xca\xc4\xa0\xd8\xdf\xbd\xe9\x2c\x4c\x8b\x05\xaf\x91\x49\x48\xfb\xb7\xe1\x4b\x74\xd3\x63\x38\xfb\xd9\x91\xdb\xa0\x00\xde\xdb\x21\x32\xec\x6b\x28\x33\x40\x65\x0f\xe8\xb6\xa4\x12\x1c\x10\x7c\x16\x11\x8e\x08\x7a\xe9\xcb\x22\x70\x8e\xdf\x1e\x74\x66\x3b\x2d\xd3\x77\x6a\xa2\xee\x9c\xa3\x3f\x09\x18\x46\x6f\x98\xe1\xbb\x83\xfb\x56\x30\x4c\x08\xdf\xf1\x1a\x2a\x40\x8c\xbe\xe2\x33\x50\x8a\x09\xc3\x0b\x1e\x78\x08\xbc\xf5\x74\x30\x2c\xf0\xd5\xdb\x18\x6c\xea\xd3\xe1\x86\xfc\x2e\xbb\xb7\x50\x57\x7f\xc8\xdd\x91\xd4\xbd\x91\x81\x6e\xbb\xdf\x80\x22\x96\x2d\x95\xfc\x5d\xb6\x1d\xb0\xf6\x95\x88\x68\x43\x2b\xa4\x7f\x01\x58\x99\x43\xb0\xec\x6d\xb0\x63\x80\xa5\x0f\x01\xa3\x12\x03\xed\x87\x94\x3a\x04\xc9\xbe\x8b\xea\x40\x04\x9b\x5d\xda\xd9\xb7\x7b\xaf\xde\xd2\xb0\xb3\x01\xef\xd0\x5a\x02\xd9\x82\x7f\xf0\x06\xc0\x51\x71\xf8\xfb\x16\x3b\x95\x7b\x15\x6b\xf8\x34\x54\x98\xf4\xd1\x74\x41\x0c\x06\x9b\xc0\x2f\xa2\x80\x5d\xd7\x5f\xec\x9c\x53\xef\x36\xaf\xd1\x95\xce\xf0\x50\xdd\xdf\xf0\xd7\x5f\xbf\x7f\x73\x52\xd1\xbc\xfd\xed\x9d\x48\x08\x0b\x7c\x05\xb4\x10\x66\xf2\x42\x73\x17\x7f\xf5\x4b\x69\x74\x5b\xfa\xee\x05\x0c\x59\x29\x44\xe9\x88\x06\xbd\x4b\x40\xca\x01\x65\xdf\x2b\xce\x3d\xbd\xa5\x42\xee\xe1\x65\x9d\x41\x13\xce\x21\x07\xbc\xdb\x13\x50\x63\x4f\x51\xfb\x98\xea\x18\xd3\x04\xfc\x00\x24\x81\xf7\x72\x4e\x80\xc9\xec\xa7\x88\xeb\x2e\xc0\x15\xa0\x57\x1b\x12\x29\xd4\x8a\xb4\x09\x88\x8a\xee\x72\x19\x60\x2a\xa2\x22\x67\xa1\x9f\x09\x29\xed\x9b\x42\xc3\x0b\xd9\x04\x05\xa5\xa2\xe1\x25\x6c\xaa\x86\x7d\x7d\x0b\x76\x72\xc7\x89\x03\x7f\xa7\xc8\x61\xa5\xd8\x05\x93\xf9\x74\xd0\x41\xc0\x60\xe6\x25\xbb\xb9\x61\xee\x0b\x43\x57\x1d\x8e\x62\x2c\x9d\xd0\x25\x08\xf8\xa0\xdd\x1d\xce\x2b\x28\xde\x78\x0f\xb3\xc0\xef\x0e\xb7\xec\x28\x8c\xd9\x05\x7e\xc4\xfc\x02\x7f\x01\x86\x81\x7f\x76\x33\x0b\x29\x7e\x14\xb7\xe0\xb2\xfb\xd9\x05\x97\xd9\xcb\x2f\xb0\xc8\x7e\x5e\x81\x25\x0e\x30\xcb\x0f\xe2\x15\xd2\x25\x8a\x59\x7e\x06\xaf\xe0\x56\x3e\xc0\x2c\x3b\x18\xc7\x61\x0b\x3b\x9d\x34\x2d\x55\xf7\x27\xa1\xb6\x47\xde\x9b\xfa\x99\xb8\x6c\x3e\x5f\x30\xa9\x20\x03\xc0\xc0\x5d\x59\xf3\xea\x28\x01\x4e\x26\xf0\x30\xe7\xd9\x6e\xc5\xdf\xbf\xd9\xcd\xec\x96\xe1\x4e\xc5\x5d\x62\xdc\x29\xb0\x43\x92\x47\x49\x87\xa3\xbb\x44\xb9\xe9\x10\x64\xa7\x40\x87\xd9\xc6\x42\x29\xf2\x3f\x4c\xe6\x74\xaf\xb4\x47\x43\x61\xaf\x6c\x1e\x10\x41\x42\xee\xe5\x1b\xcc\x35\x21\x0b\x1f\x66\x21\x87\x0a\xbf\xec\xe7\x21\x1f\xcf\x04\x15\x9c\x2f\xd0\x06\x5d\x02\x5e\x81\x6b\x7c\x4f\xb4\x5c\xcf\x1e\x11\x00\x67\x8c\xbf\x04\xc2\xfb\x74\x8f\x81\xad\xa2\x5d\x4e\x30\x56\xce\x5e\x66\x70\xbf\xfd\x77\xdf\xf9\x69\x9a\x02\xf0\xe4\xab\xa4\xeb\x30\x81\xc1\x29\x4c\x0e\x23\x7a\x13\xe6\xc1\xcf\x21\xd7\x1a\x80\xb2\xf0\x78\xf1\x49\x60\x0f\x9d\x9c\xe5\x76\x36\xe6\x69\x8d\x26\xac\x6c\x80\xf1\x10\x25\xce\x1d\x38\x5f\x92\xfe\x8c\x7a\xc2\xd8\xf3\x3d\xf5\x75\x87\x52\x89\xd4\x1e\x72\xe9\x01\xc9\x4f\xe6\xb9\x18\x21\x7a\xea\x61\x27\xa4\x5f\x89\xd6\x4a\x37\x5e\x89\xb3\x00\x0e\x43\x0b\xbf\x39\x71\x6a\xa3\x0c\x03\x67\xa8\xf9\x33\xbf\xad\xc7\x6d\xf4\x85\x75\x1e\x9c\x48\x2a\x40\x63\x29\x0a\xf7\xe4\x3b\x3e\xf0\xe1\xe5\x9c\xb3\x30\x1a\xf8\x01\x99\x13\x0e\xe5\x9b\x11\x74\x2b\xba\xb7\x3e\xa1\x51\x50\x98\x28\xf0\xb4\xc0\x37\xb0\xe2\x4c\x60\x4c\x0e\xd4\x0c\xf4\x80\xeb\x07\xb4\xa3\x02\x7e\x98\x1c\x83\xe8\x6c\xb2\x31\x65\x3e\xa4\x29\x11\x25\x4e\x11\x42\x61\xa0\x89\xcb\x8b\x65\x0b\x58\x54\x69\x98\x5c\x5b\x38\x0f\x59\x25\x4c\x78\x9d\xe4\xf8\x1e\x89\x82\x73\x26\x9d\x49\x9e\xed\x28\x52\x85\xa7\x2e\x38\x78\xb8\x21\x99\x48\x15\xfd\x53\xd4\x5f\x4b\xe5\xd6\x4f\xa2\xa2\xf3\x28\x2e\x2c\x95\x0d\xec\x97\x98\xba\xb2\x44\xd9\x53\xfd\x38\x46\x83\xde\x09\x55\x04\x62\x61\x06\xdb\xcd\xe4\x42\x7c\x24\x23\x59\x91\xb7\x28\x2b\x50\x58\xff\x1c\x0a\xf9\x1d\x95\x84\x69\xc0\x84\x44\x75\x01\x71\xd3\x3e\x1f\x28\xf1\x05\xcd\x60\xcc\xc3\x0d\x0c\x71\x58\xc2\xd3\x23\xe9\xdc\xfe\xbe\xfb\x1e\xf1\xc6\x60\x10\x33\xac\x7d\x87\x61\x4c\xd8\x27\xfa\x5b\xba\xc8\x15\xb2\xb9\xe8\x21\x52\x23\xb5\x73\x2f\xa0\x64\xb2\x30\x92\xa4\xc3\x80\x90\x4e\xb2\x17\x52\xaa\xc0\xa5\x47\xc5\xc3\x90\xa8\xf5\x68\x2f\x3c\x49\xe2\x53\xc9\x42\xf4\x78\x15\xc1\x2b\x4c\x88\x20\xc1\xc9\x15\x69\x4e\x70\x84\x0f\x8a\x40\x30\x38\xd5\xdc\x11\x7d\x30\x13\x0d\x98\x56\x06\xa7\xed\x23\x45\x13\x2e\x53\x30\x2c\x43\xde\x59\xba\xc5\x29\xa7\x60\xb1\x4c\x25\x93\xde\xe5\xc8\x16\x7e\x74\xba\x67\xfb\xce\x97\xe8\x19\x13\x80\x79\x9a\xe0\x61\x12\x9c\x95\x2c\x58\x30\xed\xe2\xdf\x60\x25\x74\x90\x78\xfb\xc7\xdf\x81\x78\xc9\xd0\xfe\xf2\xa2\xaf\xc7\x37\x0e\xfc\x1a\xb0\xd2\x61\xbf\x43\x7a\x7c\x00\x55\x38\x01\x7c\xd8\x45\x41\x77\xff\xe1\xf7\xa7\xee\x5e\xac\x82\x0b\xdb\x8e\x1e\xd8\xb8\x8b\x27\xa8\xd1\x4f\x61\xb9\xf1\x5c\xa7\x81\x69\x19\xfa\xe6\x47\x2d\xbe\xfe\x05\x75\x67\x4e\x4b\x9f\xd7\xa3\xa5\x5b\x57\x30\x66\x74\xa7\xe3\x23\xf2\x79\x92\xba\x6c\xeb\xfa\xcc\x4c\x30\x35\x94\xc0\x18\xde\x60\x0f\x93\x0d\xc3\x9c\x98\x30\x24\x0b\xa0\xf9\x99\x05\x85\x22\x07\xb7\x85\xe2\x3c\x07\x04\x87\x29\x2a\x7b\x36\x86\xaa\xa4\xc8\x77\x7b\x59\xa0\x0a\x8a\xb7\xd2\xce\xf6\x7a\x5e\x0e\x1f\xf1\xbf\xd1\x42\x03\x13\xdc\xc0\xf7\xc9\x42\x7b\xf5\x44\x12\x65\x3e\xb4\x6b\x06\xc9\x23\xec\x20\x4d\x07\x93\x46\xf8\x21\xce\x27\x3b\x7b\xe1\x11\x1e\x5a\x8f\xfb\x12\xae\x65\x8a\xac\xf9\x63\x42\xed\x5c\x49\x0e\x05\x12\x23\x52\xd0\xaf\x4a\xe3\xbc\x41\x40\x3f\xf2\x64\x0e\x0a\x7a\xf1\xec\x54\xdf\x9f\x42\x6a\xe3\x94\x2a\xc2\x01\x08\x61\xce\x4c\x1b\x02\xbc\x2d\xe8\x40\x75\x81\x33\x5e\x7d\x75\x43\x92\xff\x04\xeb\xf9\x4f\x06\xec\x70\x0a\xe3\xbb\x2b\x44\x01\x6d\x4a\xd4\x50\x34\xe3\x0e\xee\xfa\xf5\x57\x97\xaa\x9e\x5a\xf0\xb0\xce\xae\x4f\x30\x7f\x30\x35\x1a\x81\x20\xb3\x3d\x61\x86\x9f\x8e\xf5\x61\x87\x9c\x67\x73\xdd\xba\x17\xee\x8e\x0e\xd9\xd4\xfa\xd3\x24\xdb\x5a\x2e\x5a\xb8\xbc\x27\x1d\xd9\xff\x77\x82\xbf\xc7\x09\x1e\xe6\x23\x39\xec\x0d\xdf\xc1\x92\x5b\x5d\x57\x7b\xbc\x21\x8a\x1a\x58\x4a\x2c\x9c\x64\xe8\xd4\xb7\xde\x78\xd3\x45\xc1\x25\xf5\x1b\xbc\xef\x81\xd3\x4c\x09\xe6\x83\x46\x1b\xdc\x9c\x02\x56\xbf\xd3\xe8\xae\x0d\xb2\x85\xf6\x23\x1b\x4a\xed\x6e\x88\x03\x53\x51\x7b\x01\x6d\x0d\x64\x6b\x52\x5d\x18\xa6\x6e\x84\xb5\x85\x9c\x31\xf6\xed\x7e\xc8\xd2\xf3\xb5\xad\xe8\x26\xbc\xbb\xc9\xce\x15\xe7\x20\xee\xde\x09\x18\xf5\xd9\xbc\xfb\x91\x8f\xeb\x86\x3c\x96\x35\xd0\x87\x13\x52\x12\x02\x1e\x32\x71\x17\x8d\x04\xce\xb6\x77\x02\x0f\x33\x48\x00\x5f\x96\xfa\x84\x54\x98\x93\x53\xa2\xb3\xc1\x58\xb4\x7f\xe0\x9c\xeb\x14\xb0\xe7\x70\x60\x96\x3e\xf3\xc2\x9a\x88\x50\x5a\x79\x81\xed\xa4\x27\xbc\xaa\xc0\x1d\xb6\xa6\x2e\x70\x4a\x18\x3d\xf7\xa7\xd5\xb2\x29\xae\xc2\xea\xf6\x52\x86\xa8\x1e\xf9\xcd\xf4\x02\x8f\x78\x2a\x79\x2a\xe0\xa3\xfd\xd1\x04\x7a\x19\xc7\x21\x09\x76\xf6\x32\x47\xba\xf8\x37\xe9\x43\x21\x50\xc3\x09\xf3\x94\x02\x28\x9e\xcb\x53\x9c\x6b\x0c\x28\xa1\xe5\xd4\xc0\x37\xef\x1c\x6c\xc2\xc7\x36\x4e\x13\xa6\xc1\x1f\xd7\x82\xad\xd6\x2a\x30\x14\xe4\xd8\xfe\xa1\x27\xd0\x08\xd0\x0a\xa3\xbb\xc7\xb3\x26\x5a\xf0\x8e\x88\x9f\x30\x98\x02\x05\x39\x12\xa8\x61\xa0\xed\x26\x5b\x03\x82\xf7\xdd\x44\x3f\x23\x3a\x91\x0c\x62\x38\x7a\xc8\xbe\x04\x94\xbc\xbc\x88\x90\x1f\xf0\x22\xc9\x60\xe9\x4b\xef\x34\x84\x3e\x18\xd0\x80\xcf\x5f\x07\x3d\x2f\x41\xd3\x8d\xc0\x39\xa7\xa8\x4b\x5e\xed\xb3\x81\xf1\x92\x7b\x0e\x3b\x43\x96\x5f\xef\x77\x28\xe0\x65\xbe\x8b\xbe\x5c\x41\x4b\x1c\x16\xf4\xbd\xf4\x98\x14\x89\xdf\x91\x3b\x0e\x68\xf5\x34\xf5\x98\x44\xb0\xaf\xd1\x00\x45\x81\x52\x24\x6b\xe1\x34\xb5\x6f\xfd\x8a\xe3\x32\x36\x51\x81\x5a\x08\x28\x0a\xfe\x75\xc8\xe9\x2d\xf8\x3d\xf4\x44\x2a\x27\xad\x9c\x60\xc0\x55\x84\x00\x8a\xb5\x3d\x86\xb0\x08\x8d\xe3\x48\x8b\x8b\x7e\x98\xb8\xde\x9e\x47\x8f\x9c\xd4\xde\x5a\xf4\x7a\x40\x2e\x53\x3a\xf1\x2a\x6f\x14\x11\x02\xe3\x87\xcf\x2c\x86\x8e\x1f\xfe\x44\x86\x0d\x3d\x5c\x44\xd0\x1f\x67\xe0\xd0\xd3\x77\x8c\x17\xaa\x4f\x0f\x18\x75\x82\xf2\x98\x81\x42\xc5\x8f\x1b\x28\x5c\xf4\xc3\x03\x85\xaf\x49\x39\x72\x7c\x50\xe1\x43\xc3\x82\x0a\x05\x86\x03\x2e\xd4\x3b\x86\x03\x7f\x22\xc3\x81\x1e\x2e\x22\xe8\x8f\x33\x1c\xe8\xe9\x3b\x86\x03\xd5\xa7\x87\x03\x37\x79\xf4\x70\xa0\xe2\xc7\x0d\x07\x2e\xfa\xe1\xe1\x40\xd5\x8f\x1d\x0e\x54\xf8\xd0\x70\xa0\x42\x81\xe1\xe0\x66\x72\x8d\xa4\x1a\xde\x31\x2a\xa0\x44\x5c\x70\x8a\x90\xd1\x71\x5e\x5c\x44\x9c\x9f\xce\x28\x79\x6a\x7c\xc7\x68\x39\x30\xe8\x11\xf3\x20\x7c\xf4\xc0\xd1\xb5\x8e\x1b\x3f\x4f\x8d\x0f\x0f\xa3\x87\x14\xc7\x0e\xa7\xa7\xd2\xa1\x61\xa5\xf1\x0c\x8c\xae\x13\xc6\x77\xc1\xfc\x8d\x22\x50\x4d\x14\xe2\xf7\xfb\x37\xca\x9f\x40\x47\xfa\xbd\x31\xa3\x0d\x98\xb4\x7f\x7f\x0a\x0b\x18\xc3\x01\x7c\x38\x4f\x51\x1d\xde\x92\x0a\x8c\x39\xbf\x69\xe5\x40\x8b\x81\x16\x99\x13\xba\x21\xc8\x56\xd0\x97\x28\x0a\x15\x52\x88\xb4\xc6\x60\x05\x5e\x34\x60\x06\x53\xc6\x5b\xc5\xd3\x18\xb0\xca\xd0\xe5\xac\xc2\xe9\xdf\xbb\x22\x96\xbc\xc8\xda\x17\x4e\xf5\x65\x55\xdc\x8b\xe9\x99\x7b\x3f\x1e\xdc\x3e\xf0\x52\x88\x86\xf2\xc6\xa8\xe6\x91\x8d\x4f\x39\x43\x3d\xd0\xe8\x6d\xb9\xdb\xf4\xb6\x05\x2b\xbd\xed\x6c\x60\x37\xcb\x40\xc0\x71\x38\xb8\xb6\xb6\x6e\xb7\x14\x64\x09\x8e\x7f\x05\x7c\x0c\x27\xbb\xc7\xab\x44\xde\x52\x87\xc1\x47\xe4\xa2\xca\x11\x0a\xae\x40\x77\x54\x8e\xa8\xa8\xd9\x51\x02\x65\xb9\x7a\xfb\xfb\x48\xae\xb6\x9b\xb0\x31\xfc\xbb\x42\x5e\x20\xc0\xe4\x37\x49\x93\x71\xc6\x44\x4f\x01\x60\x9b\xdf\x9d\xaf\x54\x0a\xdf\xc0\xba\x62\x70\xaa\x78\x8f\x6f\x74\x40\x81\xc5\x97\xd0\x8b\xfb\x99\x23\x59\xe2\x2f\x22\x7f\x8d\x14\x4e\x7b\x8d\xc0\x8b\x8c\xe1\x2a\x8f\xee\x87\x33\xe0\x81\x44\x11\xb1\x9e\x01\xc5\x17\x77\xe9\xb7\x53\xa0\x2d\x80\xf1\x3d\xca\xea\xc1\xe2\x18\xa2\x12\xd7\x25\x00\x0b\x7c\x86\x89\x08\xc8\xbd\x0e\x27\xd1\x2b\xf8\x89\xd1\x25\x60\x66\xd3\x16\x07\xaa\xd1\x96\x98\x3f\xdc\x6e\x9c\x04\xbe\xc2\x98\xd2\xe8\x0e\xd9\x4e\x4a\x84\x12\xc5\x37\xd4\xe4\x1d\x7d\xea\xdf\x6e\xf3\x3d\xfd\x33\x77\xf5\x0e\x8c\xa7\x8b\x24\x2e\xba\x6b\xdc\xc8\x57\x3b\x91\x04\xb9\x9a\x13\x5f\xd5\x75\x44\xf3\xa4\x59\x99\xa4\x1b\x82\x5c\x83\x48\x74\x86\x6e\xc6\x38\x0d\xb1\x8d\xb0\x27\x0f\xd0\x63\x9f\xea\x89\x0b\xb9\xdd\xfb\xb4\x47\xbe\xe0\xb2\x75\xc5\x14\x91\xd7\x3e\xe8\x69\xc2\x05\x1c\x0a\x75\x6d\x14\x38\x0b\xb1\x40\xd8\x70\xdb\x95\x60\xca\xf5\xd3\x83\xb2\x26\xdc\xdf\x79\x08\x91\x3d\x7d\xf0\x52\xd2\xc5\x98\x44\x7d\x83\x77\x70\x5e\x99\x33\x4e\x03\x13\x06\xfd\x09\x7a\x08\x3c\x48\x9d\xee\xc9\x90\x48\xb0\x22\xdc\xb2\x0f\x2b\xc0\xaf\xfb\xfa\x1b\x18\x6b\x78\x77\x99\x13\xed\x80\x61\xe2\x57\x88\xf1\x8f\x34\x43\x50\x0d\x47\x60\x55\x15\x19\xac\x46\x40\xcc\x0a\x22\x81\x0f\x45\x17\xfe\x15\x2e\xb8\xc8\xb7\x1d\xec\xff\x93\x3c\x2f\xf0\xae\x11\x0b\x5f\x43\x32\x5a\x58\x16\xbc\x0e\xd2\x2b\xd3\x76\xc3\x73\x2e\xc9\xdd\x01\x58\x5c\xc5\x0d\x0e\xfc\x27\xce\x17\xa2\x69\x39\xe0\xcf\x3c\x16\x29\xfa\xe6\xb9\x7c\x79\x0f\xaa\x04\xa2\x6d\x83\x87\x23\x4c\xdd\x1a\xed\x34\x74\xfa\x31\xc4\xc9\x7d\xe6\x61\x98\xc3\x25\xf3\x00\xda\xbb\x7c\x3f\xc7\xef\x43\x79\x9d\x0d\xbb\xf7\xea\xc8\xb5\xca\x1f\x3a\xca\x15\xd8\x98\x72\xbc\x30\xa1\x01\xcf\x61\x89\xa0\xc8\x86\x04\xc6\x82\xdc\x5b\x1d\x0c\xa8\x27\x57\x56\xcb\x1a\xcc\x28\x62\x8a\x66\x4f\xe4\x17\xc1\x44\x34\xae\xe7\x1c\x75\x7a\x9f\xc3\x9d\x02\x2a\x88\xef\x02\x1a\xba\xb9\x10\x12\xca\x1e\xfd\xd0\xa8\xf9\xbc\x18\xbb\x87\xad\x4b\xbb\x22\xbe\x7f\xdc\xd0\xf3\xf1\xd9\xe2\x43\x4c\x8e\xdd\xa8\x96\x3b\x37\x8e\xe1\xf0\xdd\x98\x52\xa6\xda\x3b\xd1\xc5\x86\xee\x6e\x34\xe1\x75\x98\xdf\x8f\x1f\x31\xfc\xdf\x89\x1b\xf6\x89\xec\xc6\x0d\xdd\x64\xfd\xdd\xb8\x11\x1f\xd1\xf1\xb8\xe1\x83\xbc\xf1\xd9\x31\x47\x7d\x7f\xca\xa6\x36\xc1\xee\x17\xcf\x05\xdc\xf8\xae\xcb\x0b\xe6\xdb\xb7\xc4\x1b\x09\x3b\xc6\x9f\xf0\x8d\xcc\x70\x83\x1b\x08\x70\x54\xc0\xf3\xc6\x5b\x18\x5f\xe1\x08\x45\xb9\x49\xc2\xfd\x60\xb4\xe5\xb7\x6f\xd4\xfb\x37\x7b\x1b\x05\xd7\x20\xd1\x8a\x7f\x25\xc0\x52\x09\x55\x1b\xb4\x3d\x4a\x12\xab\x9f\x10\xb4\x4e\xe1\xad\x70\x40\x8c\x00\xe5\xc1\xea\xc2\x35\xf9\x9c\x59\x81\xa5\x41\x5f\x25\xe0\x91\x5d\x18\x20\x82\xce\x0f\x30\x5e\xc8\xf8\xfe\x53\x82\x06\x18\x03\x54\xd3\x70\x5c\x17\xb6\x0a\xe0\x1e\x5c\x75\x2f\x49\x85\xfb\x0f\x8a\xcc\x41\x5d\x18\xf9\x44\x4c\x76\x04\xed\x33\x37\xe8\x96\x71\xc6\xf3\xfc\xb8\x7b\x30\x60\x32\x41\x32\x36\x3b\xcf\xb3\x38\x97\x4d\x06\x3f\xc2\xbb\x59\xcf\x42\x10\x75\x90\x43\xf9\xc2\x8f\xc1\xcb\x4d\xf1\xef\x47\xc9\x73\x9d\xf2\xae\x06\x0f\xb7\x73\x64\x03\x67\x76\x7e\x7e\xf4\x0a\xa7\xc9\x3f\xd4\x49\x9e\x1c\x99\x3d\xd8\x49\x37\xc7\xf2\x87\x3a\x89\x1b\x44\xad\xb1\xe7\xf4\x59\xdd\xdd\x0d\xfb\xcf\xf0\xbb\xed\x22\x3e\x23\x97\x1e\x07\x30\xd8\x9d\xee\x18\x1d\xec\x43\x75\x13\x24\xa4\xcc\x41\xc4\xce\x1d\x73\x4e\x67\x62\xfe\x12\x5e\x16\xa7\x65\x24\x37\x13\xbd\x9d\x1e\x20\xb0\x7d\x88\xf9\x20\x81\xdd\x14\x4a\xdf\x41\x60\xd4\x1a\x7b\x8e\xfe\xfc\xf1\xef\xa0\xee\xfe\xf4\x45\x88\xe2\x27\x1e\x32\xd2\x99\xa9\x5c\xaa\x87\x14\xf9\x83\xd0\x18\x67\xe4\x12\x98\x08\xdc\x79\x0e\x29\x17\x63\xa2\x11\x64\x9b\xe1\xd2\x70\x07\x1e\x14\x62\x38\xc6\x3e\x2f\x7e\x68\x84\x4c\x94\xdc\x26\x8e\xb3\xb3\x1c\x25\x87\xfc\xb9\x73\xbe\x63\xc0\xf0\xba\xf4\xc1\x91\x7a\x77\x6b\x28\xb7\xbb\xa3\x60\xfc\xa0\x26\xfd\x2c\x71\xe2\xdf\x47\x38\x4d\x98\xba\x2a\x9e\xc0\x37\x28\x8f\x22\xf8\x0b\x17\x97\x0e\xa0\xf8\x4a\x37\x04\x8a\x0d\xc8\x18\xa2\xeb\xa1\x71\xfe\x79\xa4\xec\x44\xf7\xf7\x0a\x5e\x4b\x15\x5f\xcc\x60\xea\xb7\xff\x82\x6e\xc1\x15\xf9\x11\x21\xb3\xa3\x63\xb0\x00\xf3\x48\xd0\x3d\x20\xa0\x49\x68\x5b\x1c\xc7\xa1\xfd\xc4\xde\x79\x22\xe9\xf0\x69\x6e\x18\x39\x07\x7b\x1a\xf2\xc9\x0e\x8b\x0b\x74\xb0\x05\xf4\x02\xdd\x60\xaa\xf8\x3b\x03\x50\xe2\x45\xc6\x8e\xe4\x3b\xb6\xb3\x63\x1c\x7e\xfb\xfd\x3d\x85\x80\x3a\x78\x65\xf4\xa2\xd9\x00\x1f\xde\x87\x9c\x7b\x9d\xe1\x47\xd0\xc2\xf1\xb4\xc7\xc8\x53\xef\xed\xca\x5f\x9c\x6c\xc9\x14\xa5\xaf\xc8\x15\xcd\x47\xad\x40\xf8\x28\xc8\x3e\xac\xdd\xa3\xc8\x07\x94\x8b\x1f\xa8\x63\xc1\x74\xed\x71\x18\xda\xab\xed\x45\xcd\x9b\x70\xff\x43\x72\xcf\x49\x0d\xbd\xb7\x21\x6f\xda\xf0\x0f\x35\x64\xa7\xcd\xdd\xdb\x8e\x27\x2b\xf3\x87\x9a\x21\x09\x4c\xf7\xb2\xa1\x9b\x5e\xf6\xc8\x45\xfd\xcc\xce\x8f\x8a\x97\x57\x9c\xa2\x74\x8e\x17\xe9\x03\xfc\x05\x77\x3f\x0e\x88\x25\x58\xe2\x27\xf1\xd7\x19\xda\xa5\xb4\xcb\xa0\xdf\x3b\x08\xf7\x3f\x7b\x71\xf4\x04\xa6\x9f\x3a\x7e\x90\xaf\x1e\xb3\x0e\x0a\x6c\xf7\x3e\xc8\x5f\x9c\xd3\xb1\xd4\x64\x46\x0b\x42\xa8\x48\x3d\x85\x21\xb4\x5e\xa1\xb4\xe3\x2a\x22\x78\x55\xac\x23\x0a\x35\x6e\xe9\xdc\x12\xec\x4b\xc4\xb9\xe4\x0c\x86\x9b\xcd\x5c\x63\xcc\x31\xc3\xd0\x79\xc7\xdf\xc0\xb7\x28\x9d\xca\x08\x13\xe9\x48\xa3\x17\x1b\x7a\x84\x21\x8c\x5f\xdc\x20\xff\xcf\xac\xc9\x1b\xf2\xcc\xba\x84\x48\x7c\x16\xe4\x25\x56\x98\x2f\x22\xc8\x11\xc8\x48\x9c\x20\x46\x18\x78\x32\x01\x26\x85\xbd\x88\xc4\x53\x11\x00\x43\x11\x2f\x22\x82\xcc\x01\xed\x23\xc2\xa0\x13\x13\xf8\x66\xd5\x8b\x08\x3c\x51\x14\x61\x64\xe1\x22\xe2\x0f\xc8\xbb\x44\x6d\x06\x1a\x88\x63\x30\xd8\x09\x19\x5f\xdb\xe5\xc2\x4a\x92\xad\x52\xa7\x44\x58\x19\xec\x59\xa3\x8a\x80\x42\x93\x9c\xb7\x0c\x12\xbb\x70\x2b\x6c\x92\xf3\x94\xc3\x0e\x53\x94\x05\xe5\x22\x82\x1f\x22\x76\x4d\x14\xc8\x19\x41\x04\x07\x18\x9b\xaa\xec\x80\x23\x04\x40\xa7\x52\x2f\x22\x55\x54\xee\xd2\xe3\xc5\x43\x1b\x09\x21\x64\xba\xfc\x27\x3a\xc7\xf5\x89\x6c\x31\xd0\xa8\xb0\xb8\x79\xaa\xa7\x2c\xe8\xea\xbe\x8e\x43\xcf\xae\xb7\xdb\x1c\x03\x5d\xcb\x17\x91\x48\x60\x7b\x90\x54\xf4\x85\x2f\x02\x8a\xc8\xea\xd8\xfe\xe8\x0b\x3c\x8c\x30\xa6\xc1\x43\x58\x9c\x62\xc1\x3f\x2c\xda\x49\x3c\x1e\x3d\x7c\xd2\x24\x72\x34\xbd\x47\x96\xc6\x80\xff\xdc\x10\xe4\x70\xda\x5f\x22\x7a\x1f\x20\x17\xf5\xe0\xfc\x24\x3f\x7e\x2c\xcb\x7b\xc2\x16\xff\x3f\xbf\xff\x9b\xf9\x9d\x2a\x12\x16\xc0\xe5\x47\x72\x92\xb9\x44\xbe\xcd\x73\x40\x97\x8c\xef\xdb\x42\x09\x42\x82\x04\x5c\x28\x5e\xac\x3d\x38\x86\xa0\xe0\x0b\x5a\x0a\x41\x01\x59\x43\x47\xa0\xe0\xc4\x88\xbd\x17\x85\x1d\x81\x36\x21\xa8\x94\x3b\x37\x8c\xe3\x2d\x3f\x02\x25\x0f\xe4\x63\x50\x9b\x79\xaa\x3b\xe1\x1c\xe8\x36\xf6\xb8\x0a\xcf\x4e\xa1\xf0\xaa\x7d\x75\xec\x10\x8e\xe3\xab\x38\x1b\xe2\xc7\x57\xb1\x63\x1b\xde\x5b\xe5\x5d\x68\xe1\x8d\xda\x7d\x15\x00\xfd\xbb\x76\xc4\x0e\xd9\x2a\x0b\x8c\xca\x67\x1c\xea\x4c\x43\xf6\xec\xbe\x41\xa8\x38\xbe\x79\x1f\x8f\xec\x0a\x45\x0d\x61\x12\x7b\xf3\x87\x41\xbb\x3f\x61\x5c\xb2\x1f\x38\x44\xc8\xcf\x17\xbe\xa9\xfe\x91\xa5\xe4\xe0\x5a\x67\xaf\x27\xe4\x68\x18\x13\xd8\x40\x8e\x5c\x3e\xc1\x57\xc8\x06\xf3\x2c\x6d\x1f\x83\x1e\xba\x8b\x0c\xdb\x00\x9a\x5d\x97\x03\xff\xe1\x0f\x3f\xae\x25\xef\xee\x32\xd5\x12\x61\x9d\x1f\xd9\x27\xcf\x06\xb3\xa7\x53\xf8\x8b\xbf\xad\xff\x82\x85\x1e\xd4\x04\xf2\x06\x2c\xf8\x80\x63\x2d\x15\x08\xaa\xff\x0b\x68\x83\x7c\x84\xd4\x44\x01\x00")
 
 func staticReport_templateHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -84,7 +104,7 @@ func staticReport_templateHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/report_template.html", size: 31308, mode: os.FileMode(420), modTime: time.Unix(1558255764, 0)}
+	info := bindataFileInfo{name: "static/report_template.html", size: 83156, mode: os.FileMode(420), modTime: time.Unix(1792151617, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -161,6 +181,7 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"static/comparison_template.html": staticComparison_templateHtml,
 	"static/report_template.html": staticReport_templateHtml,
 	"static/wappalyzer_fingerprints.json": staticWappalyzer_fingerprintsJson,
 }
@@ -206,6 +227,7 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"static": &bintree{nil, map[string]*bintree{
+		"comparison_template.html": &bintree{staticComparison_templateHtml, map[string]*bintree{}},
 		"report_template.html": &bintree{staticReport_templateHtml, map[string]*bintree{}},
 		"wappalyzer_fingerprints.json": &bintree{staticWappalyzer_fingerprintsJson, map[string]*bintree{}},
 	}},
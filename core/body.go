@@ -0,0 +1,68 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// How response bodies are kept in the html folder, set with --save-body.
+// Agents like the page title extractor and technology fingerprinter read
+// bodies from there, so with SaveBodyNone the sampled bodies are written
+// for the scan and removed once the pages have been analyzed.
+const (
+	SaveBodyFull   = "full"
+	SaveBodySample = "sample"
+	SaveBodyNone   = "none"
+)
+
+var saveBodyModes = []string{SaveBodyFull, SaveBodySample, SaveBodyNone}
+
+// ParseSaveBody returns the --save-body mode for the given value. true and
+// false are accepted for full and none, which --save-body used to take.
+func ParseSaveBody(s string) (string, error) {
+	switch strings.ToLower(s) {
+	case "true":
+		return SaveBodyFull, nil
+	case "false":
+		return SaveBodyNone, nil
+	}
+	for _, mode := range saveBodyModes {
+		if strings.EqualFold(s, mode) {
+			return mode, nil
+		}
+	}
+	return "", fmt.Errorf("Invalid value %q for --save-body (valid values: %s)", s, strings.Join(saveBodyModes, ", "))
+}
+
+// SampleBody returns the part of a response body that is written to the
+// html folder, and whether it was cut short. Only the first
+// --body-sample-size KB are kept unless full bodies are saved, which is
+// enough for titles and page structures. Bodies are sampled regardless while
+// disk space is low.
+func (s *Session) SampleBody(body []byte) ([]byte, bool) {
+	if *s.Options.SaveBody == SaveBodyFull && !s.DiskSpaceLow() {
+		return body, false
+	}
+	size := *s.Options.BodySampleSize * 1024
+	if len(body) <= size {
+		return body, false
+	}
+	return body[:size], true
+}
+
+// RemoveBodies removes the body files of all pages, for scans with
+// --save-body none once the bodies are no longer needed.
+func (s *Session) RemoveBodies() {
+	s.Lock()
+	defer s.Unlock()
+	for _, page := range s.Pages {
+		if page.BodyPath == "" {
+			continue
+		}
+		if err := s.RemoveFile(page.BodyPath); err != nil {
+			s.Out.Debug("Unable to remove body file %s: %v\n", page.BodyPath, err)
+		}
+		page.BodyPath = ""
+		page.BodySampled = false
+	}
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// Classes of pages set by ClassifyPages.
+const (
+	ClassLogin   = "login"
+	ClassPortal  = "portal"
+	ClassError   = "error"
+	ClassParking = "parking"
+	ClassContent = "content"
+)
+
+// PageClasses lists the classes of pages in order of precedence, which is
+// used when a page scores the same for several classes.
+var PageClasses = []string{ClassLogin, ClassPortal, ClassParking, ClassError, ClassContent}
+
+// A page needs at least this score to get a class other than content.
+const minClassScore = 3
+
+// classSignal adds weight to the score of a class when pattern matches.
+type classSignal struct {
+	class   string
+	pattern *regexp.Regexp
+	weight  int
+}
+
+var titleClassSignals = []classSignal{
+	{ClassLogin, regexp.MustCompile(`(?i)\b(log ?in|sign ?in|log ?on|sign ?on|sso|single sign|authenticat\w*|anmelden|connexion|iniciar sesi)`), 3},
+	{ClassPortal, regexp.MustCompile(`(?i)\b(dashboard|admin\w*|portal|console|control panel|management|manager|webmail|intranet|router|configuration|cpanel|plesk|jenkins|grafana|kibana|phpmyadmin|gitlab|jira|confluence)\b`), 3},
+	{ClassError, regexp.MustCompile(`(?i)(\b(400|401|403|404|500|502|503|504)\b|not found|forbidden|\berror\b|unavailable|bad gateway|bad request|access denied|gateway time-?out|default (web )?page|test page|welcome to nginx|it works!|iis windows server|apache2 \w+ default)`), 3},
+	{ClassParking, regexp.MustCompile(`(?i)(for sale|parked|domain parking|buy this domain|this domain|domain name|under construction|coming soon)`), 3},
+}
+
+var bodyClassSignals = []classSignal{
+	{ClassLogin, regexp.MustCompile(`(?i)forgot (your )?password|reset (your )?password|remember me|keep me (signed|logged) in`), 2},
+	{ClassLogin, regexp.MustCompile(`(?i)\b(username|e-?mail address|one-time (password|code)|two-factor|2fa)\b`), 1},
+	{ClassPortal, regexp.MustCompile(`(?i)\b(dashboard|log ?out|sign ?out|control panel|administration)\b`), 1},
+	{ClassError, regexp.MustCompile(`(?i)(stack trace|traceback \(most recent call|unhandled exception|page (you requested )?(could not be|was not|cannot be) found|no such (file|bucket|host)|nosuchbucket|there isn't a github pages site here)`), 2},
+	{ClassParking, regexp.MustCompile(`(?i)(sedoparking|parkingcrew|bodis\.com|above\.com/|parklogic|dan\.com|hugedomains|afternic|domain (is|may be) for sale|buy this domain|parked (free|domain)|this domain (has been|is) parked)`), 3},
+}
+
+// portalCategories are categories of technologies that mostly run
+// management interfaces.
+var portalCategories = map[string]bool{
+	"Hosting Panels":    true,
+	"Web Mail":          true,
+	"Remote Access":     true,
+	"Database Managers": true,
+	"Build CI Systems":  true,
+	"Control Systems":   true,
+	"Network Devices":   true,
+	"Network Storage":   true,
+	"Issue Trackers":    true,
+}
+
+var passwordInput = regexp.MustCompile(`(?i)<input[^>]+type\s*=\s*["']?password`)
+
+// ClassifyPage returns the class of a page: login for login pages, portal
+// for management interfaces and dashboards, error for error and default
+// pages, parking for parked domains and content for everything else. Pages
+// are scored for each class from weighted signals in their status, title,
+// forms, technologies and body, which may be nil.
+func ClassifyPage(page *Page, body []byte) string {
+	scores := make(map[string]int)
+
+	hasPassword := passwordInput.Match(body)
+	for _, form := range page.Forms {
+		hasPassword = hasPassword || form.HasPassword
+	}
+	if hasPassword {
+		scores[ClassLogin] += 5
+	}
+
+	if code, err := strconv.Atoi(pageStatusCode(page)); err == nil {
+		switch {
+		case code == 401 || code == 407:
+			scores[ClassLogin] += 4
+		case code >= 400:
+			scores[ClassError] += 4
+		}
+	}
+
+	for _, signal := range titleClassSignals {
+		if signal.pattern.MatchString(page.PageTitle) {
+			scores[signal.class] += signal.weight
+		}
+	}
+	for _, signal := range bodyClassSignals {
+		if signal.pattern.Match(body) {
+			scores[signal.class] += signal.weight
+		}
+	}
+
+	for _, tech := range page.Technologies {
+		for _, category := range tech.Categories {
+			if portalCategories[category] {
+				scores[ClassPortal] += 3
+				break
+			}
+		}
+	}
+
+	class, best := ClassContent, minClassScore-1
+	for _, c := range PageClasses {
+		if scores[c] > best {
+			class, best = c, scores[c]
+		}
+	}
+	return class
+}
+
+// ClassifyPages sets the class of every page with ClassifyPage, reading the
+// saved bodies, and returns the number of pages in each class.
+func (s *Session) ClassifyPages() map[string]int {
+	counts := make(map[string]int)
+	for _, page := range s.Pages {
+		var body []byte
+		if page.BodyPath != "" {
+			body, _ = s.ReadFile(page.BodyPath)
+		}
+		page.Class = ClassifyPage(page, body)
+		counts[page.Class]++
+	}
+	return counts
+}
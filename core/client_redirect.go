@@ -0,0 +1,127 @@
+package core
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Kinds of client-side redirects found by FindClientRedirect.
+const (
+	ClientRedirectMetaRefresh = "meta-refresh"
+	ClientRedirectJavaScript  = "javascript"
+)
+
+// ClientRedirect is a redirect done by a page itself, with a meta refresh
+// tag or by assigning a URL to location in JavaScript, which was followed to
+// screenshot and analyze the page it leads to.
+type ClientRedirect struct {
+	URL    string `json:"url"`
+	Kind   string `json:"kind"`
+	Status string `json:"status"`
+}
+
+// Meta refresh tags with a longer delay are meant to let the page be read
+// first, so the page is kept as it is.
+const maxMetaRefreshDelay = 5
+
+// JavaScript redirects are only looked for in bodies of at most this many
+// bytes. Larger pages have content of their own, and assigning to location
+// is then rather part of some navigation than an instant redirect.
+const maxJavaScriptRedirectBodySize = 4096
+
+var (
+	noscriptElement    = regexp.MustCompile(`(?is)<noscript[^>]*>.*?</noscript>`)
+	metaRefreshTag     = regexp.MustCompile(`(?is)<meta\s[^>]*http-equiv\s*=\s*["']?refresh\b[^>]*>`)
+	metaContentAttr    = regexp.MustCompile(`(?is)\scontent\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+	metaRefreshContent = regexp.MustCompile(`(?is)^\s*(\d+)[\d.]*\s*(?:[;,]\s*(?:url\s*=\s*)?(.*))?$`)
+	javaScriptRedirect = regexp.MustCompile(`(?:\blocation(?:\.href)?\s*=\s*|\blocation\.(?:replace|assign)\(\s*)["']([^"'\s]+)["']`)
+)
+
+// FindClientRedirect returns the URL a page at pageURL instantly redirects
+// to by itself with a meta refresh tag or a trivial JavaScript location
+// assignment in its body, and the kind of redirect. It returns empty strings
+// if there is no such redirect, or if it leads to the page itself or away
+// from HTTP(S).
+func FindClientRedirect(pageURL string, body []byte) (string, string) {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return "", ""
+	}
+	// Redirects in noscript elements are for browsers without JavaScript,
+	// while the page works in Chrome
+	content := noscriptElement.ReplaceAll(body, nil)
+
+	if target := metaRefreshTarget(content); target != "" {
+		if u := resolveClientRedirect(base, target); u != "" {
+			return u, ClientRedirectMetaRefresh
+		}
+	}
+	if len(content) <= maxJavaScriptRedirectBodySize {
+		if match := javaScriptRedirect.FindSubmatch(content); match != nil {
+			if u := resolveClientRedirect(base, string(match[1])); u != "" {
+				return u, ClientRedirectJavaScript
+			}
+		}
+	}
+	return "", ""
+}
+
+// metaRefreshTarget returns the URL of the first meta refresh tag that
+// redirects within maxMetaRefreshDelay seconds.
+func metaRefreshTarget(body []byte) string {
+	for _, tag := range metaRefreshTag.FindAll(body, -1) {
+		attr := metaContentAttr.FindSubmatch(tag)
+		if attr == nil {
+			continue
+		}
+		value := string(attr[1]) + string(attr[2]) + string(attr[3])
+		match := metaRefreshContent.FindStringSubmatch(value)
+		if match == nil {
+			continue
+		}
+		if delay, _ := strconv.Atoi(match[1]); delay > maxMetaRefreshDelay {
+			continue
+		}
+		if target := strings.Trim(strings.TrimSpace(match[2]), `"'`); target != "" {
+			return target
+		}
+	}
+	return ""
+}
+
+func resolveClientRedirect(base *url.URL, target string) string {
+	ref, err := url.Parse(strings.TrimSpace(target))
+	if err != nil {
+		return ""
+	}
+	u := base.ResolveReference(ref)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ""
+	}
+	u.Fragment = ""
+	page := *base
+	page.Fragment = ""
+	if u.String() == page.String() {
+		return ""
+	}
+	return u.String()
+}
+
+// AddClientRedirect records a client-side redirect that was followed from
+// the page.
+func (p *Page) AddClientRedirect(redirect ClientRedirect) {
+	p.Lock()
+	defer p.Unlock()
+	p.ClientRedirects = append(p.ClientRedirects, redirect)
+}
+
+// DestinationURL returns the URL the page ends up at after its client-side
+// redirects, which is the URL that is screenshotted.
+func (p *Page) DestinationURL() string {
+	if len(p.ClientRedirects) == 0 {
+		return p.URL
+	}
+	return p.ClientRedirects[len(p.ClientRedirects)-1].URL
+}
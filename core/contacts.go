@@ -0,0 +1,73 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+const (
+	ContactEmail     = "email"
+	ContactTwitter   = "twitter"
+	ContactLinkedIn  = "linkedin"
+	ContactGitHub    = "github"
+	ContactFacebook  = "facebook"
+	ContactInstagram = "instagram"
+)
+
+// Contact is an email address or social media handle found on a page.
+type Contact struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func (c Contact) String() string {
+	if c.Type == ContactEmail {
+		return c.Value
+	}
+	return fmt.Sprintf("%s:%s", c.Type, c.Value)
+}
+
+// Link returns a URL for the contact.
+func (c Contact) Link() string {
+	handle := strings.TrimPrefix(c.Value, "@")
+	switch c.Type {
+	case ContactEmail:
+		return "mailto:" + c.Value
+	case ContactTwitter:
+		return "https://twitter.com/" + handle
+	case ContactLinkedIn:
+		return "https://www.linkedin.com/" + handle
+	case ContactGitHub:
+		return "https://github.com/" + handle
+	case ContactFacebook:
+		return "https://www.facebook.com/" + handle
+	case ContactInstagram:
+		return "https://www.instagram.com/" + handle
+	}
+	return ""
+}
+
+// SaveContactsToFile writes the deduplicated contacts found on all pages,
+// one per line.
+func (s *Session) SaveContactsToFile(filename string) error {
+	seen := make(map[string]struct{})
+	for _, page := range s.Pages {
+		for _, contact := range page.Contacts {
+			seen[contact.String()] = struct{}{}
+		}
+	}
+
+	var lines []string
+	for line := range seen {
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+
+	content := strings.Join(lines, "\n")
+	if len(lines) > 0 {
+		content += "\n"
+	}
+	return ioutil.WriteFile(s.GetFilePath(filename), []byte(content), 0644)
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultPage is a known default, install or debug page. Pages are matched
+// on SHA-256 hashes of their body or of one of their inline style and script
+// blocks, with runs of whitespace collapsed to a single space, so a match
+// holds up when the title or text around the block was changed.
+type DefaultPage struct {
+	Name string
+	Type string
+}
+
+// KnownDefaultPages maps hashes from DefaultPageHashes to the default pages
+// they belong to.
+var KnownDefaultPages = map[string]DefaultPage{
+	// htdocs/index.html of Apache httpd 2.x
+	"8f3ff2e2482468f3b9315a433b383f0cc0f9eb525889a34d4703b7681330a3fb": {"Apache Default Page", "info"},
+	// html/index.html of nginx 1.21 and later, and its style block
+	"efe2bf5f556ed5c2c907d347641beb4b95c57c896383768a2b0645cf0b914b63": {"Nginx Default Page", "info"},
+	"7d579cec119dacf032c5670a2387ae6fd9bc52137e0866f65e9c7ed6a2fd05d6": {"Nginx Default Page", "info"},
+	// html/index.html of nginx before 1.21, and its style block
+	"ff144df2f5614130717b65fed02d439879e931949f4a5c1d990c2d9bd9584bf0": {"Nginx Default Page", "info"},
+	"d328799025da6653d46ed0139cee0ffa86ba90f6b7afa6e7d84be4527a2dee70": {"Nginx Default Page", "info"},
+}
+
+var inlineBlockPattern = regexp.MustCompile(`(?is)<(?:style|script)\b[^>]*>(.*?)</(?:style|script)\s*>`)
+
+// DefaultPageHashes returns the hashes a body is looked up with in
+// KnownDefaultPages: the hash of the body with whitespace collapsed,
+// followed by the hashes of its inline style and script blocks.
+func DefaultPageHashes(body []byte) []string {
+	hashes := []string{normalizedHash(string(body))}
+	for _, match := range inlineBlockPattern.FindAllSubmatch(body, -1) {
+		if block := strings.TrimSpace(string(match[1])); block != "" {
+			hashes = append(hashes, normalizedHash(block))
+		}
+	}
+	return hashes
+}
+
+func normalizedHash(s string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(strings.Fields(s), " "))))
+}
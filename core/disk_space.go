@@ -0,0 +1,90 @@
+package core
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// diskSpaceInterval is how often free disk space on the output volume is
+// checked during a scan.
+const diskSpaceInterval = 10 * time.Second
+
+// States of free disk space on the output volume. When space is low,
+// screenshots are saved as JPEG and only the start of response bodies is
+// saved.
+// When it is critical, writing files pauses until space is freed.
+const (
+	DiskSpaceOK int32 = iota
+	DiskSpaceLow
+	DiskSpaceCritical
+)
+
+var errDiskSpaceUnsupported = errors.New("checking free disk space is not supported on this platform")
+
+// MonitorDiskSpace checks free disk space on the output volume against
+// --min-free-space in the background. Space is critical below a quarter of
+// the minimum.
+func (s *Session) MonitorDiskSpace() {
+	if *s.Options.MinFreeSpace <= 0 {
+		return
+	}
+	if err := s.checkDiskSpace(); err != nil {
+		s.Out.Debug("Not monitoring free disk space: %v\n", err)
+		return
+	}
+	go func() {
+		for range time.Tick(diskSpaceInterval) {
+			if err := s.checkDiskSpace(); err != nil {
+				s.Out.Debug("Unable to check free disk space: %v\n", err)
+			}
+		}
+	}()
+}
+
+func (s *Session) checkDiskSpace() error {
+	free, err := freeDiskSpace(s.GetFilePath(""))
+	if err != nil {
+		return err
+	}
+	freeMB := int64(free / 1024 / 1024)
+	min := int64(*s.Options.MinFreeSpace)
+
+	state := DiskSpaceOK
+	if freeMB < min/4 {
+		state = DiskSpaceCritical
+	} else if freeMB < min {
+		state = DiskSpaceLow
+	}
+	previous := atomic.SwapInt32(&s.diskSpace, state)
+	if state == previous {
+		return nil
+	}
+
+	switch state {
+	case DiskSpaceCritical:
+		s.Out.Warn("Only %d MB of disk space left on %s: pausing the scan until at least %d MB are free\n", freeMB, *s.Options.OutDir, min/4)
+	case DiskSpaceLow:
+		if previous == DiskSpaceCritical {
+			s.Out.Warn("%d MB of disk space free on %s: resuming the scan\n", freeMB, *s.Options.OutDir)
+		}
+		s.Out.Warn("Only %d MB of disk space left on %s: saving screenshots as JPEG and only the start of response bodies\n", freeMB, *s.Options.OutDir)
+	case DiskSpaceOK:
+		s.Out.Info("%d MB of disk space free on %s: saving screenshots and response bodies as usual again\n", freeMB, *s.Options.OutDir)
+	}
+	return nil
+}
+
+// DiskSpaceLow reports whether free disk space on the output volume is
+// below --min-free-space.
+func (s *Session) DiskSpaceLow() bool {
+	return atomic.LoadInt32(&s.diskSpace) != DiskSpaceOK
+}
+
+// WaitForDiskSpace blocks while free disk space on the output volume is
+// critical. Agents call it before writing files.
+func (s *Session) WaitForDiskSpace() {
+	for atomic.LoadInt32(&s.diskSpace) == DiskSpaceCritical {
+		time.Sleep(diskSpaceInterval)
+	}
+}
@@ -0,0 +1,15 @@
+//go:build linux || darwin || freebsd
+
+package core
+
+import "syscall"
+
+// freeDiskSpace returns the number of bytes available to unprivileged
+// users on the volume of the given path.
+func freeDiskSpace(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// defaultDNSServer is used when no nameserver is configured in
+// /etc/resolv.conf, like on Windows.
+const defaultDNSServer = "127.0.0.1:53"
+
+// SystemDNSServer returns the address of the first nameserver in
+// /etc/resolv.conf, and whether there is one.
+func SystemDNSServer() (string, bool) {
+	f, err := os.Open("/etc/resolv.conf")
+	if err != nil {
+		return defaultDNSServer, false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			return net.JoinHostPort(fields[1], "53"), true
+		}
+	}
+	return defaultDNSServer, false
+}
+
+// QueryDNS sends a query for records of the given type to the nameserver at
+// the given address and returns the full response. The resolver in the net
+// package hides response codes and TTLs and does not let us ask for
+// arbitrary record types or ask a specific nameserver. Queries to
+// authoritative nameservers should not ask for recursion.
+func (s *Session) QueryDNS(server string, name string, qtype dnsmessage.Type, recursive bool, timeout time.Duration) (*dnsmessage.Message, error) {
+	qname, err := dnsmessage.NewName(dnsFQDN(name))
+	if err != nil {
+		return nil, err
+	}
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:               uint16(s.Random.Intn(1 << 16)),
+			RecursionDesired: recursive,
+		},
+		Questions: []dnsmessage.Question{{Name: qname, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	conn, err := s.Dialer.DialContext(ctx, "udp", server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(s.Clock.Now().Add(timeout))
+
+	if _, err := conn.Write(packed); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 4096)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		var response dnsmessage.Message
+		if err := response.Unpack(buf[:n]); err != nil {
+			return nil, err
+		}
+		// Ignore stray responses to earlier queries
+		if response.Header.ID != query.Header.ID || !response.Header.Response {
+			continue
+		}
+		return &response, nil
+	}
+}
+
+// dnsFQDN returns the name with a trailing dot.
+func dnsFQDN(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
@@ -0,0 +1,33 @@
+package core
+
+// Types of dangling DNS records. Classic takeovers of CNAMEs pointing to
+// unclaimed resources at third-party services are tagged on pages instead.
+const (
+	DNSFindingDanglingA    = "dangling-a"
+	DNSFindingDanglingMX   = "dangling-mx"
+	DNSFindingDanglingNS   = "dangling-ns"
+	DNSFindingExpiredCNAME = "expired-cname-domain"
+)
+
+// DNSFinding is a DNS record of a host that points to something that no
+// longer exists or is no longer in use, and could be claimed by someone
+// else.
+type DNSFinding struct {
+	Hostname string `json:"hostname"`
+	Type     string `json:"type"`
+	Record   string `json:"record"`
+	Detail   string `json:"detail"`
+}
+
+// AddDNSFinding records a dangling DNS record. Findings for the same record
+// are only recorded once.
+func (s *Session) AddDNSFinding(finding DNSFinding) {
+	s.Lock()
+	defer s.Unlock()
+	for _, f := range s.DNSFindings {
+		if f.Hostname == finding.Hostname && f.Type == finding.Type && f.Record == finding.Record {
+			return
+		}
+	}
+	s.DNSFindings = append(s.DNSFindings, finding)
+}
@@ -0,0 +1,86 @@
+package core
+
+import (
+	"crypto/x509"
+	"errors"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+)
+
+// Process exit codes used by Aquatone so wrapper scripts can react to
+// specific failure conditions without parsing console output.
+const (
+	ExitOK               = 0
+	ExitGeneric          = 1
+	ExitInvalidOptions   = 2
+	ExitNoTargets        = 3
+	ExitChromeMissing    = 4
+	ExitOutputDir        = 5
+	ExitFailureThreshold = 6
+	ExitFailCondition    = 7
+)
+
+// Failure reasons recorded for targets that could not be processed.
+const (
+	ReasonDNS               = "dns"
+	ReasonTimeout           = "timeout"
+	ReasonRefused           = "refused"
+	ReasonReset             = "reset"
+	ReasonTLS               = "tls"
+	ReasonTooLarge          = "too_large"
+	ReasonScreenshotFailed  = "screenshot_failed"
+	ReasonScreenshotTimeout = "screenshot_timeout"
+	ReasonUnknown           = "unknown"
+)
+
+type Failure struct {
+	Target string `json:"target"`
+	Stage  string `json:"stage"`
+	Reason string `json:"reason"`
+	Error  string `json:"error"`
+}
+
+// ClassifyError maps a network or request error to one of the failure
+// reason constants.
+func ClassifyError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return ReasonDNS
+	}
+
+	if os.IsTimeout(err) {
+		return ReasonTimeout
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ReasonTimeout
+	}
+
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return ReasonRefused
+	}
+	if errors.Is(err, syscall.ECONNRESET) {
+		return ReasonReset
+	}
+
+	var certErr x509.UnknownAuthorityError
+	var hostErr x509.HostnameError
+	if errors.As(err, &certErr) || errors.As(err, &hostErr) || strings.Contains(err.Error(), "tls:") {
+		return ReasonTLS
+	}
+
+	// Responses with headers, HTTP/2 frames or messages over the limits of
+	// the HTTP client
+	msg := err.Error()
+	if strings.Contains(msg, "too large") || strings.Contains(msg, "headers exceeded") || strings.Contains(msg, "message too long") {
+		return ReasonTooLarge
+	}
+
+	return ReasonUnknown
+}
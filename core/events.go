@@ -3,7 +3,7 @@ package core
 const (
 	SessionStart  = "session:start"
 	SessionEnd    = "session:end"
-	Host          = "host"
+	TargetHost    = "host"
 	URL           = "url"
 	URLResponsive = "url:responsive"
 	TCPPort       = "port:tcp"
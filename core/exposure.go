@@ -0,0 +1,196 @@
+package core
+
+import (
+	"bytes"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Kinds of files found with --probe-exposures, which are also the texts of
+// the tags of pages they are found on.
+const (
+	ExposureGit       = "Exposed Git Repository"
+	ExposureSVN       = "Exposed SVN Working Copy"
+	ExposureMercurial = "Exposed Mercurial Repository"
+	ExposureEnv       = "Exposed Environment File"
+	ExposureBackup    = "Exposed Backup"
+	ExposureDSStore   = "Exposed DS_Store File"
+	ExposureFile      = "Exposed File"
+)
+
+// ExposureSeverities are the severities of the notes for the kinds of
+// exposed files. Source control metadata, environment files and backups can
+// be downloaded in full and often hold credentials, a DS_Store file only
+// lists file names.
+var ExposureSeverities = map[string]string{
+	ExposureGit:       SeverityHigh,
+	ExposureSVN:       SeverityHigh,
+	ExposureMercurial: SeverityHigh,
+	ExposureEnv:       SeverityHigh,
+	ExposureBackup:    SeverityHigh,
+	ExposureDSStore:   SeverityLow,
+	ExposureFile:      SeverityMedium,
+}
+
+// ExposurePaths are the paths probed on every web server with
+// --probe-exposures, unless a list is given with --exposure-list.
+var ExposurePaths = []string{
+	"/.git/HEAD",
+	"/.git/config",
+	"/.svn/entries",
+	"/.svn/wc.db",
+	"/.hg/requires",
+	"/.env",
+	"/.env.local",
+	"/.env.production",
+	"/.DS_Store",
+	"/backup.zip",
+	"/backup.tar.gz",
+	"/backup.sql",
+	"/dump.sql",
+	"/database.sql",
+}
+
+// MaxExposureRead is the number of bytes of a file that are requested and
+// checked. It is enough to recognize a file by its start.
+const MaxExposureRead = 65536
+
+// Exposure is a file on a web server that shouldn't be public, like the
+// metadata of a source control working copy or a file with secrets. Evidence
+// is what the file was recognized by, with the values of environment
+// variables left out.
+type Exposure struct {
+	Kind     string `json:"kind"`
+	URL      string `json:"url"`
+	Size     int64  `json:"size,omitempty"`
+	Evidence string `json:"evidence,omitempty"`
+}
+
+var (
+	gitHeadPattern = regexp.MustCompile(`^(ref: refs/\S+|[0-9a-f]{40}|[0-9a-f]{64})\s*$`)
+	envLinePattern = regexp.MustCompile(`^\s*(?:export\s+)?([A-Za-z_][A-Za-z0-9_.]*)\s*=`)
+	hgRequirement  = regexp.MustCompile(`^[a-z0-9-]+$`)
+	sqlDumpPattern = regexp.MustCompile(`(?i)\b(create table|insert into|mysql dump|postgresql database dump|sqlite_sequence)\b`)
+)
+
+// Magic bytes at the start of backup archives, by file extension.
+var archiveMagic = map[string][]byte{
+	".zip": []byte("PK\x03\x04"),
+	".gz":  []byte("\x1f\x8b"),
+	".tgz": []byte("\x1f\x8b"),
+	".bz2": []byte("BZh"),
+	".xz":  []byte("\xfd7zXZ\x00"),
+	".7z":  []byte("7z\xbc\xaf\x27\x1c"),
+	".rar": []byte("Rar!\x1a\x07"),
+}
+
+// CheckExposure reports whether body, the response to a probe for path, is
+// the file the path is known for, and returns its kind and the evidence it
+// was recognized by. Only the first MaxExposureRead bytes are looked at.
+// Small metadata files that are larger than they can be are rejected, and
+// so are HTML pages, which are what catch-all pages and soft 404s answer
+// with. Files that can't be recognized by their content are only accepted
+// from servers that don't answer every path.
+func CheckExposure(p string, body []byte, size int64, catchAll bool) (string, string, bool) {
+	if len(body) > MaxExposureRead {
+		body = body[:MaxExposureRead]
+	}
+	if len(body) == 0 || looksLikeHTML(body) {
+		return "", "", false
+	}
+	name := path.Base(p)
+	ext := strings.ToLower(path.Ext(name))
+	if strings.HasSuffix(strings.ToLower(name), ".tar.gz") {
+		ext = ".gz"
+	}
+
+	switch {
+	case strings.Contains(p, "/.git/"):
+		switch name {
+		case "HEAD", "ORIG_HEAD", "FETCH_HEAD":
+			first := firstLine(body)
+			return ExposureGit, first, size <= 1024 && gitHeadPattern.MatchString(first)
+		case "config":
+			return ExposureGit, "[core]", bytes.Contains(body, []byte("[core]")) && bytes.Contains(body, []byte("repositoryformatversion"))
+		case "index":
+			return ExposureGit, "DIRC index", bytes.HasPrefix(body, []byte("DIRC"))
+		}
+		return ExposureGit, "", !catchAll
+	case strings.Contains(p, "/.svn/"):
+		switch name {
+		case "entries":
+			first := firstLine(body)
+			return ExposureSVN, "entries format " + first, size <= 1<<20 && isDigits(first)
+		case "wc.db":
+			return ExposureSVN, "SQLite database", bytes.HasPrefix(body, []byte("SQLite format 3\x00"))
+		}
+		return ExposureSVN, "", !catchAll
+	case strings.Contains(p, "/.hg/"):
+		if name == "requires" {
+			lines := strings.Fields(string(body))
+			for _, line := range lines {
+				if !hgRequirement.MatchString(line) {
+					return "", "", false
+				}
+			}
+			return ExposureMercurial, strings.Join(lines, " "), size <= 1024 && len(lines) > 0
+		}
+		return ExposureMercurial, "", !catchAll
+	case name == ".DS_Store":
+		return ExposureDSStore, "Bud1", len(body) >= 8 && bytes.Equal(body[4:8], []byte("Bud1"))
+	case name == ".env" || strings.HasPrefix(name, ".env."):
+		keys, ok := envKeys(body)
+		return ExposureEnv, strings.Join(keys, ", "), ok
+	case ext == ".sql":
+		match := sqlDumpPattern.Find(body)
+		return ExposureBackup, string(match), match != nil
+	case ext == ".tar":
+		return ExposureBackup, "tar archive", len(body) >= 262 && bytes.Equal(body[257:262], []byte("ustar"))
+	}
+	if magic, ok := archiveMagic[ext]; ok {
+		return ExposureBackup, strings.TrimPrefix(ext, ".") + " archive", bytes.HasPrefix(body, magic)
+	}
+	return ExposureFile, "", !catchAll
+}
+
+// envKeys returns the names of the variables set in a .env file. It returns
+// false unless most lines that aren't comments set a variable.
+func envKeys(body []byte) ([]string, bool) {
+	var keys []string
+	lines := 0
+	for _, line := range strings.Split(string(body), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines++
+		if match := envLinePattern.FindStringSubmatch(line); match != nil {
+			keys = append(keys, match[1])
+		}
+	}
+	return keys, len(keys) > 0 && len(keys)*2 >= lines
+}
+
+func firstLine(body []byte) string {
+	return strings.TrimSpace(strings.SplitN(string(body), "\n", 2)[0])
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// AddExposure records a file found exposed on the web server of the page.
+func (p *Page) AddExposure(exposure Exposure) {
+	p.Lock()
+	defer p.Unlock()
+	p.Exposures = append(p.Exposures, exposure)
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// What the extract subcommand lists from the pages of a session.
+const (
+	ExtractURLs         = "urls"
+	ExtractHosts        = "hosts"
+	ExtractTechnologies = "technologies"
+	ExtractHeaders      = "headers"
+)
+
+var extractNames = []string{ExtractURLs, ExtractHosts, ExtractTechnologies, ExtractHeaders}
+
+func isExtractName(name string) bool {
+	for _, n := range extractNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Extract returns the URLs, hostnames, technologies or headers of the pages
+// in the session that match the filter, sorted and without duplicates.
+// Headers are returned as "Name: Value" lines.
+func (s *Session) Extract(what string, filter *Filter) ([]string, error) {
+	if !isExtractName(what) {
+		return nil, fmt.Errorf("Unknown value to extract %q (valid values: %s)", what, strings.Join(extractNames, ", "))
+	}
+
+	seen := make(map[string]bool)
+	for _, page := range s.Pages {
+		if !filter.Match(page) {
+			continue
+		}
+		switch what {
+		case ExtractURLs:
+			seen[page.URL] = true
+		case ExtractHosts:
+			seen[page.Hostname] = true
+		case ExtractTechnologies:
+			for _, tech := range page.Technologies {
+				seen[tech.Name] = true
+			}
+		case ExtractHeaders:
+			for _, header := range page.Headers {
+				seen[header.Name+": "+header.Value] = true
+			}
+		}
+	}
+
+	values := []string{}
+	for value := range seen {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	sort.Strings(values)
+	return values, nil
+}
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	FailOnTakeover         = "takeover"
+	FailOnDanglingDNS      = "dangling-dns"
+	FailOnNewHost          = "new-host"
+	FailOnFailed           = "failed"
+	FailOnScreenshotFailed = "screenshot-failed"
+	FailOn2xx              = "2xx"
+	FailOn3xx              = "3xx"
+	FailOn4xx              = "4xx"
+	FailOn5xx              = "5xx"
+)
+
+var failConditionNames = []string{
+	FailOnTakeover, FailOnDanglingDNS, FailOnNewHost, FailOnFailed, FailOnScreenshotFailed,
+	FailOn2xx, FailOn3xx, FailOn4xx, FailOn5xx,
+}
+
+// FailCondition is a condition given with --fail-on. The condition is met
+// when the value of the named metric is greater than Threshold.
+type FailCondition struct {
+	Name      string
+	Threshold int
+}
+
+func (c FailCondition) String() string {
+	return fmt.Sprintf("%s>%d", c.Name, c.Threshold)
+}
+
+// ParseFailConditions parses a comma separated list of conditions like
+// "takeover,new-host,5xx>10".
+func ParseFailConditions(s string) ([]FailCondition, error) {
+	var conditions []FailCondition
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		condition := FailCondition{Name: part}
+		if i := strings.Index(part, ">"); i != -1 {
+			threshold, err := strconv.Atoi(strings.TrimSpace(part[i+1:]))
+			if err != nil || threshold < 0 {
+				return nil, fmt.Errorf("Invalid threshold in fail condition %q", part)
+			}
+			condition.Name = strings.TrimSpace(part[:i])
+			condition.Threshold = threshold
+		}
+
+		if !isFailConditionName(condition.Name) {
+			return nil, fmt.Errorf("Unknown fail condition %q (valid conditions: %s)", condition.Name, strings.Join(failConditionNames, ", "))
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+func isFailConditionName(name string) bool {
+	for _, n := range failConditionNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// FailConditionValue returns the current value of the metric for the named
+// condition. The previous session is used for comparisons and may be nil.
+func (s *Session) FailConditionValue(name string, previous *Session) int {
+	switch name {
+	case FailOnTakeover:
+		count := 0
+		for _, page := range s.Pages {
+			if page.HasTag("Domain Takeover") {
+				count++
+			}
+		}
+		return count
+	case FailOnDanglingDNS:
+		return len(s.DNSFindings)
+	case FailOnNewHost:
+		if previous == nil {
+			return 0
+		}
+		known := make(map[string]struct{})
+		for _, page := range previous.Pages {
+			known[page.Hostname] = struct{}{}
+		}
+		seen := make(map[string]struct{})
+		for _, page := range s.Pages {
+			if _, ok := known[page.Hostname]; ok {
+				continue
+			}
+			seen[page.Hostname] = struct{}{}
+		}
+		return len(seen)
+	case FailOnFailed:
+		return int(s.Stats.RequestFailed)
+	case FailOnScreenshotFailed:
+		return int(s.Stats.ScreenshotFailed)
+	case FailOn2xx:
+		return int(s.Stats.ResponseCode2xx)
+	case FailOn3xx:
+		return int(s.Stats.ResponseCode3xx)
+	case FailOn4xx:
+		return int(s.Stats.ResponseCode4xx)
+	case FailOn5xx:
+		return int(s.Stats.ResponseCode5xx)
+	}
+	return 0
+}
+
+// MetFailConditions returns the conditions given with --fail-on that are met
+// by the session.
+func (s *Session) MetFailConditions(previous *Session) []FailCondition {
+	var met []FailCondition
+	for _, condition := range s.FailConditions {
+		if s.FailConditionValue(condition.Name, previous) > condition.Threshold {
+			met = append(met, condition)
+		}
+	}
+	return met
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// FilenameFields are the values available to templates given with
+// --filename-template.
+type FilenameFields struct {
+	Scheme   string
+	Host     string
+	Port     string
+	Path     string
+	PathHash string
+}
+
+// NewFilenameFields returns the filename template values for a URL.
+func NewFilenameFields(u *url.URL) FilenameFields {
+	h := sha1.New()
+	io.WriteString(h, u.Path)
+	io.WriteString(h, u.Fragment)
+
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "https":
+			port = "443"
+		case "http":
+			port = "80"
+		}
+	}
+
+	return FilenameFields{
+		Scheme:   u.Scheme,
+		Host:     u.Hostname(),
+		Port:     port,
+		Path:     strings.Trim(u.Path, "/"),
+		PathHash: fmt.Sprintf("%x", h.Sum(nil))[0:16],
+	}
+}
+
+// ParseFilenameTemplate parses a template given with --filename-template and
+// makes sure that it renders for a sample URL.
+func ParseFilenameTemplate(s string) (*template.Template, error) {
+	tmpl, err := template.New("filename").Option("missingkey=error").Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid filename template: %s", err)
+	}
+
+	u, _ := url.Parse("https://www.example.com/index.html")
+	name, err := renderFilename(tmpl, u)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid filename template: %s", err)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("Invalid filename template: template renders an empty filename")
+	}
+	return tmpl, nil
+}
+
+func renderFilename(tmpl *template.Template, u *url.URL) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, NewFilenameFields(u)); err != nil {
+		return "", err
+	}
+	name := unsafeFilenameChars.ReplaceAllString(buf.String(), "_")
+	return strings.TrimLeft(name, "."), nil
+}
+
+// assignFilename sets the base filename of the page from the filename
+// template. If the name is already taken by another page, a numeric suffix
+// is added to keep the files of both pages apart. The caller must hold the
+// session lock.
+func (s *Session) assignFilename(page *Page) {
+	name, err := renderFilename(s.FilenameTemplate, page.ParsedURL())
+	if err != nil || name == "" {
+		s.Out.Debug("[session] Falling back to default filename for %s: filename template rendered no name\n", page.URL)
+		return
+	}
+
+	filename := name
+	for i := 2; ; i++ {
+		owner, taken := s.filenames[filename]
+		if !taken || owner == page.URL {
+			break
+		}
+		filename = name + "_" + strconv.Itoa(i)
+	}
+	if filename != name {
+		s.Out.Warn("Filename %s for %s is already used by %s, using %s instead\n", name, page.URL, s.filenames[name], filename)
+	}
+
+	s.filenames[filename] = page.URL
+	page.Filename = filename
+}
@@ -0,0 +1,422 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Filter is a parsed filter expression selecting pages of a session, like
+// "status=200 && tech~wordpress". Expressions compare fields of a page with
+// a value and can be combined with &&, || and ! and grouped with
+// parentheses. The operators are:
+//
+//	=, !=    equal, not equal (case insensitive)
+//	~, !~    contains, does not contain (case insensitive)
+//	>, >=, <, <=    numeric comparison, only for numeric fields
+//
+// Fields with several values, like tech or header, match if any of their
+// values matches. Values containing spaces or operator characters can be
+// quoted with single or double quotes.
+type Filter struct {
+	expr string
+	root filterNode
+}
+
+// filterField returns the values of a field of a page.
+type filterField struct {
+	numeric bool
+	values  func(p *Page) []string
+}
+
+var filterFields = map[string]filterField{
+	"url":      {values: func(p *Page) []string { return []string{p.URL} }},
+	"host":     {values: func(p *Page) []string { return []string{p.Hostname} }},
+	"scheme":   {values: func(p *Page) []string { return []string{pageURLPart(p, "scheme")} }},
+	"path":     {values: func(p *Page) []string { return []string{pageURLPart(p, "path")} }},
+	"port":     {numeric: true, values: func(p *Page) []string { return []string{pageURLPart(p, "port")} }},
+	"status":   {numeric: true, values: func(p *Page) []string { return []string{pageStatusCode(p)} }},
+	"size":     {numeric: true, values: func(p *Page) []string { return []string{strconv.FormatInt(p.BodySize, 10)} }},
+	"title":    {values: func(p *Page) []string { return []string{p.PageTitle} }},
+	"ip":       {values: func(p *Page) []string { return p.Addrs }},
+	"baseline": {values: func(p *Page) []string { return []string{p.Baseline} }},
+	"class":    {values: func(p *Page) []string { return []string{p.Class} }},
+	"tech": {values: func(p *Page) []string {
+		var values []string
+		for _, tech := range p.Technologies {
+			values = append(values, tech.Name)
+		}
+		return values
+	}},
+	"realm": {values: func(p *Page) []string {
+		var values []string
+		for _, challenge := range p.AuthChallenges {
+			values = append(values, challenge.Realm)
+		}
+		return values
+	}},
+	"tag": {values: func(p *Page) []string {
+		var values []string
+		for _, tag := range p.Tags {
+			values = append(values, tag.Text)
+		}
+		return values
+	}},
+	"header": {values: func(p *Page) []string {
+		var values []string
+		for _, header := range p.Headers {
+			values = append(values, header.Name+": "+header.Value)
+		}
+		return values
+	}},
+}
+
+// FilterFieldNames returns the names of the fields that can be used in
+// filter expressions.
+func FilterFieldNames() []string {
+	var names []string
+	for name := range filterFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseFilter parses a filter expression. An empty expression matches all
+// pages.
+func ParseFilter(expr string) (*Filter, error) {
+	filter := &Filter{expr: expr}
+	if strings.TrimSpace(expr) == "" {
+		return filter, nil
+	}
+
+	tokens, err := lexFilter(expr)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid filter %q: %v", expr, err)
+	}
+	parser := &filterParser{tokens: tokens}
+	if filter.root, err = parser.parseOr(); err != nil {
+		return nil, fmt.Errorf("Invalid filter %q: %v", expr, err)
+	}
+	if !parser.done() {
+		return nil, fmt.Errorf("Invalid filter %q: unexpected %q", expr, parser.peek().text)
+	}
+	return filter, nil
+}
+
+// Match reports whether the page matches the filter.
+func (f *Filter) Match(p *Page) bool {
+	if f.root == nil {
+		return true
+	}
+	return f.root.match(p)
+}
+
+func (f *Filter) String() string {
+	return f.expr
+}
+
+type filterNode interface {
+	match(p *Page) bool
+}
+
+type filterAnd struct {
+	left, right filterNode
+}
+
+func (n filterAnd) match(p *Page) bool {
+	return n.left.match(p) && n.right.match(p)
+}
+
+type filterOr struct {
+	left, right filterNode
+}
+
+func (n filterOr) match(p *Page) bool {
+	return n.left.match(p) || n.right.match(p)
+}
+
+type filterNot struct {
+	node filterNode
+}
+
+func (n filterNot) match(p *Page) bool {
+	return !n.node.match(p)
+}
+
+type filterComparison struct {
+	field  filterField
+	op     string
+	value  string
+	number int64
+}
+
+func (n filterComparison) match(p *Page) bool {
+	values := n.field.values(p)
+	switch n.op {
+	case "!=":
+		return !filterAny(values, func(v string) bool { return strings.EqualFold(v, n.value) })
+	case "!~":
+		return !filterAny(values, func(v string) bool { return filterContains(v, n.value) })
+	case "=":
+		return filterAny(values, func(v string) bool { return strings.EqualFold(v, n.value) })
+	case "~":
+		return filterAny(values, func(v string) bool { return filterContains(v, n.value) })
+	}
+	return filterAny(values, func(v string) bool {
+		number, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return false
+		}
+		switch n.op {
+		case ">":
+			return number > n.number
+		case ">=":
+			return number >= n.number
+		case "<":
+			return number < n.number
+		case "<=":
+			return number <= n.number
+		}
+		return false
+	})
+}
+
+func filterAny(values []string, f func(string) bool) bool {
+	for _, v := range values {
+		if f(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func filterContains(s string, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+const (
+	filterTokenWord = iota
+	filterTokenOp
+	filterTokenAnd
+	filterTokenOr
+	filterTokenNot
+	filterTokenOpen
+	filterTokenClose
+)
+
+type filterToken struct {
+	kind int
+	text string
+}
+
+// lexFilter splits a filter expression into tokens.
+func lexFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		next := rune(0)
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{filterTokenOpen, "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{filterTokenClose, ")"})
+			i++
+		case r == '&' && next == '&':
+			tokens = append(tokens, filterToken{filterTokenAnd, "&&"})
+			i += 2
+		case r == '|' && next == '|':
+			tokens = append(tokens, filterToken{filterTokenOr, "||"})
+			i += 2
+		case r == '!' && (next == '=' || next == '~'):
+			tokens = append(tokens, filterToken{filterTokenOp, string([]rune{r, next})})
+			i += 2
+		case r == '!':
+			tokens = append(tokens, filterToken{filterTokenNot, "!"})
+			i++
+		case r == '=' && next == '=':
+			tokens = append(tokens, filterToken{filterTokenOp, "="})
+			i += 2
+		case (r == '>' || r == '<') && next == '=':
+			tokens = append(tokens, filterToken{filterTokenOp, string([]rune{r, next})})
+			i += 2
+		case r == '=' || r == '~' || r == '>' || r == '<':
+			tokens = append(tokens, filterToken{filterTokenOp, string(r)})
+			i++
+		case r == '"' || r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated string starting at position %d", i+1)
+			}
+			tokens = append(tokens, filterToken{filterTokenWord, string(runes[i+1 : end])})
+			i = end + 1
+		case r == '&' || r == '|':
+			return nil, fmt.Errorf("unexpected %q at position %d, did you mean %s?", r, i+1, strings.Repeat(string(r), 2))
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune("()&|!=~<>\"'", runes[end]) {
+				end++
+			}
+			tokens = append(tokens, filterToken{filterTokenWord, string(runes[i:end])})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// filterParser is a recursive descent parser for filter expressions. &&
+// binds tighter than ||.
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	if p.done() {
+		return filterToken{}, fmt.Errorf("unexpected end of expression")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	return token, nil
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for !p.done() && p.peek().kind == filterTokenOr {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = filterOr{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for !p.done() && p.peek().kind == filterTokenAnd {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = filterAnd{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	token, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token.kind {
+	case filterTokenNot:
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return filterNot{node}, nil
+	case filterTokenOpen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing, err := p.next(); err != nil || closing.kind != filterTokenClose {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return node, nil
+	case filterTokenWord:
+		return p.parseComparison(token.text)
+	}
+	return nil, fmt.Errorf("unexpected %q", token.text)
+}
+
+func (p *filterParser) parseComparison(name string) (filterNode, error) {
+	field, ok := filterFields[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q (valid fields: %s)", name, strings.Join(FilterFieldNames(), ", "))
+	}
+	op, err := p.next()
+	if err != nil || op.kind != filterTokenOp {
+		return nil, fmt.Errorf("expected an operator after %q", name)
+	}
+	value, err := p.next()
+	if err != nil || value.kind != filterTokenWord {
+		return nil, fmt.Errorf("expected a value after %s%s", name, op.text)
+	}
+
+	comparison := filterComparison{field: field, op: op.text, value: value.text}
+	switch op.text {
+	case ">", ">=", "<", "<=":
+		if !field.numeric {
+			return nil, fmt.Errorf("field %q can't be compared with %s", name, op.text)
+		}
+		if comparison.number, err = strconv.ParseInt(value.text, 10, 64); err != nil {
+			return nil, fmt.Errorf("%q is not a number", value.text)
+		}
+	}
+	return comparison, nil
+}
+
+// pageURLPart returns the scheme, path or port of the page's URL. The port
+// is the default port of the scheme if the URL has none.
+func pageURLPart(p *Page, part string) string {
+	u := p.ParsedURL()
+	if u == nil {
+		return ""
+	}
+	switch part {
+	case "scheme":
+		return u.Scheme
+	case "path":
+		return u.Path
+	case "port":
+		if port := u.Port(); port != "" {
+			return port
+		}
+		if u.Scheme == "https" {
+			return "443"
+		}
+		return "80"
+	}
+	return ""
+}
+
+// pageStatusCode returns the status code of the page, like 200 for a
+// status of "200 OK".
+func pageStatusCode(p *Page) string {
+	if fields := strings.Fields(p.Status); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// Clock tells the time. Agents get the time from the session clock so tests
+// can control it.
+type Clock interface {
+	Now() time.Time
+}
+
+// Dialer opens network connections. *net.Dialer implements it. Agents make
+// all connections to targets with the session dialer so tests can redirect
+// or fake them.
+type Dialer interface {
+	DialContext(ctx context.Context, network string, address string) (net.Conn, error)
+}
+
+// CommandRunner creates external commands, like the browser used for
+// screenshots. Tests can swap in a runner that executes a fake browser.
+type CommandRunner interface {
+	CommandContext(ctx context.Context, name string, arg ...string) *exec.Cmd
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+type execRunner struct{}
+
+func (execRunner) CommandContext(ctx context.Context, name string, arg ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, name, arg...)
+}
+
+// Random is a source of random numbers that is safe for concurrent use.
+type Random struct {
+	sync.Mutex
+	rand *rand.Rand
+}
+
+// NewRandom returns a source of random numbers. Sources with the same seed
+// return the same numbers.
+func NewRandom(seed int64) *Random {
+	return &Random{rand: rand.New(rand.NewSource(seed))}
+}
+
+// Intn returns a random number in [0,n).
+func (r *Random) Intn(n int) int {
+	r.Lock()
+	defer r.Unlock()
+	return r.rand.Intn(n)
+}
+
+// initHooks sets the default clock, dialer, command runner and random
+// source unless they have already been set.
+func (s *Session) initHooks() {
+	if s.Clock == nil {
+		s.Clock = systemClock{}
+	}
+	if s.Dialer == nil {
+		s.Dialer = &net.Dialer{}
+		// Errors were reported when validating the options
+		if d, err := newSourceDialer(*s.Options.SourceIP, *s.Options.Interface); err == nil && d != nil {
+			s.Dialer = d
+		}
+	}
+	if s.Runner == nil {
+		s.Runner = execRunner{}
+	}
+	if s.Random == nil {
+		seed := time.Now().UnixNano()
+		if *s.Options.Seed != 0 {
+			seed = *s.Options.Seed
+		}
+		s.Random = NewRandom(seed)
+	}
+}
@@ -0,0 +1,161 @@
+package core
+
+import (
+	"sort"
+	"strings"
+)
+
+// Sources of hosts, recorded in Host.Sources. Hosts given in the input are
+// recorded with the name of the input format instead, like nmap or amass.
+const (
+	HostSourceCrtsh    = "crt.sh"
+	HostSourceCrawl    = "crawl"
+	HostSourceSelfTest = "selftest"
+)
+
+// Host is everything learned about a host of the scan, recorded as the
+// agents go instead of being pieced together from the URLs of pages: the
+// addresses it resolved to, their PTR records and autonomous systems, its
+// open ports, services on them that don't speak HTTP, where the host came
+// from and notes about how scanning it went.
+type Host struct {
+	Hostname  string        `json:"hostname"`
+	IPs       []string      `json:"ips,omitempty"`
+	PTRs      []string      `json:"ptrs,omitempty"`
+	ASNs      []ASN         `json:"asns,omitempty"`
+	OpenPorts []int         `json:"openPorts,omitempty"`
+	Services  []HostService `json:"services,omitempty"`
+	Sources   []string      `json:"sources,omitempty"`
+	Notes     []string      `json:"notes,omitempty"`
+}
+
+// HostService is a service on an open port of a host that sends a banner
+// as soon as it accepts a connection, like SSH, SMTP or FTP. HTTP servers
+// wait for the request, so they never have one.
+type HostService struct {
+	Port   int    `json:"port"`
+	Name   string `json:"name,omitempty"`
+	Banner string `json:"banner,omitempty"`
+}
+
+// AddHost records a host found by source, and returns it.
+func (s *Session) AddHost(hostname string, source string) *Host {
+	s.Lock()
+	defer s.Unlock()
+	return s.addHost(hostname, source)
+}
+
+// addHost records a host while the session is locked.
+func (s *Session) addHost(hostname string, source string) *Host {
+	if s.Hosts == nil {
+		s.Hosts = make(map[string]*Host)
+	}
+	key := hostAddrsKey(hostname)
+	host, ok := s.Hosts[key]
+	if !ok {
+		host = &Host{Hostname: key}
+		s.Hosts[key] = host
+	}
+	if source != "" && !containsString(host.Sources, source) {
+		host.Sources = append(host.Sources, source)
+	}
+	return host
+}
+
+// GetHost returns the host with the hostname, or nil if it isn't known.
+func (s *Session) GetHost(hostname string) *Host {
+	s.Lock()
+	defer s.Unlock()
+	return s.Hosts[hostAddrsKey(hostname)]
+}
+
+// AddHostIPs records addresses the host resolved to, and returns those
+// that weren't known yet.
+func (s *Session) AddHostIPs(hostname string, ips []string) []string {
+	s.Lock()
+	defer s.Unlock()
+	host := s.addHost(hostname, "")
+	var added []string
+	for _, ip := range ips {
+		if !containsString(host.IPs, ip) {
+			host.IPs = append(host.IPs, ip)
+			added = append(added, ip)
+		}
+	}
+	return added
+}
+
+// AddHostPTRs records the names in the PTR records of the addresses of the
+// host.
+func (s *Session) AddHostPTRs(hostname string, names []string) {
+	s.Lock()
+	defer s.Unlock()
+	host := s.addHost(hostname, "")
+	for _, name := range names {
+		name = strings.TrimSuffix(name, ".")
+		if name != "" && !containsString(host.PTRs, name) {
+			host.PTRs = append(host.PTRs, name)
+		}
+	}
+}
+
+// AddHostPort records an open port of the host. Ports are kept in order.
+func (s *Session) AddHostPort(hostname string, port int) {
+	s.Lock()
+	defer s.Unlock()
+	host := s.addHost(hostname, "")
+	i := sort.SearchInts(host.OpenPorts, port)
+	if i < len(host.OpenPorts) && host.OpenPorts[i] == port {
+		return
+	}
+	host.OpenPorts = append(host.OpenPorts, 0)
+	copy(host.OpenPorts[i+1:], host.OpenPorts[i:])
+	host.OpenPorts[i] = port
+}
+
+// AddHostService records a service on an open port of the host, replacing
+// the one recorded earlier for the port.
+func (s *Session) AddHostService(hostname string, service HostService) {
+	s.Lock()
+	defer s.Unlock()
+	host := s.addHost(hostname, "")
+	for i := range host.Services {
+		if host.Services[i].Port == service.Port {
+			host.Services[i] = service
+			return
+		}
+	}
+	host.Services = append(host.Services, service)
+	sort.Slice(host.Services, func(i, j int) bool { return host.Services[i].Port < host.Services[j].Port })
+}
+
+// AddHostNote records a note about the host, like why it wasn't scanned.
+func (s *Session) AddHostNote(hostname string, note string) {
+	s.Lock()
+	defer s.Unlock()
+	host := s.addHost(hostname, "")
+	if !containsString(host.Notes, note) {
+		host.Notes = append(host.Notes, note)
+	}
+}
+
+// SortedHosts returns the hosts ordered by hostname.
+func (s *Session) SortedHosts() []*Host {
+	s.Lock()
+	defer s.Unlock()
+	hosts := make([]*Host, 0, len(s.Hosts))
+	for _, host := range s.Hosts {
+		hosts = append(hosts, host)
+	}
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].Hostname < hosts[j].Hostname })
+	return hosts
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package core
+
+// AcquireHostSlot blocks until fewer than --max-per-host connections or
+// requests of the agents are in flight to the host, and returns a function
+// that gives the slot back. Agents take the slot before they take a worker
+// of the session, so a slow host with hundreds of URLs only ever ties up a
+// few workers, and the scan of other hosts keeps going.
+func (s *Session) AcquireHostSlot(host string) func() {
+	s.Lock()
+	if s.hostSlots == nil {
+		s.hostSlots = make(map[string]chan struct{})
+	}
+	key := hostAddrsKey(host)
+	slots, ok := s.hostSlots[key]
+	if !ok {
+		slots = make(chan struct{}, *s.Options.MaxPerHost)
+		s.hostSlots[key] = slots
+	}
+	s.Unlock()
+
+	slots <- struct{}{}
+	return func() { <-slots }
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// openPort is an open port of a host on one of its addresses, with the
+// schemes of the pages requested from it.
+type openPort struct {
+	host    string
+	port    int
+	addrs   []string
+	schemes map[string]bool
+}
+
+// openPorts returns the open ports found by the port scan, along with the
+// ports of pages that were requested without a port scan, like URLs given
+// as input, ordered by host and port.
+func (s *Session) openPorts() []*openPort {
+	s.Lock()
+	defer s.Unlock()
+	ports := make(map[string]*openPort)
+	for address, addrs := range s.PortAddrs {
+		host, portStr, err := net.SplitHostPort(address)
+		if err != nil {
+			continue
+		}
+		port, _ := strconv.Atoi(portStr)
+		ports[address] = &openPort{host: host, port: port, addrs: addrs, schemes: make(map[string]bool)}
+	}
+
+	for _, page := range s.Pages {
+		u := page.ParsedURL()
+		port := u.Port()
+		if port == "" {
+			port = "80"
+			if u.Scheme == "https" {
+				port = "443"
+			}
+		}
+		address := net.JoinHostPort(page.Hostname, port)
+		p, ok := ports[address]
+		if !ok {
+			n, _ := strconv.Atoi(port)
+			p = &openPort{host: page.Hostname, port: n, addrs: page.Addrs, schemes: make(map[string]bool)}
+			ports[address] = p
+		}
+		p.schemes[u.Scheme] = true
+	}
+
+	var sorted []*openPort
+	for _, p := range ports {
+		sorted = append(sorted, p)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].host != sorted[j].host {
+			return sorted[i].host < sorted[j].host
+		}
+		return sorted[i].port < sorted[j].port
+	})
+	return sorted
+}
+
+// SaveOpenPortsToFile writes the open ports as host,ip,port,scheme lines,
+// one per address of the host the port is open on. The scheme is http or
+// https for ports with web servers, and left empty for other open ports.
+func (s *Session) SaveOpenPortsToFile(filename string) error {
+	var lines []string
+	for _, p := range s.openPorts() {
+		addrs := p.addrs
+		if len(addrs) == 0 {
+			addrs = []string{""}
+		}
+		schemes := []string{""}
+		if len(p.schemes) > 0 {
+			schemes = nil
+			for scheme := range p.schemes {
+				schemes = append(schemes, scheme)
+			}
+			sort.Strings(schemes)
+		}
+		for _, addr := range addrs {
+			for _, scheme := range schemes {
+				lines = append(lines, fmt.Sprintf("%s,%s,%d,%s", p.host, addr, p.port, scheme))
+			}
+		}
+	}
+	return writeLines(s.GetFilePath(filename), lines)
+}
+
+// SaveHostsToFile writes the hosts with open ports as host,ip lines, one
+// per address of the host, like the hosts.txt of earlier versions of
+// Aquatone.
+func (s *Session) SaveHostsToFile(filename string) error {
+	seen := make(map[string]struct{})
+	var lines []string
+	for _, p := range s.openPorts() {
+		for _, addr := range p.addrs {
+			line := fmt.Sprintf("%s,%s", p.host, addr)
+			if _, ok := seen[line]; !ok {
+				seen[line] = struct{}{}
+				lines = append(lines, line)
+			}
+		}
+	}
+	sort.Strings(lines)
+	return writeLines(s.GetFilePath(filename), lines)
+}
+
+// writeLines writes the lines to a file, each ending with a newline.
+func writeLines(path string, lines []string) error {
+	content := strings.Join(lines, "\n")
+	if len(lines) > 0 {
+		content += "\n"
+	}
+	return ioutil.WriteFile(path, []byte(content), 0644)
+}
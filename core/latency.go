@@ -0,0 +1,81 @@
+package core
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// minLatencySamples is the number of pages a host must have before its
+	// response times are considered for anomaly detection.
+	minLatencySamples = 4
+	// latencyDeviations is the number of scaled median absolute deviations
+	// a response time must be above the host median to be anomalous.
+	latencyDeviations = 3.5
+	// minLatencyDifference avoids flagging small absolute differences on
+	// hosts that respond very consistently.
+	minLatencyDifference = 500 * time.Millisecond
+)
+
+// TagLatencyAnomalies tags pages whose response time is a statistical
+// outlier compared to the other pages on the same host. Slow outliers can
+// point to backend processing, SSRF sinks or debug endpoints.
+func (s *Session) TagLatencyAnomalies() []*Page {
+	hosts := make(map[string][]*Page)
+	for _, page := range s.Pages {
+		if page.ResponseTime <= 0 {
+			continue
+		}
+		hosts[page.Hostname] = append(hosts[page.Hostname], page)
+	}
+
+	var anomalous []*Page
+	for _, pages := range hosts {
+		if len(pages) < minLatencySamples {
+			continue
+		}
+
+		times := make([]float64, len(pages))
+		for i, page := range pages {
+			times[i] = float64(page.ResponseTime)
+		}
+		median := medianOf(times)
+		deviations := make([]float64, len(times))
+		for i, t := range times {
+			deviations[i] = abs(t - median)
+		}
+		// 1.4826 scales the MAD to be comparable with a standard deviation
+		// for normally distributed values.
+		mad := medianOf(deviations) * 1.4826
+
+		for _, page := range pages {
+			t := float64(page.ResponseTime)
+			if t-median < float64(minLatencyDifference/time.Millisecond) {
+				continue
+			}
+			if mad > 0 && (t-median)/mad < latencyDeviations {
+				continue
+			}
+			page.AddTag("Anomalous Latency", "warning", "")
+			anomalous = append(anomalous, page)
+		}
+	}
+	return anomalous
+}
+
+func medianOf(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+func abs(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
@@ -0,0 +1,279 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LayoutVersion is the version of the output directory layout described by
+// the manifest. It is increased whenever files or folders are moved, renamed
+// or change meaning, so tools consuming the output can tell which layout they
+// are looking at. Output directories without a manifest use layout 1.
+//
+//	1: aquatone_* files, headers/, html/ and screenshots/
+//	2: adds aquatone_manifest.json and a copy of every session in sessions/
+//	3: screenshots are stored once per content in screenshots/sha256/, with
+//	   a symlink named after the page in screenshots/
+//	4: adds api/ with the API documents found with --probe-apis
+//	5: adds aquatone_unresponsive.txt with the URLs that never responded
+//	6: adds aquatone_open_ports.txt and aquatone_hosts.txt
+//	7: adds aquatone_search_index.json with the text searched by the report
+//	8: adds aquatone_store.zst and aquatone_store.idx, which hold the files of
+//	   headers/ and html/ with --response-store
+//	9: the session file and its copies in sessions/ are named after the
+//	   --session-format, like aquatone_session.json.gz
+const LayoutVersion = 9
+
+// ManifestFilename is the name of the manifest in the output directory.
+const ManifestFilename = "aquatone_manifest.json"
+
+// sessionHistoryDir is the folder in the output directory that keeps a copy
+// of the session file of every scan.
+const sessionHistoryDir = "sessions"
+
+// layoutFiles maps the names of the files and folders of the output
+// directory to their paths relative to it.
+var layoutFiles = map[string]string{
+	"report":          "aquatone_report.html",
+	"session":         "aquatone_session.json",
+	"urls":            "aquatone_urls.txt",
+	"errors":          "aquatone_errors.json",
+	"contacts":        "aquatone_contacts.txt",
+	"unresponsive":    "aquatone_unresponsive.txt",
+	"openPorts":       "aquatone_open_ports.txt",
+	"hosts":           "aquatone_hosts.txt",
+	"searchIndex":     "aquatone_search_index.json",
+	"store":           StoreFilename,
+	"storeIndex":      StoreIndexFilename,
+	"screenshots":     "screenshots",
+	"screenshotStore": screenshotStoreDir,
+	"headers":         "headers",
+	"html":            "html",
+	"api":             "api",
+	"sessions":        sessionHistoryDir,
+}
+
+// Folders with files that are referenced by pages in sessions and can be
+// pruned by Clean.
+var cleanDirs = []string{"screenshots", "headers", "html", "api"}
+
+// Manifest describes the layout of an output directory.
+type Manifest struct {
+	LayoutVersion int               `json:"layoutVersion"`
+	Version       string            `json:"version"`
+	UpdatedAt     time.Time         `json:"updatedAt"`
+	Files         map[string]string `json:"files"`
+	Sessions      []string          `json:"sessions"`
+}
+
+// CleanResult holds what was removed from the output directory by Clean.
+type CleanResult struct {
+	Sessions []string
+	Files    []string
+	Bytes    int64
+}
+
+// ReadManifest reads the manifest of an output directory. A manifest for
+// layout 1 is returned if the directory has none.
+func ReadManifest(dir string) (*Manifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, ManifestFilename))
+	if os.IsNotExist(err) {
+		return &Manifest{LayoutVersion: 1}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// SaveManifest writes the manifest for the current layout to the output
+// directory. The session file it lists is the one written last, as its name
+// depends on the --session-format of the scan.
+func (s *Session) SaveManifest() error {
+	sessions, err := s.historySessions()
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]string, len(layoutFiles))
+	for name, path := range layoutFiles {
+		files[name] = path
+	}
+	if current := FindSessionFile(s.GetFilePath("")); current != "" {
+		files["session"] = filepath.Base(current)
+	}
+
+	manifest := &Manifest{
+		LayoutVersion: LayoutVersion,
+		Version:       Version,
+		UpdatedAt:     s.Clock.Now().UTC(),
+		Files:         files,
+		Sessions:      sessions,
+	}
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(s.GetFilePath(ManifestFilename), manifestJSON, 0644)
+}
+
+// SaveToHistory writes a copy of the session file to the sessions folder,
+// named after the time the scan started so the names sort by age.
+func (s *Session) SaveToHistory() error {
+	if err := os.MkdirAll(s.GetFilePath(sessionHistoryDir), 0755); err != nil {
+		return err
+	}
+	filename := fmt.Sprintf("aquatone_session_%s.%s", s.Stats.StartedAt.UTC().Format("20060102T150405Z"), *s.Options.SessionFormat)
+	return s.SaveToFile(path.Join(sessionHistoryDir, filename))
+}
+
+// historySessions returns the paths of the session files in the sessions
+// folder relative to the output directory, from oldest to newest.
+func (s *Session) historySessions() ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(s.GetFilePath(sessionHistoryDir), "aquatone_session_*"))
+	if err != nil {
+		return nil, err
+	}
+	sessions := []string{}
+	for _, match := range matches {
+		sessions = append(sessions, path.Join(sessionHistoryDir, filepath.Base(match)))
+	}
+	sort.Strings(sessions)
+	return sessions, nil
+}
+
+// Clean removes all but the keep newest sessions from the sessions folder,
+// and then removes screenshots, headers and bodies that are not referenced
+// by the remaining sessions or the current session file. Nothing is removed
+// if any of the remaining sessions can't be read, as the files it references
+// would be lost. The manifest is updated afterwards.
+func (s *Session) Clean(keep int) (*CleanResult, error) {
+	manifest, err := ReadManifest(s.GetFilePath(""))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read manifest: %v", err)
+	}
+	if manifest.LayoutVersion > LayoutVersion {
+		return nil, fmt.Errorf("output directory has layout version %d, but this version of Aquatone only knows up to %d", manifest.LayoutVersion, LayoutVersion)
+	}
+
+	sessions, err := s.historySessions()
+	if err != nil {
+		return nil, err
+	}
+	result := &CleanResult{}
+	if len(sessions) > keep {
+		result.Sessions = sessions[:len(sessions)-keep]
+		sessions = sessions[len(sessions)-keep:]
+	}
+	if current := FindSessionFile(s.GetFilePath("")); current != "" {
+		sessions = append(sessions, filepath.Base(current))
+	}
+	if len(sessions) == 0 {
+		return nil, fmt.Errorf("no sessions found in %s", *s.Options.OutDir)
+	}
+
+	referenced := make(map[string]bool)
+	for _, name := range sessions {
+		session, err := LoadSession(s.GetFilePath(name))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load session %s: %v", name, err)
+		}
+		for _, page := range session.Pages {
+			page.addReferencedFiles(referenced)
+		}
+		for _, page := range session.GonePages {
+			page.addReferencedFiles(referenced)
+		}
+	}
+
+	for _, name := range result.Sessions {
+		if err := s.removeFile(name, result); err != nil {
+			return nil, err
+		}
+	}
+
+	root := s.GetFilePath("")
+	for _, dir := range cleanDirs {
+		err := filepath.Walk(filepath.Join(root, dir), func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if !info.Mode().IsRegular() && info.Mode()&os.ModeSymlink == 0 {
+				return nil
+			}
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			rel = filepath.ToSlash(rel)
+			if referenced[rel] {
+				return nil
+			}
+			result.Files = append(result.Files, rel)
+			return s.removeFile(rel, result)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if ResponseStoreExists(root) {
+		dropped, freed, err := CompactResponseStore(root, func(name string) bool { return referenced[name] })
+		if err != nil {
+			return nil, fmt.Errorf("unable to compact response store: %v", err)
+		}
+		result.Files = append(result.Files, dropped...)
+		result.Bytes += freed
+	}
+
+	return result, s.SaveManifest()
+}
+
+func (s *Session) removeFile(name string, result *CleanResult) error {
+	p := s.GetFilePath(name)
+	info, err := os.Lstat(p)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil {
+		return err
+	}
+	result.Bytes += info.Size()
+	return nil
+}
+
+// addReferencedFiles adds the paths of the files written for the page to
+// the given set, including the symlink to its screenshot in the screenshot
+// store.
+func (p *Page) addReferencedFiles(files map[string]bool) {
+	paths := []string{p.ScreenshotPath, p.RequestPath, p.HeadersPath, p.BodyPath}
+	if p.HasScreenshot && path.Dir(p.ScreenshotPath) == screenshotStoreDir {
+		paths = append(paths, fmt.Sprintf("screenshots/%s%s", p.BaseFilename(), path.Ext(p.ScreenshotPath)))
+	}
+	for _, hop := range p.RedirectChain {
+		paths = append(paths, hop.HeadersPath)
+	}
+	for _, doc := range p.APIDocuments {
+		paths = append(paths, doc.Path)
+	}
+	for _, name := range paths {
+		if name != "" && !strings.HasPrefix(name, "..") {
+			files[path.Clean(name)] = true
+		}
+	}
+}
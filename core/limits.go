@@ -0,0 +1,35 @@
+package core
+
+import "sync/atomic"
+
+// AllowHost reports whether another host may be scanned under --max-hosts.
+// Hosts beyond the limit are counted as skipped, and a warning is printed
+// when the limit is first reached.
+func (s *Session) AllowHost() bool {
+	if s.allow(&s.hostCount, *s.Options.MaxHosts) {
+		return true
+	}
+	if atomic.AddUint32(&s.Stats.HostsSkipped, 1) == 1 {
+		s.Out.Warn("Reached the limit of %d hosts given with --max-hosts: skipping the remaining hosts\n", *s.Options.MaxHosts)
+	}
+	return false
+}
+
+// AllowURL reports whether another URL may be requested under --max-urls.
+// This includes URLs of open ports found on hosts.
+func (s *Session) AllowURL() bool {
+	if s.allow(&s.urlCount, *s.Options.MaxURLs) {
+		return true
+	}
+	if atomic.AddUint32(&s.Stats.URLsSkipped, 1) == 1 {
+		s.Out.Warn("Reached the limit of %d URLs given with --max-urls: skipping the remaining URLs\n", *s.Options.MaxURLs)
+	}
+	return false
+}
+
+func (s *Session) allow(count *int64, limit int) bool {
+	if limit <= 0 {
+		return true
+	}
+	return atomic.AddInt64(count, 1) <= int64(limit)
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
 )
 
 const (
@@ -28,12 +29,36 @@ var LogColors = map[int]*color.Color{
 type Logger struct {
 	sync.Mutex
 
-	debug  bool
-	silent bool
+	debug   bool
+	silent  bool
+	noColor bool
 }
 
 func (l *Logger) SetSilent(s bool) {
 	l.silent = s
+	l.detectColor()
+}
+
+// SetNoColor disables colored output. Colors are also disabled when the
+// output isn't a terminal or the NO_COLOR environment variable is set.
+func (l *Logger) SetNoColor(n bool) {
+	l.noColor = n
+	l.detectColor()
+}
+
+func (l *Logger) detectColor() {
+	out := l.output()
+	color.NoColor = l.noColor || os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" ||
+		(!isatty.IsTerminal(out.Fd()) && !isatty.IsCygwinTerminal(out.Fd()))
+}
+
+// output returns the stream to log to. In silent mode stdout is reserved
+// for the JSON summary.
+func (l *Logger) output() *os.File {
+	if l.silent {
+		return os.Stderr
+	}
+	return os.Stdout
 }
 
 func (l *Logger) SetDebug(d bool) {
@@ -41,6 +66,14 @@ func (l *Logger) SetDebug(d bool) {
 }
 
 func (l *Logger) Log(level int, format string, args ...interface{}) {
+	l.print(level, format, args...)
+
+	if level == FATAL {
+		os.Exit(ExitGeneric)
+	}
+}
+
+func (l *Logger) print(level int, format string, args ...interface{}) {
 	l.Lock()
 	defer l.Unlock()
 	if level == DEBUG && !l.debug {
@@ -49,14 +82,11 @@ func (l *Logger) Log(level int, format string, args ...interface{}) {
 		return
 	}
 
+	out := l.output()
 	if c, ok := LogColors[level]; ok {
-		c.Printf(format, args...)
+		c.Fprintf(out, format, args...)
 	} else {
-		fmt.Printf(format, args...)
-	}
-
-	if level == FATAL {
-		os.Exit(1)
+		fmt.Fprintf(out, format, args...)
 	}
 }
 
@@ -64,6 +94,12 @@ func (l *Logger) Fatal(format string, args ...interface{}) {
 	l.Log(FATAL, format, args...)
 }
 
+// FatalWithCode logs a fatal message and exits with the given exit code.
+func (l *Logger) FatalWithCode(code int, format string, args ...interface{}) {
+	l.print(FATAL, format, args...)
+	os.Exit(code)
+}
+
 func (l *Logger) Error(format string, args ...interface{}) {
 	l.Log(ERROR, format, args...)
 }
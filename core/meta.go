@@ -0,0 +1,40 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+)
+
+// ParseMeta parses key=value pairs given with --meta, like
+// engagement=ACME-2024-07 or ticket=PT-1234, which are recorded in the
+// session and shown in the report header.
+func ParseMeta(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+	meta := make(map[string]string)
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || key == "" {
+			return nil, fmt.Errorf("Invalid metadata %q: must be given as key=value", pair)
+		}
+		meta[key] = strings.TrimSpace(parts[1])
+	}
+	return meta, nil
+}
+
+// scanOperator returns the name of the user running the scan and the
+// hostname of the machine it runs on, for evidence of who scanned what.
+func scanOperator() (string, string) {
+	operator := ""
+	if current, err := user.Current(); err == nil {
+		operator = current.Username
+	} else {
+		operator = os.Getenv("USER")
+	}
+	hostname, _ := os.Hostname()
+	return operator, hostname
+}
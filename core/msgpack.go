@@ -0,0 +1,36 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// The session is written as MessagePack (https://msgpack.org) straight from
+// its structs, with the names and omitempty options of their JSON tags, so
+// the JSON tags stay the only description of the format and both encodings
+// hold the same data. Nothing but the session itself is kept in memory.
+
+// writeMsgpack writes the MessagePack encoding of the session.
+func writeMsgpack(w io.Writer, s *Session) error {
+	bw := bufio.NewWriter(w)
+	encoder := msgpack.NewEncoder(bw)
+	encoder.SetCustomStructTag("json")
+	if err := encoder.Encode(s); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// readMsgpack reads a session written by writeMsgpack.
+func readMsgpack(r io.Reader) (*Session, error) {
+	decoder := msgpack.NewDecoder(r)
+	decoder.SetCustomStructTag("json")
+	var session Session
+	if err := decoder.Decode(&session); err != nil {
+		return nil, fmt.Errorf("invalid MessagePack: %v", err)
+	}
+	return &session, nil
+}
@@ -8,43 +8,199 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// Subcommands of the aquatone command. Options.Command is empty when
+// running a scan.
+const (
+	CommandShow         = "show"
+	CommandSelfTest     = "selftest"
+	CommandClean        = "clean"
+	CommandExtract      = "extract"
+	CommandImport       = "import"
+	CommandTemplateLint = "template lint"
+)
+
 type Options struct {
-	Threads           *int
-	OutDir            *string
-	SessionPath       *string
-	TemplatePath      *string
-	Proxy             *string
-	ChromePath        *string
-	Resolution        *string
-	Ports             *string
-	ScanTimeout       *int
-	HTTPTimeout       *int
-	ScreenshotTimeout *int
-	Nmap              *bool
-	SaveBody          *bool
-	Silent            *bool
-	Debug             *bool
-	Version           *bool
+	Command            *string
+	Threads            *int
+	OutDir             *string
+	SessionPath        *string
+	SessionFormat      *string
+	TriagePath         *string
+	Meta               *[]string
+	AuthorizationFile  *string
+	ReportTitle        *string
+	ReportLogo         *string
+	Baseline           *string
+	CompareScreenshots *string
+	TemplatePath       *string
+	FilenameTemplate   *string
+	ReportBaseURL      *string
+	Proxy              *string
+	Via                *string
+	SourceIP           *string
+	Interface          *string
+	TLSFingerprint     *string
+	ChromePath         *string
+	Resolution         *string
+	Headful            *bool
+	Display            *string
+	Stealth            *bool
+	Ports              *string
+	ProbeMethod        *string
+	ProbeBody          *string
+	ProbeContentType   *string
+	ConnectTimeout     *int
+	PortReadTimeout    *int
+	HTTPTimeout        *int
+	ScreenshotTimeout  *int
+	ScreenshotOverlay  *bool
+	ChromeInstances    *int
+	MinFreeSpace       *int
+	MaxHosts           *int
+	MaxURLs            *int
+	MaxCIDRHosts       *int
+	MaxClientRedirects *int
+	QuarantineAfter    *int
+	MaxRetryAfter      *int
+	MaxPerHost         *int
+	SPARoutes          *int
+	FailureThreshold   *float64
+	FailOn             *string
+	ExportBurp         *string
+	ExportZAP          *string
+	ExportDefectDojo   *string
+	ExportCycloneDX    *string
+	ExportSTIX         *string
+	ExportNmapXML      *string
+	ExportStructure    *string
+	Archive            *string
+	ArchivePassphrase  *string
+	JARM               *bool
+	JARMList           *string
+	DefaultPageList    *string
+	VerifyTakeover     *bool
+	ProbeAPIs          *bool
+	WellKnown          *bool
+	LookupASN          *bool
+	ProbeExposures     *bool
+	ExposureList       *string
+	Nmap               *bool
+	Nessus             *bool
+	InputFormat        *string
+	TargetsFiles       *[]string
+	TrustResolution    *bool
+	ExpandWildcards    *bool
+	NoPortScan         *bool
+	KeepFragments      *bool
+	SaveBody           *string
+	BodySampleSize     *int
+	ResponseStore      *bool
+	Silent             *bool
+	NoColor            *bool
+	Debug              *bool
+	Seed               *int64
+	Pprof              *string
+	Version            *bool
+	Listen             *string
+	BasicAuth          *string
+	KeepSessions       *int
+	ExtractWhat        *string
+	ExtractWhere       *string
+	ImportPath         *string
+	LintPath           *string
 }
 
 func ParseOptions() (Options, error) {
 	var (
-		threads           int
-		outDir            string
-		sessionPath       string
-		templatePath      string
-		proxy             string
-		chromePath        string
-		resolution        string
-		ports             string
-		scanTimeout       int
-		httpTimeout       int
-		screenshotTimeout int
-		nmap              bool
-		saveBody          bool
-		silent            bool
-		debug             bool
-		version           bool
+		command            string
+		threads            int
+		outDir             string
+		sessionPath        string
+		sessionFormat      string
+		triagePath         string
+		meta               []string
+		authorizationFile  string
+		reportTitle        string
+		reportLogo         string
+		baseline           string
+		compareScreenshots string
+		templatePath       string
+		filenameTemplate   string
+		reportBaseURL      string
+		proxy              string
+		via                string
+		sourceIP           string
+		iface              string
+		tlsFingerprint     string
+		chromePath         string
+		resolution         string
+		headful            bool
+		display            string
+		stealth            bool
+		ports              string
+		probeMethod        string
+		probeBody          string
+		probeContentType   string
+		scanTimeout        int
+		connectTimeout     int
+		portReadTimeout    int
+		httpTimeout        int
+		screenshotTimeout  int
+		screenshotOverlay  bool
+		chromeInstances    int
+		minFreeSpace       int
+		maxHosts           int
+		maxURLs            int
+		maxCIDRHosts       int
+		maxClientRedirects int
+		quarantineAfter    int
+		maxRetryAfter      int
+		maxPerHost         int
+		spaRoutes          int
+		failureThreshold   float64
+		failOn             string
+		exportBurp         string
+		exportZAP          string
+		exportDefectDojo   string
+		exportCycloneDX    string
+		exportSTIX         string
+		exportNmapXML      string
+		exportStructure    string
+		archive            string
+		archivePassphrase  string
+		jarm               bool
+		jarmList           string
+		defaultPageList    string
+		verifyTakeover     bool
+		probeAPIs          bool
+		wellKnown          bool
+		lookupASN          bool
+		probeExposures     bool
+		exposureList       string
+		nmap               bool
+		nessus             bool
+		inputFormat        string
+		targetsFiles       []string
+		trustResolution    bool
+		expandWildcards    bool
+		noPortScan         bool
+		keepFragments      bool
+		saveBody           string
+		bodySampleSize     int
+		responseStore      bool
+		silent             bool
+		noColor            bool
+		debug              bool
+		seed               int64
+		pprof              string
+		version            bool
+		listen             string
+		basicAuth          string
+		keepSessions       int
+		extractWhat        string
+		extractWhere       string
+		importPath         string
+		lintPath           string
 	)
 
 	rootCmd := &cobra.Command{
@@ -53,56 +209,299 @@ func ParseOptions() (Options, error) {
 		RunE:  func(cmd *cobra.Command, args []string) error { return nil },
 	}
 
+	rootCmd.CompletionOptions.DisableDefaultCmd = true
+
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Short: "Serve the report in the output directory over HTTP",
+		Args:  cobra.NoArgs,
+		RunE:  func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	showCmd.Flags().StringVarP(&listen, "listen", "l", "127.0.0.1:8000", "Address to listen on")
+	showCmd.Flags().StringVar(&basicAuth, "auth", "", "Require HTTP basic authentication with the given user:password")
+	rootCmd.AddCommand(showCmd)
+
+	selfTestCmd := &cobra.Command{
+		Use:   "selftest",
+		Short: "Scan a local test server to check that scanning, screenshots and output work",
+		Args:  cobra.NoArgs,
+		RunE:  func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	rootCmd.AddCommand(selfTestCmd)
+
+	cleanCmd := &cobra.Command{
+		Use:   "clean",
+		Short: "Remove old sessions and the screenshots, headers and bodies no longer referenced from the output directory",
+		Args:  cobra.NoArgs,
+		RunE:  func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	cleanCmd.Flags().IntVar(&keepSessions, "keep-sessions", 5, "Number of most recent sessions to keep")
+	rootCmd.AddCommand(cleanCmd)
+
+	extractCmd := &cobra.Command{
+		Use:   "extract",
+		Short: "Print URLs, hosts, technologies or headers of the pages in a session matching a filter",
+		Example: `  aquatone extract --session aquatone_session.json --what hosts
+  aquatone extract --session aquatone_session.json --where 'status=200 && tech~wordpress'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	extractCmd.Flags().StringVar(&extractWhat, "what", "urls", "What to print (urls, hosts, technologies, headers)")
+	extractCmd.Flags().StringVar(&extractWhere, "where", "", "Only include pages matching the filter expression (e.g. 'status=200 && tech~wordpress')")
+	rootCmd.AddCommand(extractCmd)
+
+	importCmd := &cobra.Command{
+		Use:   "import <path>",
+		Short: "Import a gowitness database or EyeWitness results directory into a session and report",
+		Example: `  aquatone import gowitness.sqlite3 -o old_scan
+  aquatone import eyewitness_results/ -o old_scan`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			importPath = args[0]
+			return nil
+		},
+	}
+	rootCmd.AddCommand(importCmd)
+
+	templateCmd := &cobra.Command{
+		Use:   "template",
+		Short: "Work with custom report templates",
+	}
+	templateLintCmd := &cobra.Command{
+		Use:     "lint <path>",
+		Short:   "Check a custom report template against the session data model",
+		Example: `  aquatone template lint mytemplate.html`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			lintPath = args[0]
+			return nil
+		},
+	}
+	templateCmd.AddCommand(templateLintCmd)
+	rootCmd.AddCommand(templateCmd)
+
 	flags := rootCmd.PersistentFlags()
 
 	flags.IntVarP(&threads, "threads", "t", 0, "Number of concurrent threads")
 	flags.StringVarP(&outDir, "out", "o", ".", "Directory to write files to")
 	flags.StringVarP(&sessionPath, "session", "s", "", "Load Aquatone session file and generate HTML report")
+	flags.StringVar(&sessionFormat, "session-format", SessionFormatJSON, "Format to write the session file in (json, json.gz, msgpack)")
+	flags.StringVar(&triagePath, "triage", "", "Triage file exported from the report to merge flagged and hidden pages from into the session")
+	flags.StringArrayVar(&meta, "meta", nil, "Metadata to record in the session and report as key=value, like an engagement or ticket ID (can be given multiple times)")
+	flags.StringVar(&authorizationFile, "authorization-file", "", "Text file with the authorization for the scan, like a letter of authorization, to embed in the session and report")
+	flags.StringVar(&baseline, "baseline", "", "Session file of a previous scan to mark pages as new, changed, unchanged or gone against")
+	flags.StringVar(&compareScreenshots, "compare-screenshots", "", "Output directory of a previous scan to write a before/after gallery of changed screenshots against")
 	flags.StringVarP(&templatePath, "template-path", "T", "", "Path to HTML template to use for report")
+	flags.StringVar(&reportTitle, "report-title", "", "Title of the report, shown in the navigation bar and browser tab")
+	flags.StringVar(&reportLogo, "report-logo", "", "Image file to show as logo in the navigation bar of the report")
+	flags.StringVar(&reportBaseURL, "report-base-url", "", "URL the output directory is served from, used for links to screenshots, headers and bodies in the report")
+	flags.StringVar(&filenameTemplate, "filename-template", "", "Template for screenshot, header and body filenames (e.g. '{{.Scheme}}_{{.Host}}_{{.Port}}')")
 
 	defaultPorts := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(MediumPortList)), ","), "[]")
 	flags.StringVarP(&ports, "ports", "p", defaultPorts, "Ports to scan on hosts (alias list: small, medium, large, xlarge)")
 	flags.StringVarP(&proxy, "proxy", "x", "", "Proxy to use for HTTP requests (like curl -x)")
+	flags.StringVar(&sourceIP, "source-ip", "", "Bind outgoing connections to the given local IP address")
+	flags.StringVar(&iface, "interface", "", "Bind outgoing connections to an address of the given network interface (e.g. eth1)")
+	flags.StringVar(&via, "via", "", "Tunnel port scans, requests and screenshots through an SSH jump host (e.g. ssh://user@bastion.example.com)")
+	flags.StringVar(&tlsFingerprint, "tls-fingerprint", "go", "Client TLS fingerprint for HTTP requests (go, random, chrome, edge, firefox, ios, safari)")
+	flags.StringVar(&probeMethod, "probe-method", "GET", "HTTP method to request URLs with, for API-only services that don't answer GET (e.g. POST)")
+	flags.StringVar(&probeBody, "probe-body", "", "Body to send with --probe-method, or @file to read it from a file")
+	flags.StringVar(&probeContentType, "probe-content-type", "application/x-www-form-urlencoded", "Content-Type of --probe-body")
 	flags.StringVarP(&chromePath, "chrome-path", "c", "", "Full path to Chrome/Chromium executable")
-	flags.StringVarP(&resolution, "resolution", "r", "1440,900", "Screenshot resolution")
+	flags.StringVarP(&resolution, "resolution", "r", "1440,900", "Screenshot resolution as width,height or widthxheight, or a preset (mobile, tablet, laptop, desktop, 4k)")
+	flags.BoolVar(&headful, "headful", false, "Run Chrome with a window instead of headless, for pages that block headless browsers (starts Xvfb on Linux when there is no display)")
+	flags.BoolVar(&stealth, "stealth", false, "Hide common signs of headless Chrome, like navigator.webdriver and HeadlessChrome in the user agent, from pages that block headless browsers")
+	flags.StringVar(&display, "display", "", "X display to run Chrome on with --headful (e.g. :0), instead of $DISPLAY or a virtual display started with Xvfb")
 
-	flags.IntVarP(&scanTimeout, "scan-timeout", "S", 100, "Timeout in milliseconds for port scans")
+	flags.IntVarP(&connectTimeout, "connect-timeout", "S", 5000, "Timeout in milliseconds for connecting to ports when port scanning")
+	flags.IntVar(&portReadTimeout, "port-read-timeout", 1000, "Time in milliseconds to wait for data on open ports to confirm them when port scanning (0 to not wait)")
+	flags.IntVar(&scanTimeout, "scan-timeout", 0, "Timeout in milliseconds for port scans")
+	flags.MarkDeprecated("scan-timeout", "use --connect-timeout instead")
 	flags.IntVarP(&httpTimeout, "http-timeout", "H", 3000, "Timeout in milliseconds for HTTP requests")
 	flags.IntVarP(&screenshotTimeout, "screenshot-timeout", "z", 40, "Timeout in seconds for screenshots")
+	flags.BoolVar(&screenshotOverlay, "screenshot-overlay", false, "Burn the URL, status and capture time into the bottom left corner of every screenshot")
+	flags.IntVar(&quarantineAfter, "quarantine-after", 10, "Number of consecutive timeouts or connection resets after which the remaining ports and URLs of a host are skipped (0 to disable)")
+	flags.IntVar(&maxPerHost, "max-per-host", 0, "Maximum number of connections and requests in flight to a single host, so slow hosts don't hold up the others (0 for half of --threads)")
+	flags.IntVar(&maxRetryAfter, "max-retry-after", 60, "Longest delay in seconds to wait for before retrying a URL rate limited with 429 or 503 and Retry-After (0 to never retry)")
+	flags.IntVar(&chromeInstances, "chrome-instances", 4, "Maximum number of Chrome instances to run at the same time for screenshots, independent of --threads")
+
+	flags.IntVar(&maxHosts, "max-hosts", 100000, "Maximum number of hosts to scan, skipping the rest (0 for no limit)")
+	flags.IntVar(&maxCIDRHosts, "max-cidr-hosts", 65536, "Maximum number of addresses a CIDR range in the input may expand to, skipping larger ranges")
+	flags.IntVar(&maxURLs, "max-urls", 500000, "Maximum number of URLs to request, including URLs of open ports on hosts (0 for no limit)")
+	flags.IntVar(&spaRoutes, "spa-routes", 0, "Number of client-side routes found in the JavaScript of single page apps to request and screenshot per app (0 to only record them)")
+	flags.IntVar(&maxClientRedirects, "max-client-redirects", 3, "Maximum number of meta refresh and JavaScript redirects to follow from a page (0 to disable)")
+
+	flags.IntVar(&minFreeSpace, "min-free-space", 0, "Free disk space in MB on the output volume below which screenshots are saved as JPEG and bodies are sampled; the scan pauses below a quarter of it (0 to disable)")
+
+	flags.Float64Var(&failureThreshold, "failure-threshold", 0, "Exit with a non-zero code when the percentage of failed requests exceeds this value (0 to disable)")
+
+	flags.StringVar(&failOn, "fail-on", "", "Exit with a non-zero code when any of the given conditions are met (e.g. takeover,new-host,5xx>10)")
 
-	flags.BoolVarP(&nmap, "nmap", "m", false, "Parse input as Nmap/Masscan XML")
+	flags.StringVar(&exportBurp, "export-burp", "", "Write request/response pairs as Burp Suite items XML to the given file")
+	flags.StringVar(&exportZAP, "export-zap", "", "Write an OWASP ZAP context with discovered hosts and authentication hints to the given file")
+	flags.StringVar(&exportDefectDojo, "export-defectdojo", "", "Write findings in DefectDojo Generic Findings Import format to the given file")
+	flags.StringVar(&exportCycloneDX, "export-cyclonedx", "", "Write an inventory of detected technologies per host as CycloneDX JSON to the given file")
+	flags.StringVar(&exportSTIX, "export-stix", "", "Write discovered hosts, IPs, certificates and URLs as a STIX 2.1 bundle to the given file")
+	flags.StringVar(&exportNmapXML, "export-nmap-xml", "", "Write the open ports found by the port scan as Nmap XML to the given file")
+	flags.StringVar(&exportStructure, "export-structure", "", "Write the page structures used for clustering and their similarity matrix as JSON to the given file")
 
-	flags.BoolVarP(&saveBody, "save-body", "b", true, "Save response bodies to files")
-	flags.BoolVarP(&silent, "silent", "q", false, "Suppress all output except for errors")
+	flags.StringVar(&archive, "archive", "", "Package the report, session, screenshots, headers and bodies into the given .tar.gz or .zip file")
+	flags.StringVar(&archivePassphrase, "archive-passphrase", "", "Encrypt the archive with the given passphrase (OpenPGP, decrypt with gpg -d)")
+
+	flags.BoolVar(&jarm, "jarm", false, "Compute JARM TLS server fingerprints of HTTPS services")
+	flags.StringVar(&jarmList, "jarm-list", "", "File with known JARM fingerprints to tag, one per line as fingerprint,name")
+	flags.StringVar(&defaultPageList, "default-page-list", "", "File with body hashes of known default and debug pages to tag, one per line as sha256,name")
+
+	flags.BoolVar(&probeAPIs, "probe-apis", false, "Probe every web server for OpenAPI/Swagger documents and GraphQL endpoints with introspection enabled")
+	flags.BoolVar(&wellKnown, "well-known", false, "Fetch security.txt and other well-known URIs (RFC 8615) from every web server")
+	flags.BoolVar(&lookupASN, "lookup-asn", false, "Look up the autonomous systems of the addresses of hosts in the IP to ASN mapping of Team Cymru over DNS")
+	flags.BoolVar(&probeExposures, "probe-exposures", false, "Probe every web server for exposed source control metadata, .env files and backups")
+	flags.StringVar(&exposureList, "exposure-list", "", "File with paths to probe with --probe-exposures instead of the built-in list, one per line")
+	flags.BoolVar(&verifyTakeover, "verify-takeover", false, "Verify domain takeover fingerprint matches with provider specific checks, like looking up the CNAME target or asking S3 if the bucket exists")
+
+	flags.StringVar(&inputFormat, "input-format", "auto", "Format of the input (auto, text, nmap, nessus, burp, masscan, httpx, amass, dnsx, massdns); auto detects it from the start of the input")
+	flags.StringArrayVarP(&targetsFiles, "targets-file", "f", nil, "File to read targets from instead of stdin, or - for stdin (can be given multiple times)")
+	flags.BoolVar(&trustResolution, "trust-resolution", false, "Use the addresses in massdns and dnsx input instead of resolving hostnames again")
+	flags.BoolVarP(&nmap, "nmap", "m", false, "Parse input as Nmap/Masscan XML (same as --input-format nmap)")
+	flags.BoolVar(&nessus, "nessus", false, "Parse input as Nessus XML (same as --input-format nessus)")
+	flags.BoolVar(&keepFragments, "keep-fragments", false, "Treat URLs that only differ in their #fragment as different pages, like routes of single page apps")
+	flags.BoolVar(&expandWildcards, "expand-wildcards", false, "Look up subdomains of wildcard entries like *.example.com in certificate transparency logs (crt.sh)")
+	flags.BoolVar(&noPortScan, "no-portscan", false, "Don't port scan hosts, only request the URLs and host:port targets of the input")
+
+	flags.StringVarP(&saveBody, "save-body", "b", SaveBodySample, "Save response bodies to files (full, sample, none)")
+	// A bare --save-body saves full bodies, as the flag did when it was a bool
+	flags.Lookup("save-body").NoOptDefVal = SaveBodyFull
+	flags.IntVar(&bodySampleSize, "body-sample-size", 64, "Size in KB of the start of response bodies saved with --save-body sample")
+	flags.BoolVar(&responseStore, "response-store", false, "Store requests, response headers and bodies up to 1 MB in a single compressed file instead of one file each under headers/ and html/")
+	flags.BoolVarP(&silent, "silent", "q", false, "Only print a single line JSON summary when done, and errors to stderr")
+	flags.BoolVar(&noColor, "no-color", false, "Disable colored output")
 	flags.BoolVarP(&debug, "debug", "d", false, "Print debugging information")
+	flags.Int64Var(&seed, "seed", 0, "Seed for random choices like user agents, to make scans reproducible (0 for a random seed)")
+	flags.StringVar(&pprof, "pprof", "", "Serve pprof profiles and runtime traces on the given address (e.g. localhost:6060)")
 	flags.BoolVarP(&version, "version", "v", false, "Print current Aquatone version")
 
 	// Use ExecuteC to capture help invocation
 	// Execute and handle help
 	cmd, err := rootCmd.ExecuteC()
 	if err != nil {
-		os.Exit(1)
+		os.Exit(ExitInvalidOptions)
 	}
 	if cmd.Flags().Changed("help") {
-		os.Exit(0)
+		os.Exit(ExitOK)
+	}
+	// --scan-timeout was the connect timeout, but was raised to at least 5
+	// seconds without saying so
+	if flags.Changed("scan-timeout") && !flags.Changed("connect-timeout") {
+		connectTimeout = scanTimeout
+	}
+
+	switch cmd {
+	case rootCmd:
+	case showCmd:
+		command = CommandShow
+	case selfTestCmd:
+		command = CommandSelfTest
+	case cleanCmd:
+		command = CommandClean
+	case extractCmd:
+		command = CommandExtract
+	case importCmd:
+		command = CommandImport
+	case templateLintCmd:
+		command = CommandTemplateLint
+	default:
+		// Built-in commands like help have already done their job
+		os.Exit(ExitOK)
 	}
 
 	return Options{
-		Threads:           &threads,
-		OutDir:            &outDir,
-		SessionPath:       &sessionPath,
-		TemplatePath:      &templatePath,
-		Proxy:             &proxy,
-		ChromePath:        &chromePath,
-		Resolution:        &resolution,
-		Ports:             &ports,
-		ScanTimeout:       &scanTimeout,
-		HTTPTimeout:       &httpTimeout,
-		ScreenshotTimeout: &screenshotTimeout,
-		Nmap:              &nmap,
-		SaveBody:          &saveBody,
-		Silent:            &silent,
-		Debug:             &debug,
-		Version:           &version,
+		Command:            &command,
+		Threads:            &threads,
+		OutDir:             &outDir,
+		SessionPath:        &sessionPath,
+		SessionFormat:      &sessionFormat,
+		TriagePath:         &triagePath,
+		Meta:               &meta,
+		AuthorizationFile:  &authorizationFile,
+		ReportTitle:        &reportTitle,
+		ReportLogo:         &reportLogo,
+		Baseline:           &baseline,
+		CompareScreenshots: &compareScreenshots,
+		TemplatePath:       &templatePath,
+		FilenameTemplate:   &filenameTemplate,
+		ReportBaseURL:      &reportBaseURL,
+		Proxy:              &proxy,
+		Via:                &via,
+		SourceIP:           &sourceIP,
+		Interface:          &iface,
+		TLSFingerprint:     &tlsFingerprint,
+		ChromePath:         &chromePath,
+		Resolution:         &resolution,
+		Headful:            &headful,
+		Display:            &display,
+		Stealth:            &stealth,
+		Ports:              &ports,
+		ProbeMethod:        &probeMethod,
+		ProbeBody:          &probeBody,
+		ProbeContentType:   &probeContentType,
+		ConnectTimeout:     &connectTimeout,
+		PortReadTimeout:    &portReadTimeout,
+		HTTPTimeout:        &httpTimeout,
+		ScreenshotTimeout:  &screenshotTimeout,
+		ScreenshotOverlay:  &screenshotOverlay,
+		ChromeInstances:    &chromeInstances,
+		MinFreeSpace:       &minFreeSpace,
+		MaxHosts:           &maxHosts,
+		MaxURLs:            &maxURLs,
+		MaxCIDRHosts:       &maxCIDRHosts,
+		MaxClientRedirects: &maxClientRedirects,
+		QuarantineAfter:    &quarantineAfter,
+		MaxRetryAfter:      &maxRetryAfter,
+		MaxPerHost:         &maxPerHost,
+		SPARoutes:          &spaRoutes,
+		FailureThreshold:   &failureThreshold,
+		FailOn:             &failOn,
+		ExportBurp:         &exportBurp,
+		ExportZAP:          &exportZAP,
+		ExportDefectDojo:   &exportDefectDojo,
+		ExportCycloneDX:    &exportCycloneDX,
+		ExportSTIX:         &exportSTIX,
+		ExportNmapXML:      &exportNmapXML,
+		ExportStructure:    &exportStructure,
+		Archive:            &archive,
+		ArchivePassphrase:  &archivePassphrase,
+		JARM:               &jarm,
+		JARMList:           &jarmList,
+		DefaultPageList:    &defaultPageList,
+		VerifyTakeover:     &verifyTakeover,
+		ProbeAPIs:          &probeAPIs,
+		WellKnown:          &wellKnown,
+		LookupASN:          &lookupASN,
+		ProbeExposures:     &probeExposures,
+		ExposureList:       &exposureList,
+		Nmap:               &nmap,
+		Nessus:             &nessus,
+		InputFormat:        &inputFormat,
+		TargetsFiles:       &targetsFiles,
+		TrustResolution:    &trustResolution,
+		ExpandWildcards:    &expandWildcards,
+		NoPortScan:         &noPortScan,
+		KeepFragments:      &keepFragments,
+		SaveBody:           &saveBody,
+		BodySampleSize:     &bodySampleSize,
+		ResponseStore:      &responseStore,
+		Silent:             &silent,
+		NoColor:            &noColor,
+		Debug:              &debug,
+		Seed:               &seed,
+		Pprof:              &pprof,
+		Version:            &version,
+		Listen:             &listen,
+		BasicAuth:          &basicAuth,
+		KeepSessions:       &keepSessions,
+		ExtractWhat:        &extractWhat,
+		ExtractWhere:       &extractWhere,
+		ImportPath:         &importPath,
+		LintPath:           &lintPath,
 	}, nil
 }
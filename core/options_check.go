@@ -0,0 +1,285 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// OptionProblem is an invalid option or combination of options, with a
+// suggestion how to fix it. Code is the exit code the problem ends
+// Aquatone with.
+type OptionProblem struct {
+	Message string
+	Fix     string
+	Code    int
+}
+
+// OptionErrors are the problems found with the options when the session is
+// created. All options are checked before giving up, so every problem can
+// be fixed at once instead of running into them one by one, or halfway
+// through a scan.
+type OptionErrors []OptionProblem
+
+func (e OptionErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Message + "\n" + e[0].Fix
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Found %d problems with the options:\n", len(e))
+	for _, problem := range e {
+		fmt.Fprintf(&b, "\n - %s\n   %s\n", problem.Message, problem.Fix)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// ExitCode returns the exit code of the problems, which is
+// ExitInvalidOptions unless they all have the same other code, like
+// ExitChromeMissing when Chrome is the only problem.
+func (e OptionErrors) ExitCode() int {
+	code := ExitInvalidOptions
+	for i, problem := range e {
+		if i > 0 && problem.Code != code {
+			return ExitInvalidOptions
+		}
+		code = problem.Code
+	}
+	return code
+}
+
+// optionChecker collects the problems found with the options.
+type optionChecker struct {
+	problems OptionErrors
+}
+
+// fail adds a problem with the message, formatted with args, and fix.
+func (c *optionChecker) fail(fix string, format string, args ...interface{}) {
+	c.failWithCode(ExitInvalidOptions, fix, format, args...)
+}
+
+func (c *optionChecker) failWithCode(code int, fix string, format string, args ...interface{}) {
+	c.problems = append(c.problems, OptionProblem{Message: fmt.Sprintf(format, args...), Fix: fix, Code: code})
+}
+
+// check adds err as a problem with fix, and reports whether it was nil.
+func (c *optionChecker) check(err error, fix string) bool {
+	if err == nil {
+		return true
+	}
+	c.fail(fix, "%s", err.Error())
+	return false
+}
+
+// exists adds a problem if the file or directory given for option doesn't
+// exist.
+func (c *optionChecker) exists(path string, what string, option string) {
+	if path == "" {
+		return
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		c.fail(fmt.Sprintf("Check the path given with %s.", option), "%s %s does not exist", what, path)
+	}
+}
+
+// err returns the problems as OptionErrors, or nil if there are none.
+func (c *optionChecker) err() error {
+	if len(c.problems) == 0 {
+		return nil
+	}
+	return c.problems
+}
+
+// resolutionPresets are the screenshot resolutions that can be given by
+// name with --resolution.
+var resolutionPresets = map[string][2]int{
+	"mobile":  {390, 844},
+	"tablet":  {820, 1180},
+	"laptop":  {1440, 900},
+	"desktop": {1920, 1080},
+	"4k":      {3840, 2160},
+}
+
+var resolutionPresetNames = []string{"mobile", "tablet", "laptop", "desktop", "4k"}
+
+// ParseResolution returns the width and height of a screenshot resolution
+// given as width,height or widthxheight, or as the name of a preset.
+func ParseResolution(s string) (int, int, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	if preset, ok := resolutionPresets[value]; ok {
+		return preset[0], preset[1], nil
+	}
+	parts := strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == 'x' })
+	if len(parts) == 2 && strings.Count(value, ",")+strings.Count(value, "x") == 1 {
+		width, errWidth := strconv.Atoi(strings.TrimSpace(parts[0]))
+		height, errHeight := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if errWidth == nil && errHeight == nil && width > 0 && height > 0 {
+			return width, height, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("Invalid resolution %q", s)
+}
+
+// ParsePorts returns the ports of --ports, a list of port numbers or the
+// name of one of the port lists.
+func ParsePorts(s string) ([]int, error) {
+	switch s {
+	case "small":
+		return SmallPortList, nil
+	case "", "medium", "default":
+		return MediumPortList, nil
+	case "large":
+		return LargePortList, nil
+	case "xlarge", "huge":
+		return XLargePortList, nil
+	}
+
+	var ports []int
+	for _, p := range strings.Split(s, ",") {
+		port, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid port %q in port list", strings.TrimSpace(p))
+		}
+		if port < 1 || port > 65535 {
+			return nil, fmt.Errorf("Invalid port given: %v", port)
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+// TLSFingerprintGo keeps the default client TLS fingerprint of Go's
+// crypto/tls package.
+const TLSFingerprintGo = "go"
+
+// TLSFingerprintRandom presents a new randomized client TLS fingerprint for
+// every connection.
+const TLSFingerprintRandom = "random"
+
+// TLSFingerprintNames are the names accepted by --tls-fingerprint. The
+// agents package maps them to the client hellos that are presented.
+var TLSFingerprintNames = []string{TLSFingerprintGo, "chrome", "edge", "firefox", "ios", TLSFingerprintRandom, "safari"}
+
+// IsTLSFingerprint reports whether name is accepted by --tls-fingerprint.
+func IsTLSFingerprint(name string) bool {
+	for _, fingerprint := range TLSFingerprintNames {
+		if name == fingerprint {
+			return true
+		}
+	}
+	return false
+}
+
+// chromePaths are the places Chrome and Chromium are looked for when
+// --chrome-path isn't given. The last one found wins.
+var chromePaths = []string{
+	"/usr/bin/google-chrome",
+	"/usr/bin/google-chrome-beta",
+	"/usr/bin/google-chrome-unstable",
+	"/usr/bin/chromium-browser",
+	"/usr/bin/chromium",
+	"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+	"/Applications/Google Chrome Canary.app/Contents/MacOS/Google Chrome Canary",
+	"/Applications/Chromium.app/Contents/MacOS/Chromium",
+	"C:/Program Files (x86)/Google/Chrome/Application/chrome.exe",
+}
+
+// LocateChrome returns the path of the Chrome or Chromium executable given
+// with --chrome-path, or else of an installation in one of the usual
+// places. It returns an empty string if there is none.
+func LocateChrome(chromePath string) string {
+	if chromePath != "" {
+		return chromePath
+	}
+	var found string
+	for _, path := range chromePaths {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+		found = path
+	}
+	return found
+}
+
+// checkScanOptions checks the options that only matter to scans: the ports,
+// timeouts, TLS fingerprint and Chrome.
+func (c *optionChecker) checkScanOptions(o Options) {
+	if _, err := ParsePorts(*o.Ports); err != nil {
+		c.check(err, "Give ports from 1 to 65535 separated by commas, like --ports 80,443,8080, or one of the lists small, medium, large and xlarge.")
+	}
+
+	if !IsTLSFingerprint(*o.TLSFingerprint) {
+		c.fail(fmt.Sprintf("Give one of the fingerprints %s with --tls-fingerprint.", strings.Join(TLSFingerprintNames, ", ")), "Unknown TLS fingerprint %q", *o.TLSFingerprint)
+	} else if *o.TLSFingerprint != TLSFingerprintGo && *o.Proxy != "" {
+		// HTTPS requests through the proxy are tunneled with CONNECT, and
+		// net/http does the TLS handshake in the tunnel itself
+		c.fail("Leave out --proxy, or tunnel requests through an SSH jump host with --via instead.", "--tls-fingerprint %s can't be used with --proxy, as requests through the proxy keep Go's TLS fingerprint", *o.TLSFingerprint)
+	}
+
+	if *o.ConnectTimeout <= 0 {
+		c.fail("Give the port scan connect timeout in milliseconds, like --connect-timeout 1000.", "Connect timeout must be greater than 0")
+	}
+	if *o.PortReadTimeout < 0 {
+		c.fail("Use 0 with --port-read-timeout to count ports as open as soon as they accept connections.", "Port read timeout must not be negative")
+	}
+	if *o.HTTPTimeout <= 0 {
+		c.fail("Give the HTTP timeout in milliseconds, like --http-timeout 3000.", "HTTP timeout must be greater than 0")
+	}
+	if *o.ScreenshotTimeout <= 0 {
+		c.fail("Give the screenshot timeout in seconds, like --screenshot-timeout 40.", "Screenshot timeout must be greater than 0")
+	} else if *o.HTTPTimeout > *o.ScreenshotTimeout*1000 {
+		c.fail(fmt.Sprintf("Raise --screenshot-timeout to at least %d seconds or lower --http-timeout, which is in milliseconds.", (*o.HTTPTimeout+999)/1000),
+			"Screenshot timeout of %d seconds is shorter than the HTTP timeout of %d milliseconds, so Chrome would give up on pages that load slowly but in time", *o.ScreenshotTimeout, *o.HTTPTimeout)
+	}
+
+	if *o.ChromePath == "" && LocateChrome("") == "" {
+		c.failWithCode(ExitChromeMissing, "Install Chromium or Google Chrome, or give the path of the executable with --chrome-path.", "Unable to locate a valid installation of Chrome")
+	}
+}
+
+// checkOutDir checks that the output directory is a directory that can be
+// written to, or can be created. Nothing is created.
+func (c *optionChecker) checkOutDir(dir string) {
+	const fix = "Give a directory you can write to with --out or AQUATONE_OUT_PATH, or fix the permissions of this one."
+	info, err := os.Stat(dir)
+	if os.IsNotExist(err) {
+		parent := filepath.Dir(dir)
+		for parent != filepath.Dir(parent) {
+			if _, err := os.Stat(parent); err == nil {
+				break
+			}
+			parent = filepath.Dir(parent)
+		}
+		if info, err := os.Stat(parent); err != nil || !info.IsDir() {
+			c.failWithCode(ExitOutputDir, fix, "Output directory %s can't be created, as %s is not a directory", dir, parent)
+		} else if !writableDir(parent) {
+			c.failWithCode(ExitOutputDir, fix, "Output directory %s can't be created in %s", dir, parent)
+		}
+		return
+	}
+	if err != nil {
+		c.failWithCode(ExitOutputDir, fix, "Unable to use output directory %s: %v", dir, err)
+		return
+	}
+	if !info.IsDir() {
+		c.failWithCode(ExitOutputDir, fix, "Output destination %s must be a directory", dir)
+		return
+	}
+	if !writableDir(dir) {
+		c.failWithCode(ExitOutputDir, fix, "Output directory %s is not writable", dir)
+	}
+}
+
+// writableDir reports whether files can be created in dir, by creating and
+// removing one.
+func writableDir(dir string) bool {
+	f, err := ioutil.TempFile(dir, ".aquatone-check-")
+	if err != nil {
+		return false
+	}
+	f.Close()
+	os.Remove(f.Name())
+	return true
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func parseOptionsWithArgs(t *testing.T, args ...string) Options {
+	t.Helper()
+	osArgs := os.Args
+	defer func() { os.Args = osArgs }()
+	os.Args = append([]string{"aquatone"}, args...)
+
+	options, err := ParseOptions()
+	if err != nil {
+		t.Fatalf("ParseOptions(%q) failed: %v", args, err)
+	}
+	return options
+}
+
+func TestParseOptionsSaveBody(t *testing.T) {
+	tests := []struct {
+		args    []string
+		command string
+		want    string
+	}{
+		{nil, "", SaveBodySample},
+		{[]string{"--save-body"}, "", SaveBodyFull},
+		{[]string{"-b"}, "", SaveBodyFull},
+		{[]string{"--save-body", "show"}, CommandShow, SaveBodyFull},
+		{[]string{"-b", "show"}, CommandShow, SaveBodyFull},
+		{[]string{"--save-body=none"}, "", SaveBodyNone},
+		{[]string{"--save-body=sample", "show"}, CommandShow, SaveBodySample},
+		{[]string{"-b=none"}, "", SaveBodyNone},
+	}
+	for _, test := range tests {
+		options := parseOptionsWithArgs(t, test.args...)
+		if *options.Command != test.command || *options.SaveBody != test.want {
+			t.Errorf("ParseOptions(%q) = command %q, save-body %q; want %q, %q", test.args, *options.Command, *options.SaveBody, test.command, test.want)
+		}
+	}
+}
+
+func TestCheckScanOptionsReportsTLSFingerprintWithOtherProblems(t *testing.T) {
+	options := parseOptionsWithArgs(t, "--tls-fingerprint", "netscape", "--ports", "0")
+	var c optionChecker
+	c.checkScanOptions(options)
+
+	var fingerprint, ports bool
+	for _, problem := range c.problems {
+		fingerprint = fingerprint || strings.Contains(problem.Message, `Unknown TLS fingerprint "netscape"`)
+		ports = ports || strings.Contains(problem.Message, "Invalid port")
+	}
+	if !fingerprint || !ports {
+		t.Errorf("problems = %v; want both the TLS fingerprint and the ports", c.problems)
+	}
+
+	for _, name := range TLSFingerprintNames {
+		options := parseOptionsWithArgs(t, "--tls-fingerprint", name)
+		var c optionChecker
+		c.checkScanOptions(options)
+		for _, problem := range c.problems {
+			if strings.Contains(problem.Message, "TLS fingerprint") {
+				t.Errorf("--tls-fingerprint %s: %s", name, problem.Message)
+			}
+		}
+	}
+}
+
+func TestCheckScanOptionsRejectsTLSFingerprintWithProxy(t *testing.T) {
+	tests := []struct {
+		args []string
+		fail bool
+	}{
+		{[]string{"--tls-fingerprint", "chrome", "--proxy", "http://127.0.0.1:8080"}, true},
+		{[]string{"--tls-fingerprint", "random", "-x", "http://127.0.0.1:8080"}, true},
+		{[]string{"--tls-fingerprint", "go", "--proxy", "http://127.0.0.1:8080"}, false},
+		{[]string{"--proxy", "http://127.0.0.1:8080"}, false},
+		{[]string{"--tls-fingerprint", "chrome"}, false},
+	}
+	for _, test := range tests {
+		var c optionChecker
+		c.checkScanOptions(parseOptionsWithArgs(t, test.args...))
+		failed := false
+		for _, problem := range c.problems {
+			failed = failed || strings.Contains(problem.Message, "can't be used with --proxy")
+		}
+		if failed != test.fail {
+			t.Errorf("%q: rejected = %v; want %v (problems: %v)", test.args, failed, test.fail, c.problems)
+		}
+	}
+}
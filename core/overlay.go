@@ -0,0 +1,111 @@
+package core
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Layout of the screenshot overlay, in pixels.
+const (
+	overlayPadding    = 6
+	overlayLineHeight = 15
+	overlayMargin     = 8
+)
+
+var overlayBackground = color.NRGBA{R: 0, G: 0, B: 0, A: 0xc0}
+
+// ScreenshotOverlay returns the lines burned into the screenshot of a page
+// with --screenshot-overlay: its URL, and its status with the time the
+// screenshot was taken, so screenshots copied into documents on their own
+// still say what they show.
+func (s *Session) ScreenshotOverlay(page *Page) []string {
+	status := page.Status
+	if status == "" {
+		status = "no status"
+	}
+	return []string{
+		page.URL,
+		status + " | " + s.Clock.Now().UTC().Format("2006-01-02 15:04:05 MST"),
+	}
+}
+
+// DrawOverlay draws the lines in white on a dark box in the bottom left
+// corner of the PNG or JPEG image at path, and writes it back in the same
+// format. Lines that don't fit the width of the image are shortened.
+func DrawOverlay(path string, lines []string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	src, format, err := image.Decode(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	bounds := src.Bounds()
+	img := image.NewRGBA(bounds)
+	draw.Draw(img, bounds, src, bounds.Min, draw.Src)
+
+	face := basicfont.Face7x13
+	maxWidth := bounds.Dx() - 2*overlayMargin - 2*overlayPadding
+	fitted := make([]string, len(lines))
+	width := 0
+	for i, line := range lines {
+		fitted[i] = fitOverlayLine(face, line, maxWidth)
+		if w := font.MeasureString(face, fitted[i]).Ceil(); w > width {
+			width = w
+		}
+	}
+	box := image.Rect(0, 0, width+2*overlayPadding, len(lines)*overlayLineHeight+2*overlayPadding)
+	box = box.Add(image.Pt(bounds.Min.X+overlayMargin, bounds.Max.Y-overlayMargin-box.Dy())).Intersect(bounds)
+	draw.Draw(img, box, image.NewUniform(overlayBackground), image.Point{}, draw.Over)
+
+	drawer := &font.Drawer{Dst: img, Src: image.White, Face: face}
+	for i, line := range fitted {
+		drawer.Dot = fixed.P(box.Min.X+overlayPadding, box.Min.Y+overlayPadding+i*overlayLineHeight+face.Ascent)
+		drawer.DrawString(line)
+	}
+
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if format == "jpeg" {
+		err = jpeg.Encode(out, img, &jpeg.Options{Quality: 90})
+	} else {
+		err = png.Encode(out, img)
+	}
+	if err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// fitOverlayLine shortens the line with an ellipsis until it fits in width
+// pixels. The font only has ASCII glyphs, so other characters are replaced.
+func fitOverlayLine(face font.Face, line string, width int) string {
+	line = strings.Map(func(r rune) rune {
+		if r < ' ' || r > '~' {
+			return '?'
+		}
+		return r
+	}, line)
+	if font.MeasureString(face, line).Ceil() <= width {
+		return line
+	}
+	for len(line) > 0 && font.MeasureString(face, line+"...").Ceil() > width {
+		line = line[:len(line)-1]
+	}
+	return line + "..."
+}
@@ -2,12 +2,15 @@ package core
 
 import (
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"net"
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -76,6 +79,33 @@ func (h Header) increasesSecurity() bool {
 	return false
 }
 
+func NewHeader(name string, value string) Header {
+	header := Header{
+		Name:  name,
+		Value: value,
+	}
+	header.SetSecurityFlags()
+	return header
+}
+
+// RedirectHop is an intermediate response in a redirect chain that
+// eventually led to the page.
+type RedirectHop struct {
+	URL         string   `json:"url"`
+	Status      string   `json:"status"`
+	HeadersPath string   `json:"headersPath"`
+	Headers     []Header `json:"headers"`
+}
+
+func (h RedirectHop) HasHeader(name string) bool {
+	for _, header := range h.Headers {
+		if strings.EqualFold(header.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
 type Tag struct {
 	Text string `json:"text"`
 	Type string `json:"type"`
@@ -90,38 +120,209 @@ func (t Tag) HasLink() bool {
 	return false
 }
 
-type Note struct {
-	Text string `json:"text"`
+type Certificate struct {
+	Subject      string    `json:"subject"`
+	Issuer       string    `json:"issuer"`
+	SerialNumber string    `json:"serialNumber"`
+	NotBefore    time.Time `json:"notBefore"`
+	NotAfter     time.Time `json:"notAfter"`
+	DNSNames     []string  `json:"dnsNames"`
+	SHA256       string    `json:"sha256"`
+}
+
+func NewCertificate(cert *x509.Certificate) *Certificate {
+	return &Certificate{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SerialNumber: cert.SerialNumber.String(),
+		NotBefore:    cert.NotBefore,
+		NotAfter:     cert.NotAfter,
+		DNSNames:     cert.DNSNames,
+		SHA256:       fmt.Sprintf("%x", sha256.Sum256(cert.Raw)),
+	}
+}
+
+// Backend is the response of one of the addresses a load balanced
+// hostname resolves to.
+type Backend struct {
+	Addr     string `json:"addr"`
+	Status   string `json:"status"`
+	BodySize int64  `json:"bodySize"`
+	Error    string `json:"error"`
+}
+
+const (
+	AssetFavicon    = "favicon"
+	AssetStylesheet = "stylesheet"
+	AssetScript     = "script"
+)
+
+// StaticAsset is a static file referenced by a page, hashed to correlate
+// hostnames that serve identical files.
+type StaticAsset struct {
+	Type        string `json:"type"`
+	URL         string `json:"url"`
+	SHA256      string `json:"sha256"`
+	FaviconHash int32  `json:"faviconHash,omitempty"`
+}
+
+type FormInput struct {
+	Name string `json:"name"`
 	Type string `json:"type"`
 }
 
+type Form struct {
+	Method        string      `json:"method"`
+	Action        string      `json:"action"`
+	Inputs        []FormInput `json:"inputs"`
+	HasPassword   bool        `json:"hasPassword"`
+	HasFileUpload bool        `json:"hasFileUpload"`
+	HasCSRFToken  bool        `json:"hasCsrfToken"`
+}
+
+type Technology struct {
+	Name       string   `json:"name"`
+	Version    string   `json:"version"`
+	Categories []string `json:"categories"`
+	Website    string   `json:"website"`
+}
+
+// Severities of notes, from things worth knowing about to issues that need
+// to be looked at first.
+const (
+	SeverityInfo   = "info"
+	SeverityLow    = "low"
+	SeverityMedium = "medium"
+	SeverityHigh   = "high"
+)
+
+// noteTypes are the tag types of note severities, kept in Type for readers
+// of session files that predate severities.
+var noteTypes = map[string]string{
+	SeverityInfo:   "info",
+	SeverityLow:    "warning",
+	SeverityMedium: "warning",
+	SeverityHigh:   "danger",
+}
+
+type Note struct {
+	Text     string `json:"text"`
+	Type     string `json:"type"`
+	Severity string `json:"severity"`
+}
+
+// NewNote returns a note with the given severity.
+func NewNote(text string, severity string) Note {
+	noteType, ok := noteTypes[severity]
+	if !ok {
+		severity, noteType = SeverityInfo, "info"
+	}
+	return Note{Text: text, Type: noteType, Severity: severity}
+}
+
+// Level returns the severity of the note. Notes of session files that
+// predate severities get one from their type.
+func (n Note) Level() string {
+	if n.Severity != "" {
+		return n.Severity
+	}
+	switch n.Type {
+	case "danger":
+		return SeverityHigh
+	case "warning":
+		return SeverityLow
+	}
+	return SeverityInfo
+}
+
 type Page struct {
 	sync.Mutex
-	UUID           string   `json:"uuid"`
-	URL            string   `json:"url"`
-	Hostname       string   `json:"hostname"`
-	Addrs          []string `json:"addrs"`
-	Status         string   `json:"status"`
-	PageTitle      string   `json:"pageTitle"`
-	PageStructure  []string `json:"-"`
-	HeadersPath    string   `json:"headersPath"`
-	BodyPath       string   `json:"bodyPath"`
-	ScreenshotPath string   `json:"screenshotPath"`
-	HasScreenshot  bool     `json:"hasScreenshot"`
-	Headers        []Header `json:"headers"`
-	Tags           []Tag    `json:"tags"`
-	Notes          []Note   `json:"notes"`
+	UUID               string           `json:"uuid"`
+	URL                string           `json:"url"`
+	Hostname           string           `json:"hostname"`
+	Filename           string           `json:"filename,omitempty"`
+	Addrs              []string         `json:"addrs"`
+	Status             string           `json:"status"`
+	ResponseTime       int64            `json:"responseTime"`
+	RateLimited        bool             `json:"rateLimited,omitempty"`
+	PageTitle          string           `json:"pageTitle"`
+	PageStructure      []string         `json:"-"`
+	RequestPath        string           `json:"requestPath"`
+	HeadersPath        string           `json:"headersPath"`
+	BodyPath           string           `json:"bodyPath"`
+	BodySize           int64            `json:"bodySize"`
+	BodySampled        bool             `json:"bodySampled,omitempty"`
+	BodyHash           string           `json:"bodyHash"`
+	CompressedBodySize int64            `json:"compressedBodySize"`
+	ContentEncoding    string           `json:"contentEncoding"`
+	ScreenshotPath     string           `json:"screenshotPath"`
+	HasScreenshot      bool             `json:"hasScreenshot"`
+	ScreenshotHash     string           `json:"screenshotHash"`
+	RenderedURL        string           `json:"renderedUrl,omitempty"`
+	RenderedTitle      string           `json:"renderedTitle,omitempty"`
+	RenderedElsewhere  bool             `json:"renderedElsewhere,omitempty"`
+	Baseline           string           `json:"baseline,omitempty"`
+	Class              string           `json:"class,omitempty"`
+	Flagged            bool             `json:"flagged,omitempty"`
+	Hidden             bool             `json:"hidden,omitempty"`
+	Headers            []Header         `json:"headers"`
+	Certificate        *Certificate     `json:"certificate"`
+	AuthChallenges     []AuthChallenge  `json:"authChallenges,omitempty"`
+	JARM               string           `json:"jarm"`
+	Backends           []Backend        `json:"backends"`
+	RedirectChain      []RedirectHop    `json:"redirectChain"`
+	ClientRedirects    []ClientRedirect `json:"clientRedirects,omitempty"`
+	FrameOf            string           `json:"frameOf,omitempty"`
+	Frames             []string         `json:"frames,omitempty"`
+	Routes             []string         `json:"routes,omitempty"`
+	ServiceWorker      string           `json:"serviceWorker,omitempty"`
+	APIDocuments       []APIDocument    `json:"apiDocuments,omitempty"`
+	WellKnown          []WellKnownURI   `json:"wellKnown,omitempty"`
+	Exposures          []Exposure       `json:"exposures,omitempty"`
+	Takeover           *Takeover        `json:"takeover,omitempty"`
+	Tags               []Tag            `json:"tags"`
+	Technologies       []Technology     `json:"technologies"`
+	Assets             []StaticAsset    `json:"assets"`
+	Forms              []Form           `json:"forms"`
+	Contacts           []Contact        `json:"contacts"`
+	Notes              []Note           `json:"notes"`
 }
 
 func (p *Page) AddHeader(name string, value string) {
 	p.Lock()
 	defer p.Unlock()
-	header := Header{
-		Name:  name,
-		Value: value,
+	p.Headers = append(p.Headers, NewHeader(name, value))
+}
+
+func (p *Page) HasHeader(name string) bool {
+	p.Lock()
+	defer p.Unlock()
+	for _, header := range p.Headers {
+		if strings.EqualFold(header.Name, name) {
+			return true
+		}
 	}
-	header.SetSecurityFlags()
-	p.Headers = append(p.Headers, header)
+	return false
+}
+
+func (p *Page) AddRedirectHop(hop RedirectHop) {
+	p.Lock()
+	defer p.Unlock()
+	p.RedirectChain = append(p.RedirectChain, hop)
+}
+
+// AddFrame records the URL of a frame of the page, which is scanned as a
+// page of its own.
+func (p *Page) AddFrame(url string) {
+	p.Lock()
+	defer p.Unlock()
+	p.Frames = append(p.Frames, url)
+}
+
+func (p *Page) AddBackend(backend Backend) {
+	p.Lock()
+	defer p.Unlock()
+	p.Backends = append(p.Backends, backend)
 }
 
 func (p *Page) AddTag(text string, tagType string, link string) {
@@ -141,16 +342,57 @@ func (p *Page) AddTag(text string, tagType string, link string) {
 	})
 }
 
-func (p *Page) AddNote(text string, noteType string) {
+func (p *Page) HasTag(text string) bool {
 	p.Lock()
 	defer p.Unlock()
-	p.Notes = append(p.Notes, Note{
-		Text: text,
-		Type: noteType,
+	for _, tag := range p.Tags {
+		if tag.Text == text {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Page) AddTechnology(name string, version string, categories []string, website string) {
+	p.Lock()
+	defer p.Unlock()
+	p.Technologies = append(p.Technologies, Technology{
+		Name:       name,
+		Version:    version,
+		Categories: categories,
+		Website:    website,
 	})
 }
 
+func (p *Page) AddAsset(asset StaticAsset) {
+	p.Lock()
+	defer p.Unlock()
+	p.Assets = append(p.Assets, asset)
+}
+
+func (p *Page) AddForm(form Form) {
+	p.Lock()
+	defer p.Unlock()
+	p.Forms = append(p.Forms, form)
+}
+
+func (p *Page) AddContact(contact Contact) {
+	p.Lock()
+	defer p.Unlock()
+	p.Contacts = append(p.Contacts, contact)
+}
+
+func (p *Page) AddNote(text string, severity string) {
+	p.Lock()
+	defer p.Unlock()
+	p.Notes = append(p.Notes, NewNote(text, severity))
+}
+
 func (p *Page) BaseFilename() string {
+	if p.Filename != "" {
+		return p.Filename
+	}
+
 	u := p.ParsedURL()
 	h := sha1.New()
 	io.WriteString(h, u.Path)
@@ -159,7 +401,7 @@ func (p *Page) BaseFilename() string {
 	pathHash := fmt.Sprintf("%x", h.Sum(nil))[0:16]
 	host := strings.Replace(u.Host, ":", "__", 1)
 	filename := fmt.Sprintf("%s__%s__%s", u.Scheme, strings.Replace(host, ".", "_", -1), pathHash)
-	return strings.ToLower(filename)
+	return strings.ToLower(unsafeFilenameChars.ReplaceAllString(filename, "_"))
 }
 
 func (p *Page) ParsedURL() *url.URL {
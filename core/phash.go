@@ -0,0 +1,84 @@
+package core
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"math/bits"
+	"strconv"
+
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// PerceptualHash computes a 64 bit difference hash of an image and returns
+// it as a hex string. Images that look alike get hashes with a small
+// Hamming distance, even when they differ in small details like a date or
+// a session token.
+func PerceptualHash(r io.Reader) (string, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return "", err
+	}
+
+	// Scale the image down to 9x8 grayscale pixels, then set a bit for
+	// every pixel that is brighter than its right neighbour.
+	const width, height = 9, 8
+	gray := grayscaleGrid(img, width, height)
+
+	var hash uint64
+	for y := 0; y < height; y++ {
+		for x := 0; x < width-1; x++ {
+			hash <<= 1
+			if gray[y][x] > gray[y][x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return fmt.Sprintf("%016x", hash), nil
+}
+
+// grayscaleGrid scales an image down to width x height grayscale pixels by
+// averaging, with values between 0 and 65535.
+func grayscaleGrid(img image.Image, width int, height int) [][]float64 {
+	bounds := img.Bounds()
+	gray := make([][]float64, height)
+	for y := 0; y < height; y++ {
+		gray[y] = make([]float64, width)
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := bounds.Min.Y + (y+1)*bounds.Dy()/height
+		if y1 == y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := bounds.Min.X + (x+1)*bounds.Dx()/width
+			if x1 == x0 {
+				x1 = x0 + 1
+			}
+			var sum float64
+			for py := y0; py < y1 && py < bounds.Max.Y; py++ {
+				for px := x0; px < x1 && px < bounds.Max.X; px++ {
+					r, g, b, _ := img.At(px, py).RGBA()
+					sum += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)
+				}
+			}
+			gray[y][x] = sum / float64((y1-y0)*(x1-x0))
+		}
+	}
+	return gray
+}
+
+// PerceptualHashDistance returns the number of differing bits between two
+// hashes returned by PerceptualHash, or -1 if either hash is invalid.
+func PerceptualHashDistance(a string, b string) int {
+	x, err := strconv.ParseUint(a, 16, 64)
+	if err != nil {
+		return -1
+	}
+	y, err := strconv.ParseUint(b, 16, 64)
+	if err != nil {
+		return -1
+	}
+	return bits.OnesCount64(x ^ y)
+}
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// ParseProbeMethod returns the --probe-method for the given value, in upper
+// case. Any method is accepted, as some APIs answer custom ones.
+func ParseProbeMethod(s string) (string, error) {
+	method := strings.ToUpper(strings.TrimSpace(s))
+	if method == "" {
+		return "", fmt.Errorf("Probe method must not be empty")
+	}
+	for _, c := range method {
+		if (c < 'A' || c > 'Z') && c != '-' && c != '_' {
+			return "", fmt.Errorf("Invalid probe method %q", s)
+		}
+	}
+	return method, nil
+}
+
+// ReadProbeBody returns the --probe-body for the given value, which is read
+// from a file when it starts with @, like with curl -d.
+func ReadProbeBody(s string) (string, error) {
+	if !strings.HasPrefix(s, "@") {
+		return s, nil
+	}
+	body, err := ioutil.ReadFile(s[1:])
+	if err != nil {
+		return "", fmt.Errorf("Unable to read probe body: %v", err)
+	}
+	return string(body), nil
+}
@@ -0,0 +1,83 @@
+package core
+
+import "sort"
+
+// ReasonQuarantined is the failure reason of URLs that were skipped because
+// their host was quarantined.
+const ReasonQuarantined = "quarantined"
+
+// HostHealth holds the outcome of the port scan connections and HTTP
+// requests to a host. Hosts that keep timing out, like those behind a
+// firewall that drops everything, are quarantined after --quarantine-after
+// consecutive failures, and their remaining ports and URLs are skipped
+// instead of each waiting out its timeout.
+type HostHealth struct {
+	Attempts            int     `json:"attempts"`
+	Failures            int     `json:"failures"`
+	ErrorRate           float64 `json:"errorRate"`
+	ConsecutiveFailures int     `json:"consecutiveFailures"`
+	LastReason          string  `json:"lastReason,omitempty"`
+	Quarantined         bool    `json:"quarantined,omitempty"`
+}
+
+// RecordHostResult records the failure reason of a connection or request to
+// a host, or an empty reason when it succeeded, and reports whether the host
+// got quarantined because of it. Only timeouts and connection resets count
+// as failures; a refused connection means the host is there and answers
+// quickly, and ends a run of failures like a success does. Hosts that
+// answered at all are never quarantined, as firewalls commonly drop
+// connections to closed ports, which makes hosts with open ports time out
+// on the rest of them.
+func (s *Session) RecordHostResult(host string, reason string) bool {
+	s.Lock()
+	defer s.Unlock()
+	if s.HostHealth == nil {
+		s.HostHealth = make(map[string]*HostHealth)
+	}
+	key := hostAddrsKey(host)
+	health, ok := s.HostHealth[key]
+	if !ok {
+		health = &HostHealth{}
+		s.HostHealth[key] = health
+	}
+
+	failed := reason == ReasonTimeout || reason == ReasonReset
+	health.Attempts++
+	if failed {
+		health.Failures++
+		health.ConsecutiveFailures++
+		health.LastReason = reason
+	} else {
+		health.ConsecutiveFailures = 0
+	}
+	health.ErrorRate = float64(health.Failures) / float64(health.Attempts)
+
+	limit := *s.Options.QuarantineAfter
+	if limit <= 0 || health.Quarantined || health.ConsecutiveFailures < limit || health.Failures < health.Attempts {
+		return false
+	}
+	health.Quarantined = true
+	return true
+}
+
+// HostQuarantined reports whether the host was quarantined.
+func (s *Session) HostQuarantined(host string) bool {
+	s.Lock()
+	defer s.Unlock()
+	health, ok := s.HostHealth[hostAddrsKey(host)]
+	return ok && health.Quarantined
+}
+
+// QuarantinedHosts returns the quarantined hosts in sorted order.
+func (s *Session) QuarantinedHosts() []string {
+	s.Lock()
+	defer s.Unlock()
+	var hosts []string
+	for host, health := range s.HostHealth {
+		if health.Quarantined {
+			hosts = append(hosts, host)
+		}
+	}
+	sort.Strings(hosts)
+	return hosts
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"net/url"
+	"strings"
+)
+
+// SetRendered records the URL and title of the page in Chrome at the time of
+// its screenshot. These can differ from the URL and title seen over HTTP,
+// when a script sends the browser elsewhere, like to a single sign-on login,
+// or sets the title of a single page app. A page is flagged as rendered
+// elsewhere when Chrome ended up at another URL than the requests did.
+func (p *Page) SetRendered(renderedURL string, title string) {
+	p.Lock()
+	defer p.Unlock()
+	p.RenderedURL = renderedURL
+	p.RenderedTitle = strings.Join(strings.Fields(title), " ")
+	p.RenderedElsewhere = renderedURL != "" &&
+		!sameURL(renderedURL, p.responseURL()) &&
+		!sameURL(renderedURL, p.DestinationURL())
+}
+
+// responseURL returns the URL the final response of the page was received
+// from, after following its HTTP redirects.
+func (p *Page) responseURL() string {
+	if len(p.RedirectChain) == 0 {
+		return p.URL
+	}
+	hop := p.RedirectChain[len(p.RedirectChain)-1]
+	base, err := url.Parse(hop.URL)
+	if err != nil {
+		return p.URL
+	}
+	for _, header := range hop.Headers {
+		if strings.EqualFold(header.Name, "Location") {
+			if location, err := base.Parse(strings.TrimSpace(header.Value)); err == nil {
+				return location.String()
+			}
+		}
+	}
+	return p.URL
+}
+
+// sameURL reports whether two URLs lead to the same page, ignoring their
+// fragments, default ports and the case of their scheme and host.
+func sameURL(a string, b string) bool {
+	return normalizeURL(a) == normalizeURL(b)
+}
+
+func normalizeURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if (u.Scheme == "http" && u.Port() == "80") || (u.Scheme == "https" && u.Port() == "443") {
+		u.Host = u.Hostname()
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	return u.String()
+}
@@ -1,23 +1,41 @@
 package core
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"html/template"
 	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
 )
 
 type Report struct {
 	Session  *Session
 	Template string
+	BaseURL  string
+	Title    string
+	Logo     string
+
+	// Comparisons and ComparedWith are set for the screenshot comparison
+	// gallery written with --compare-screenshots
+	Comparisons  []ScreenshotComparison
+	ComparedWith string
+
+	// StoredFiles are the files kept in the response store, which reports
+	// opened from disk can't link to
+	StoredFiles []string
 }
 
 func (r *Report) Render(dest io.Writer) error {
-	funcMap := template.FuncMap{
-		"json": func(json string) template.JS {
-			return template.JS(json)
-		},
+	logo, err := r.logoURL()
+	if err != nil {
+		return err
 	}
 
-	tmpl, err := template.New("Aquatone Report").Funcs(funcMap).Parse(r.Template)
+	tmpl, err := template.New("Aquatone Report").Funcs(r.funcs(logo)).Parse(r.Template)
 	if err != nil {
 		return err
 	}
@@ -30,6 +48,73 @@ func (r *Report) Render(dest io.Writer) error {
 	return nil
 }
 
+// funcs returns the functions available to report templates.
+func (r *Report) funcs(logo template.URL) template.FuncMap {
+	return template.FuncMap{
+		"json": func(json string) template.JS {
+			return template.JS(json)
+		},
+		"reportBaseURL": func() string {
+			return r.BaseURL
+		},
+		"reportTitle": func() string {
+			return r.Title
+		},
+		"reportLogo": func() template.URL {
+			return logo
+		},
+		"fileURL": func(name string) string {
+			if r.BaseURL == "" {
+				return name
+			}
+			return strings.TrimRight(r.BaseURL, "/") + "/" + strings.TrimLeft(name, "/")
+		},
+		"storedFiles": func() (template.JS, error) {
+			names := r.StoredFiles
+			if names == nil {
+				names = []string{}
+			}
+			b, err := json.Marshal(names)
+			return template.JS(b), err
+		},
+		"screenshotComparisons": func() []ScreenshotComparison {
+			return r.Comparisons
+		},
+		"changedComparisons": func() int {
+			changed := 0
+			for _, c := range r.Comparisons {
+				if c.Changed() {
+					changed++
+				}
+			}
+			return changed
+		},
+		"comparedWith": func() string {
+			return r.ComparedWith
+		},
+	}
+}
+
+// logoURL returns the logo image as a data URL, so the report stays a
+// single file that can be handed over as is.
+func (r *Report) logoURL() (template.URL, error) {
+	if r.Logo == "" {
+		return "", nil
+	}
+	data, err := ioutil.ReadFile(r.Logo)
+	if err != nil {
+		return "", fmt.Errorf("unable to read report logo: %v", err)
+	}
+	contentType := http.DetectContentType(data)
+	if strings.ToLower(filepath.Ext(r.Logo)) == ".svg" {
+		contentType = "image/svg+xml"
+	}
+	if !strings.HasPrefix(contentType, "image/") {
+		return "", fmt.Errorf("report logo %s is not an image", r.Logo)
+	}
+	return template.URL("data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(data)), nil
+}
+
 func NewReport(s *Session, templ string) *Report {
 	return &Report{
 		Session:  s,
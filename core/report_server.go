@@ -0,0 +1,153 @@
+package core
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ReportServer serves an output directory over HTTP so the report can be
+// viewed without copying the screenshots. Only regular files inside the
+// directory are served and response bodies and headers saved from the
+// targets are sent as plain text, so they can't run scripts in the origin
+// of the report. Headers and bodies kept in the response store are served
+// as if they were files.
+type ReportServer struct {
+	root     string
+	user     string
+	password string
+	out      *Logger
+	store    *ResponseStore
+}
+
+// NewReportServer returns a server for the output directory at root. If auth
+// is given as user:password, requests must use HTTP basic authentication.
+func NewReportServer(root string, auth string, out *Logger) (*ReportServer, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(root, "aquatone_report.html")); err != nil {
+		return nil, fmt.Errorf("No report found in %s", root)
+	}
+
+	server := &ReportServer{root: root, out: out}
+	if ResponseStoreExists(root) {
+		if server.store, err = OpenResponseStore(root); err != nil {
+			return nil, fmt.Errorf("Unable to open response store in %s: %v", root, err)
+		}
+	}
+	if auth != "" {
+		i := strings.Index(auth, ":")
+		if i < 1 {
+			return nil, fmt.Errorf("Basic authentication must be given as user:password")
+		}
+		server.user = auth[:i]
+		server.password = auth[i+1:]
+	}
+	return server, nil
+}
+
+func (s *ReportServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.out.Debug("[show] %s %s %s\n", r.RemoteAddr, r.Method, r.URL.Path)
+
+	if s.user != "" && !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Aquatone"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := path.Clean("/" + r.URL.Path)
+	if name == "/" {
+		name = "/aquatone_report.html"
+	}
+
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if strings.HasPrefix(name, "/html/") || strings.HasPrefix(name, "/headers/") {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Content-Security-Policy", "sandbox")
+	}
+
+	f, info, ok := s.open(name)
+	if !ok {
+		if content, modTime, ok := s.openStored(name); ok {
+			http.ServeContent(w, r, name, modTime, bytes.NewReader(content))
+			return
+		}
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+	http.ServeContent(w, r, name, info.ModTime(), f)
+}
+
+// openStored reads the file for the cleaned request path from the response
+// store. The modification time of the store is used for the file.
+func (s *ReportServer) openStored(name string) ([]byte, time.Time, bool) {
+	if s.store == nil || !s.store.Has(strings.TrimPrefix(name, "/")) {
+		return nil, time.Time{}, false
+	}
+	content, err := s.store.Get(strings.TrimPrefix(name, "/"))
+	if err != nil {
+		s.out.Debug("[show] Unable to read %s from response store: %v\n", name, err)
+		return nil, time.Time{}, false
+	}
+	info, err := s.store.data.Stat()
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	return content, info.ModTime(), true
+}
+
+func (s *ReportServer) authorized(r *http.Request) bool {
+	user, password, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+	userOK := subtle.ConstantTimeCompare([]byte(user), []byte(s.user)) == 1
+	passwordOK := subtle.ConstantTimeCompare([]byte(password), []byte(s.password)) == 1
+	return userOK && passwordOK
+}
+
+// open opens the file for the cleaned request path. Hidden files, directories
+// and symlinks pointing outside of the output directory are not served.
+func (s *ReportServer) open(name string) (*os.File, os.FileInfo, bool) {
+	for _, segment := range strings.Split(name, "/") {
+		if strings.HasPrefix(segment, ".") {
+			return nil, nil, false
+		}
+	}
+
+	p, err := filepath.EvalSymlinks(filepath.Join(s.root, filepath.FromSlash(name)))
+	if err != nil {
+		return nil, nil, false
+	}
+	if rel, err := filepath.Rel(s.root, p); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, nil, false
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, nil, false
+	}
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		f.Close()
+		return nil, nil, false
+	}
+	return f, info, true
+}
@@ -0,0 +1,27 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestReportStoredFiles(t *testing.T) {
+	tests := []struct {
+		files []string
+		want  string
+	}{
+		{nil, "const storedFiles = new Set([]);"},
+		{[]string{"headers/a.txt", "html/a.html"}, `const storedFiles = new Set(["headers/a.txt","html/a.html"]);`},
+	}
+	for _, test := range tests {
+		report := NewReport(testSession(), "<script>const storedFiles = new Set({{storedFiles}});</script>")
+		report.StoredFiles = test.files
+		var buf bytes.Buffer
+		if err := report.Render(&buf); err != nil {
+			t.Fatalf("Render() failed: %v", err)
+		}
+		if got := buf.String(); got != "<script>"+test.want+"</script>" {
+			t.Errorf("rendered %q; want %q", got, test.want)
+		}
+	}
+}
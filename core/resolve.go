@@ -0,0 +1,411 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// TTLs of cached lookups whose records don't come with one: names resolved
+// by the system resolver, like names without a dot that need the search
+// domains, and failed lookups without an SOA record for negative caching.
+// Entries of /etc/hosts don't change during a scan.
+const (
+	dnsFallbackTTL = time.Minute
+	dnsHostsTTL    = 24 * time.Hour
+)
+
+// dnsCacheEntry is a cached lookup of a host, or of the names of an address
+// in its PTR records or the strings of TXT records, which are kept in
+// addrs. done is closed once the
+// lookup is finished, so agents asking for the same host at the same time
+// wait for one lookup instead of sending their own.
+type dnsCacheEntry struct {
+	done    chan struct{}
+	addrs   []string
+	cname   string
+	err     error
+	expires time.Time
+}
+
+// dnsCache holds the lookups of hosts of the session, until the TTL of the
+// records they came from runs out. Port scanner, hostname resolver and
+// takeover and dangling DNS detectors all look up every host, which now
+// costs one query per record type instead of one per agent.
+type dnsCache struct {
+	sync.Mutex
+	entries map[string]*dnsCacheEntry
+	hosts   map[string][]string
+	server  string
+	direct  bool
+	lookups uint32
+	hits    uint32
+}
+
+func newDNSCache() *dnsCache {
+	server, ok := SystemDNSServer()
+	return &dnsCache{
+		entries: make(map[string]*dnsCacheEntry),
+		hosts:   readHostsFile("/etc/hosts"),
+		server:  server,
+		direct:  ok,
+	}
+}
+
+// AddHostAddrs records the addresses a hostname resolves to, given by input
+// like Amass output that includes them, so the hostname doesn't have to be
+// looked up again.
+func (s *Session) AddHostAddrs(host string, addrs []string) {
+	if len(addrs) == 0 {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.hostAddrs[hostAddrsKey(host)] = addrs
+}
+
+// LookupHost returns the addresses of the host recorded with AddHostAddrs,
+// or looks them up through the DNS cache of the session.
+func (s *Session) LookupHost(host string) ([]string, error) {
+	s.Lock()
+	addrs, ok := s.hostAddrs[hostAddrsKey(host)]
+	s.Unlock()
+	if ok {
+		return addrs, nil
+	}
+	key := hostAddrsKey(host)
+	entry := s.resolve(key, func(entry *dnsCacheEntry) time.Duration {
+		return s.lookupHost(key, entry)
+	})
+	return entry.addrs, entry.err
+}
+
+// LookupCNAME returns the canonical name of the host, the end of its CNAME
+// chain with a trailing dot, or the host itself if it has no CNAME record,
+// like net.LookupCNAME.
+func (s *Session) LookupCNAME(host string) (string, error) {
+	key := hostAddrsKey(host)
+	entry := s.resolve(key, func(entry *dnsCacheEntry) time.Duration {
+		return s.lookupHost(key, entry)
+	})
+	if entry.err != nil {
+		return "", entry.err
+	}
+	if entry.cname != "" {
+		return entry.cname, nil
+	}
+	// Names resolved by the system resolver don't tell their CNAME
+	return net.LookupCNAME(dnsFQDN(host))
+}
+
+// LookupAddr returns the names in the PTR records of the address, with
+// trailing dots like net.LookupAddr.
+func (s *Session) LookupAddr(addr string) ([]string, error) {
+	name, ok := reverseAddr(addr)
+	if !ok {
+		return nil, &net.DNSError{Err: "unrecognized address", Name: addr}
+	}
+	entry := s.resolve(name, func(entry *dnsCacheEntry) time.Duration {
+		return s.lookupAddr(addr, name, entry)
+	})
+	return entry.addrs, entry.err
+}
+
+// LookupTXT returns the strings of the TXT records of the name, with the
+// strings of each record joined.
+func (s *Session) LookupTXT(name string) ([]string, error) {
+	key := hostAddrsKey(name)
+	entry := s.resolve("txt:"+key, func(entry *dnsCacheEntry) time.Duration {
+		if s.dnsCache.direct {
+			if ttl, ok := s.queryRecords(key, dnsmessage.TypeTXT, entry); ok {
+				return ttl
+			}
+		}
+		entry.addrs, entry.err = net.LookupTXT(key)
+		return dnsFallbackTTL
+	})
+	return entry.addrs, entry.err
+}
+
+// DNSCacheStats returns the number of lookups of hosts and how many of them
+// were answered from the DNS cache.
+func (s *Session) DNSCacheStats() (lookups uint32, hits uint32) {
+	s.dnsCache.Lock()
+	defer s.dnsCache.Unlock()
+	return s.dnsCache.lookups, s.dnsCache.hits
+}
+
+// resolve returns the cached lookup of the key, looking it up with lookup,
+// which returns how long the entry may be cached, if it isn't cached or has
+// expired.
+func (s *Session) resolve(key string, lookup func(entry *dnsCacheEntry) time.Duration) *dnsCacheEntry {
+	c := s.dnsCache
+	c.Lock()
+	c.lookups++
+	if entry, ok := c.entries[key]; ok {
+		select {
+		case <-entry.done:
+			if s.Clock.Now().Before(entry.expires) {
+				c.hits++
+				c.Unlock()
+				return entry
+			}
+		default:
+			c.hits++
+			c.Unlock()
+			<-entry.done
+			return entry
+		}
+	}
+	entry := &dnsCacheEntry{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.Unlock()
+
+	ttl := lookup(entry)
+	entry.expires = s.Clock.Now().Add(ttl)
+	close(entry.done)
+	if entry.err != nil {
+		s.Out.Debug("[dns] Failed to resolve %s, cached for %v: %v\n", key, ttl, entry.err)
+	} else {
+		s.Out.Debug("[dns] Resolved %s to %v, cached for %v\n", key, entry.addrs, ttl)
+	}
+	return entry
+}
+
+// lookupHost looks up the A and AAAA records of the host with the system
+// nameserver to learn their TTLs, and returns how long the entry may be
+// cached. Hosts in /etc/hosts are taken from there, and names without a
+// dot, systems without /etc/resolv.conf and queries that fail or are
+// truncated fall back to the system resolver.
+func (s *Session) lookupHost(host string, entry *dnsCacheEntry) time.Duration {
+	if addrs, ok := s.dnsCache.hosts[host]; ok {
+		entry.addrs, entry.cname = addrs, dnsFQDN(host)
+		return dnsHostsTTL
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		entry.addrs, entry.cname = []string{host}, dnsFQDN(host)
+		return dnsHostsTTL
+	}
+	if s.dnsCache.direct && strings.Contains(host, ".") {
+		if ttl, ok := s.queryHost(host, entry); ok {
+			return ttl
+		}
+	}
+	entry.addrs, entry.err = net.LookupHost(host)
+	return dnsFallbackTTL
+}
+
+// queryHost sends the A and AAAA queries for the host at the same time. It
+// returns false if the answers can't be used and the system resolver has to
+// be asked instead.
+func (s *Session) queryHost(host string, entry *dnsCacheEntry) (time.Duration, bool) {
+	types := []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA}
+	responses := make([]*dnsmessage.Message, len(types))
+	errs := make([]error, len(types))
+	var wg sync.WaitGroup
+	for i, qtype := range types {
+		wg.Add(1)
+		go func(i int, qtype dnsmessage.Type) {
+			defer wg.Done()
+			responses[i], errs[i] = s.QueryDNS(s.dnsCache.server, host, qtype, true, s.dnsTimeout())
+		}(i, qtype)
+	}
+	wg.Wait()
+
+	var addrs []string
+	cname := dnsFQDN(host)
+	ttl := time.Duration(-1)
+	minTTL := func(seconds uint32) {
+		if d := time.Duration(seconds) * time.Second; ttl < 0 || d < ttl {
+			ttl = d
+		}
+	}
+	for i, response := range responses {
+		if errs[i] != nil || response.Header.Truncated {
+			return 0, false
+		}
+		switch response.Header.RCode {
+		case dnsmessage.RCodeSuccess:
+		case dnsmessage.RCodeNameError:
+			entry.err = &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+			return negativeTTL(response), true
+		default:
+			return 0, false
+		}
+		for _, answer := range response.Answers {
+			switch body := answer.Body.(type) {
+			case *dnsmessage.AResource:
+				addrs = append(addrs, net.IP(body.A[:]).String())
+				minTTL(answer.Header.TTL)
+			case *dnsmessage.AAAAResource:
+				addrs = append(addrs, net.IP(body.AAAA[:]).String())
+				minTTL(answer.Header.TTL)
+			case *dnsmessage.CNAMEResource:
+				if strings.EqualFold(answer.Header.Name.String(), cname) {
+					cname = body.CNAME.String()
+				}
+				minTTL(answer.Header.TTL)
+			}
+		}
+		if len(response.Answers) == 0 {
+			minTTL(uint32(negativeTTL(response) / time.Second))
+		}
+	}
+	if ttl < 0 {
+		ttl = dnsFallbackTTL
+	}
+	if len(addrs) == 0 {
+		entry.err = &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+		return ttl, true
+	}
+	entry.addrs, entry.cname = dedupeAddrs(addrs), cname
+	return ttl, true
+}
+
+// lookupAddr looks up the PTR records of the address, whose reverse name in
+// in-addr.arpa or ip6.arpa is name. Like lookupHost, names of addresses in
+// /etc/hosts are taken from there, and the system resolver is asked if the
+// query fails.
+func (s *Session) lookupAddr(addr string, name string, entry *dnsCacheEntry) time.Duration {
+	for host, addrs := range s.dnsCache.hosts {
+		for _, a := range addrs {
+			if a == addr {
+				entry.addrs = append(entry.addrs, dnsFQDN(host))
+			}
+		}
+	}
+	if len(entry.addrs) > 0 {
+		sort.Strings(entry.addrs)
+		return dnsHostsTTL
+	}
+	if s.dnsCache.direct {
+		if ttl, ok := s.queryRecords(name, dnsmessage.TypePTR, entry); ok {
+			return ttl
+		}
+	}
+	entry.addrs, entry.err = net.LookupAddr(addr)
+	return dnsFallbackTTL
+}
+
+// queryRecords sends the PTR or TXT query for the name and keeps the names
+// or strings of the answers. It returns false if the answer can't be used.
+func (s *Session) queryRecords(name string, qtype dnsmessage.Type, entry *dnsCacheEntry) (time.Duration, bool) {
+	response, err := s.QueryDNS(s.dnsCache.server, name, qtype, true, s.dnsTimeout())
+	if err != nil || response.Header.Truncated {
+		return 0, false
+	}
+	switch response.Header.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		entry.err = &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+		return negativeTTL(response), true
+	default:
+		return 0, false
+	}
+	ttl := time.Duration(-1)
+	for _, answer := range response.Answers {
+		switch body := answer.Body.(type) {
+		case *dnsmessage.PTRResource:
+			entry.addrs = append(entry.addrs, body.PTR.String())
+		case *dnsmessage.TXTResource:
+			entry.addrs = append(entry.addrs, strings.Join(body.TXT, ""))
+		default:
+			continue
+		}
+		if d := time.Duration(answer.Header.TTL) * time.Second; ttl < 0 || d < ttl {
+			ttl = d
+		}
+	}
+	if ttl < 0 {
+		return negativeTTL(response), true
+	}
+	return ttl, true
+}
+
+// dnsTimeout returns how long to wait for the answer to a query.
+func (s *Session) dnsTimeout() time.Duration {
+	return time.Duration(*s.Options.HTTPTimeout) * time.Millisecond
+}
+
+// reverseAddr returns the name in in-addr.arpa or ip6.arpa that the PTR
+// records of an address are looked up under.
+func reverseAddr(addr string) (string, bool) {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return "", false
+	}
+	if v4 := ip.To4(); v4 != nil {
+		return fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa", v4[3], v4[2], v4[1], v4[0]), true
+	}
+	var b strings.Builder
+	for i := len(ip) - 1; i >= 0; i-- {
+		fmt.Fprintf(&b, "%x.%x.", ip[i]&0xf, ip[i]>>4)
+	}
+	b.WriteString("ip6.arpa")
+	return b.String(), true
+}
+
+// negativeTTL returns how long a failed lookup may be cached according to
+// the SOA record in the response (RFC 2308).
+func negativeTTL(response *dnsmessage.Message) time.Duration {
+	for _, authority := range response.Authorities {
+		if soa, ok := authority.Body.(*dnsmessage.SOAResource); ok {
+			ttl := soa.MinTTL
+			if authority.Header.TTL < ttl {
+				ttl = authority.Header.TTL
+			}
+			return time.Duration(ttl) * time.Second
+		}
+	}
+	return dnsFallbackTTL
+}
+
+func dedupeAddrs(addrs []string) []string {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, addr := range addrs {
+		if !seen[addr] {
+			seen[addr] = true
+			unique = append(unique, addr)
+		}
+	}
+	return unique
+}
+
+// readHostsFile returns the addresses of the names in a hosts file.
+func readHostsFile(path string) map[string][]string {
+	hosts := make(map[string][]string)
+	f, err := os.Open(path)
+	if err != nil {
+		return hosts
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i != -1 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 || net.ParseIP(fields[0]) == nil {
+			continue
+		}
+		for _, name := range fields[1:] {
+			name = hostAddrsKey(name)
+			hosts[name] = append(hosts[name], fields[0])
+		}
+	}
+	return hosts
+}
+
+func hostAddrsKey(host string) string {
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Route definitions of client-side routers, like React Router, Vue Router
+// and the Angular router, as they appear in (minified) JavaScript bundles.
+// Paths without a leading slash are only taken from objects that look like
+// route definitions, as Angular routes are relative.
+var (
+	absoluteRoutePattern = regexp.MustCompile(`(?:\bpath\s*:\s*|<Route\b[^>]*?\bpath\s*=\s*\{?\s*)["'](/[^"'\s<>{}\\]*)["']`)
+	relativeRoutePattern = regexp.MustCompile(`\bpath\s*:\s*["']([A-Za-z0-9][^"'\s<>{}\\/]*(?:/[^"'\s<>{}\\]*)?)["']\s*,\s*(?:component|loadChildren|loadComponent|redirectTo|children|canActivate|element|name|meta)\b`)
+	hashRouterPattern    = regexp.MustCompile(`\b(?:createWebHashHistory|createHashHistory|createHashRouter|HashRouter|useHash\s*:\s*(?:true|!0)|mode\s*:\s*["']hash["'])`)
+	serviceWorkerPattern = regexp.MustCompile(`serviceWorker\.register\(\s*["']([^"'\s]+)["']`)
+	precacheURLPattern   = regexp.MustCompile(`\burl\s*:\s*["'](/[^"'\s]*)["']`)
+)
+
+// Routes with these words are listed first, as they tend to lead to the
+// more interesting parts of an app.
+var interestingRoute = regexp.MustCompile(`(?i)admin|dashboard|manage|setting|config|account|user|internal|debug|console|report|upload|billing|api`)
+
+// ExtractRoutes returns the paths of the client-side routes defined in
+// JavaScript code. Routes with parameters or wildcards can't be visited
+// without knowing their values and are left out.
+func ExtractRoutes(script []byte) []string {
+	var routes []string
+	for _, match := range absoluteRoutePattern.FindAllSubmatch(script, -1) {
+		routes = append(routes, string(match[1]))
+	}
+	for _, match := range relativeRoutePattern.FindAllSubmatch(script, -1) {
+		routes = append(routes, "/"+string(match[1]))
+	}
+	return cleanRoutes(routes)
+}
+
+// IsHashRouter reports whether JavaScript code sets up a client-side router
+// that keeps the route in the URL fragment, like /#/settings.
+func IsHashRouter(script []byte) bool {
+	return hashRouterPattern.Match(script)
+}
+
+// FindServiceWorker returns the script URL a service worker is registered
+// with in JavaScript code, as given.
+func FindServiceWorker(script []byte) string {
+	if match := serviceWorkerPattern.FindSubmatch(script); match != nil {
+		return string(match[1])
+	}
+	return ""
+}
+
+// ExtractPrecacheRoutes returns the paths of documents a service worker
+// precaches, like the entries of a Workbox precache manifest. Other assets
+// like scripts and images are left out.
+func ExtractPrecacheRoutes(script []byte) []string {
+	var routes []string
+	for _, match := range precacheURLPattern.FindAllSubmatch(script, -1) {
+		route := string(match[1])
+		switch ext := strings.ToLower(path.Ext(strings.SplitN(route, "?", 2)[0])); ext {
+		case "", ".html", ".htm":
+			routes = append(routes, strings.TrimSuffix(strings.TrimSuffix(route, "index.html"), ".html"))
+		}
+	}
+	return cleanRoutes(routes)
+}
+
+// cleanRoutes removes duplicates, the root and routes with parameters.
+func cleanRoutes(routes []string) []string {
+	seen := map[string]bool{"": true, "/": true}
+	var cleaned []string
+	for _, route := range routes {
+		route = strings.TrimRight(route, "/")
+		if strings.ContainsAny(route, ":*()[]$") || strings.Contains(route, "//") || seen[route] {
+			continue
+		}
+		seen[route] = true
+		cleaned = append(cleaned, route)
+	}
+	return cleaned
+}
+
+// RankRoutes removes duplicate routes and sorts routes that look
+// interesting, like admin or settings pages, before the others, and shorter
+// routes before longer ones.
+func RankRoutes(routes []string) []string {
+	ranked := cleanRoutes(routes)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := interestingRoute.MatchString(ranked[i]), interestingRoute.MatchString(ranked[j])
+		if a != b {
+			return a
+		}
+		return strings.Count(ranked[i], "/") < strings.Count(ranked[j], "/")
+	})
+	return ranked
+}
+
+// AddRoutes records client-side routes found in the JavaScript of the page.
+func (p *Page) AddRoutes(routes []string) {
+	p.Lock()
+	defer p.Unlock()
+	p.Routes = append(p.Routes, routes...)
+}
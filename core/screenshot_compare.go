@@ -0,0 +1,131 @@
+package core
+
+import (
+	"image"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+)
+
+// Screenshots are compared on a grid of this many cells per side, and a cell
+// counts as changed when its brightness differs by more than
+// screenshotCellThreshold of the full range. This ignores noise like
+// antialiasing and JPEG artifacts.
+const (
+	screenshotGridSize      = 64
+	screenshotCellThreshold = 0.08
+)
+
+// Pages whose screenshots differ in more than this percentage of cells are
+// considered changed in the screenshot comparison.
+const ScreenshotChangedDifference = 1.0
+
+// ScreenshotComparison pairs the screenshots of a page in an earlier scan
+// and the current one for the gallery written with --compare-screenshots.
+// Screenshot paths are relative to the output directory.
+type ScreenshotComparison struct {
+	URL          string
+	Before       string
+	After        string
+	BeforeStatus string
+	AfterStatus  string
+	// Difference is the percentage of the screenshot that changed, or -1
+	// if the screenshots couldn't be compared
+	Difference float64
+}
+
+// Changed reports whether the screenshots of the page differ enough to be
+// looked at.
+func (c ScreenshotComparison) Changed() bool {
+	return c.Difference < 0 || c.Difference > ScreenshotChangedDifference
+}
+
+// CompareScreenshots pairs the screenshots of the pages found in both the
+// session written to dir and the earlier session written to beforeDir, and
+// computes how much they differ. Screenshot paths are made relative to dir.
+// A page with a screenshot in only one of the sessions is treated as
+// changed completely. The comparisons are sorted by difference, most
+// changed first.
+func CompareScreenshots(session *Session, dir string, before *Session, beforeDir string) []ScreenshotComparison {
+	prefix := relativeDir(dir, beforeDir)
+	var comparisons []ScreenshotComparison
+	for url, page := range session.Pages {
+		old, ok := before.Pages[url]
+		if !ok || (!old.HasScreenshot && !page.HasScreenshot) {
+			continue
+		}
+		c := ScreenshotComparison{
+			URL:          url,
+			BeforeStatus: old.Status,
+			AfterStatus:  page.Status,
+			Difference:   100,
+		}
+		if old.HasScreenshot {
+			c.Before = old.ScreenshotPath
+			if prefix != "" {
+				c.Before = path.Join(prefix, c.Before)
+			}
+		}
+		if page.HasScreenshot {
+			c.After = page.ScreenshotPath
+		}
+		if c.Before != "" && c.After != "" {
+			c.Difference = ScreenshotDifferenceFiles(filepath.Join(beforeDir, filepath.FromSlash(old.ScreenshotPath)), filepath.Join(dir, filepath.FromSlash(page.ScreenshotPath)))
+		}
+		comparisons = append(comparisons, c)
+	}
+
+	sort.Slice(comparisons, func(i, j int) bool {
+		if comparisons[i].Difference != comparisons[j].Difference {
+			return comparisons[i].Difference > comparisons[j].Difference
+		}
+		return comparisons[i].URL < comparisons[j].URL
+	})
+	return comparisons
+}
+
+// ScreenshotDifferenceFiles returns the difference of two screenshot files
+// as computed by ScreenshotDifference, or -1 if either can't be decoded.
+func ScreenshotDifferenceFiles(a string, b string) float64 {
+	imgA, err := decodeImageFile(a)
+	if err != nil {
+		return -1
+	}
+	imgB, err := decodeImageFile(b)
+	if err != nil {
+		return -1
+	}
+	return ScreenshotDifference(imgA, imgB)
+}
+
+// ScreenshotDifference returns the percentage of two images that differs,
+// by scaling both down to a grid of grayscale cells and counting the cells
+// with a different brightness. Images of different sizes are compared as
+// if scaled to the same size.
+func ScreenshotDifference(a image.Image, b image.Image) float64 {
+	grayA := grayscaleGrid(a, screenshotGridSize, screenshotGridSize)
+	grayB := grayscaleGrid(b, screenshotGridSize, screenshotGridSize)
+
+	changed := 0
+	for y := 0; y < screenshotGridSize; y++ {
+		for x := 0; x < screenshotGridSize; x++ {
+			if math.Abs(grayA[y][x]-grayB[y][x]) > screenshotCellThreshold*65535 {
+				changed++
+			}
+		}
+	}
+	difference := float64(changed) * 100 / (screenshotGridSize * screenshotGridSize)
+	return math.Round(difference*10) / 10
+}
+
+func decodeImageFile(name string) (image.Image, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	return img, err
+}
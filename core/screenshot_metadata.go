@@ -0,0 +1,154 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+)
+
+// ImageText is a keyword and text pair embedded in a screenshot.
+type ImageText struct {
+	Keyword string
+	Text    string
+}
+
+// ScreenshotMetadata returns the text embedded in the screenshot of a page,
+// so a screenshot found on disk later can be traced back to the URL, the
+// time it was taken and the session it belongs to.
+func (s *Session) ScreenshotMetadata(page *Page, capturedAt time.Time) []ImageText {
+	texts := []ImageText{
+		{"URL", page.URL},
+		// The PNG specification recommends the RFC 1123 format
+		{"Creation Time", capturedAt.UTC().Format("Mon, 02 Jan 2006 15:04:05 GMT")},
+	}
+	if s.ID != "" {
+		texts = append(texts, ImageText{"Aquatone Session", s.ID})
+	}
+	return append(texts, ImageText{"Software", "Aquatone v" + s.Version})
+}
+
+// EmbedScreenshotMetadata adds the texts to the screenshot at name, as tEXt
+// or iTXt chunks to PNG images and as a comment to JPEG images. Screenshots
+// in the screenshot store can be shared by several pages, so texts are
+// added after the ones already there instead of replacing them.
+func (s *Session) EmbedScreenshotMetadata(name string, texts []ImageText) error {
+	s.screenshotMetaMutex.Lock()
+	defer s.screenshotMetaMutex.Unlock()
+
+	filePath := s.GetFilePath(name)
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	switch {
+	case bytes.HasPrefix(data, pngSignature):
+		data, err = embedPNGText(data, texts)
+	case bytes.HasPrefix(data, []byte{0xff, 0xd8}):
+		data, err = embedJPEGComment(data, texts)
+	default:
+		err = fmt.Errorf("Unsupported image format")
+	}
+	if err != nil {
+		return err
+	}
+
+	// The file is replaced in one step, so it is never seen half written
+	tempPath := filePath + ".tmp"
+	if err := ioutil.WriteFile(tempPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tempPath, filePath)
+}
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// embedPNGText inserts a chunk for every text before the IEND chunk of the
+// PNG image. Texts in ASCII go in tEXt chunks, which every reader supports,
+// and others in iTXt chunks, which hold UTF-8.
+func embedPNGText(data []byte, texts []ImageText) ([]byte, error) {
+	offset := len(pngSignature)
+	for {
+		if offset+8 > len(data) {
+			return nil, fmt.Errorf("No IEND chunk found in PNG image")
+		}
+		length := int(binary.BigEndian.Uint32(data[offset:]))
+		if string(data[offset+4:offset+8]) == "IEND" {
+			break
+		}
+		offset += 12 + length
+	}
+
+	var chunks bytes.Buffer
+	for _, text := range texts {
+		if isASCII(text.Text) {
+			writePNGChunk(&chunks, "tEXt", []byte(text.Keyword+"\x00"+text.Text))
+		} else {
+			// No compression, and no language tag or translated keyword
+			writePNGChunk(&chunks, "iTXt", []byte(text.Keyword+"\x00\x00\x00\x00\x00"+text.Text))
+		}
+	}
+
+	embedded := make([]byte, 0, len(data)+chunks.Len())
+	embedded = append(embedded, data[:offset]...)
+	embedded = append(embedded, chunks.Bytes()...)
+	return append(embedded, data[offset:]...), nil
+}
+
+func writePNGChunk(buf *bytes.Buffer, chunkType string, data []byte) {
+	binary.Write(buf, binary.BigEndian, uint32(len(data)))
+	crc := crc32.NewIEEE()
+	crc.Write([]byte(chunkType))
+	crc.Write(data)
+	buf.WriteString(chunkType)
+	buf.Write(data)
+	binary.Write(buf, binary.BigEndian, crc.Sum32())
+}
+
+// embedJPEGComment inserts a COM segment with a line for every text before
+// the image data of the JPEG image, after any comments already there.
+func embedJPEGComment(data []byte, texts []ImageText) ([]byte, error) {
+	offset := 2
+	for {
+		if offset+4 > len(data) || data[offset] != 0xff {
+			return nil, fmt.Errorf("No image data found in JPEG image")
+		}
+		// Markers may be preceded by any number of fill bytes
+		if data[offset+1] == 0xff {
+			offset++
+			continue
+		}
+		if data[offset+1] == 0xda {
+			break
+		}
+		offset += 2 + int(binary.BigEndian.Uint16(data[offset+2:]))
+	}
+
+	var lines []string
+	for _, text := range texts {
+		lines = append(lines, text.Keyword+": "+text.Text)
+	}
+	comment := []byte(strings.Join(lines, "\n"))
+	if len(comment) > 0xffff-2 {
+		comment = comment[:0xffff-2]
+	}
+
+	embedded := make([]byte, 0, len(data)+len(comment)+4)
+	embedded = append(embedded, data[:offset]...)
+	embedded = append(embedded, 0xff, 0xfe, byte((len(comment)+2)>>8), byte(len(comment)+2))
+	embedded = append(embedded, comment...)
+	return append(embedded, data[offset:]...), nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < ' ' || s[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
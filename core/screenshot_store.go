@@ -0,0 +1,67 @@
+package core
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// screenshotStoreDir is the folder that holds screenshots named after the
+// SHA-256 hash of their content. Scans of large scopes produce thousands of
+// identical screenshots of default and error pages, which are only stored
+// once this way.
+const screenshotStoreDir = "screenshots/sha256"
+
+// StoreScreenshot moves the screenshot at tempName into the content
+// addressed store and returns the path of the stored file, which is what
+// pages and the report reference. If a screenshot with the same content is
+// already stored, the new one is discarded. A symlink named linkName is
+// created next to the store pointing to the stored file, so screenshots can
+// still be found by the page's filename. All paths are relative to the
+// output directory.
+func (s *Session) StoreScreenshot(tempName string, linkName string) (string, error) {
+	hash, err := fileSHA256(s.GetFilePath(tempName))
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(s.GetFilePath(screenshotStoreDir), 0755); err != nil {
+		return "", err
+	}
+
+	name := path.Join(screenshotStoreDir, hash+path.Ext(tempName))
+	if _, err := os.Stat(s.GetFilePath(name)); err == nil {
+		if err := os.Remove(s.GetFilePath(tempName)); err != nil {
+			return "", err
+		}
+	} else if err := os.Rename(s.GetFilePath(tempName), s.GetFilePath(name)); err != nil {
+		return "", err
+	}
+
+	link := s.GetFilePath(linkName)
+	target, err := filepath.Rel(filepath.Dir(link), s.GetFilePath(name))
+	if err != nil {
+		return name, nil
+	}
+	os.Remove(link)
+	if err := os.Symlink(target, link); err != nil {
+		s.Out.Debug("Unable to link screenshot %s to %s: %v\n", linkName, name, err)
+	}
+	return name, nil
+}
+
+func fileSHA256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
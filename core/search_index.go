@@ -0,0 +1,72 @@
+package core
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// searchIndexFields are the fields of the entries of the search index, in
+// the order they are stored.
+var searchIndexFields = []string{"uuid", "url", "title", "technologies", "notes"}
+
+// SearchIndex holds the text the report searches in, one entry per page.
+// Entries are arrays of strings in the order of Fields rather than objects,
+// which keeps the index small for reports with tens of thousands of pages.
+// The report loads it on the first search, so the text doesn't have to be
+// kept in the DOM.
+type SearchIndex struct {
+	Fields  []string   `json:"fields"`
+	Entries [][]string `json:"entries"`
+}
+
+// SearchIndex returns the search index of the pages of the session, ordered
+// by URL.
+func (s *Session) SearchIndex() *SearchIndex {
+	s.Lock()
+	defer s.Unlock()
+	index := &SearchIndex{Fields: searchIndexFields, Entries: [][]string{}}
+	for _, page := range s.Pages {
+		index.Entries = append(index.Entries, searchIndexEntry(page))
+	}
+	sort.Slice(index.Entries, func(i, j int) bool {
+		return index.Entries[i][1] < index.Entries[j][1]
+	})
+	return index
+}
+
+// searchIndexEntry returns the entry of a page. The rendered title is added
+// to the title when a client-side redirect led to a page with another one.
+func searchIndexEntry(page *Page) []string {
+	page.Lock()
+	defer page.Unlock()
+	title := page.PageTitle
+	if page.RenderedTitle != "" && page.RenderedTitle != title {
+		title = strings.TrimSpace(title + "\n" + page.RenderedTitle)
+	}
+	var technologies []string
+	for _, tech := range page.Technologies {
+		technologies = append(technologies, strings.TrimSpace(tech.Name+" "+tech.Version))
+	}
+	var notes []string
+	for _, note := range page.Notes {
+		notes = append(notes, note.Text)
+	}
+	return []string{page.UUID, page.URL, title, strings.Join(technologies, "\n"), strings.Join(notes, "\n")}
+}
+
+// Save writes the search index to path.
+func (i *SearchIndex) Save(path string) error {
+	data, err := json.Marshal(i)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// SaveSearchIndexToFile writes the search index of the session to the output
+// directory.
+func (s *Session) SaveSearchIndexToFile(filename string) error {
+	return s.SearchIndex().Save(s.GetFilePath(filename))
+}
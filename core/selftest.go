@@ -0,0 +1,219 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	selfTestTitle    = "Aquatone Self-Test"
+	selfTestHugeSize = 8 * 1024 * 1024
+	selfTestSlowTime = 2 * time.Second
+)
+
+// SelfTestServer is a local HTTP and HTTPS server with known pages that the
+// selftest command runs the full pipeline against.
+type SelfTestServer struct {
+	HTTPPort  int
+	HTTPSPort int
+	http      *http.Server
+	https     *http.Server
+}
+
+// SelfTestCheck is the outcome of one of the checks made by the selftest
+// command.
+type SelfTestCheck struct {
+	Name   string
+	Passed bool
+	Detail string
+}
+
+// NewSelfTestServer starts the self-test server on random loopback ports.
+func NewSelfTestServer() (*SelfTestServer, error) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, "<html><head><title>%s</title></head><body><h1>%s</h1><p>If you can read this, screenshots work.</p></body></html>", selfTestTitle, selfTestTitle)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/", http.StatusFound)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(selfTestSlowTime)
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, "<html><head><title>Slow Page</title></head><body>Sorry for the wait.</body></html>")
+	})
+	mux.HandleFunc("/huge", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("Content-Length", strconv.Itoa(selfTestHugeSize))
+		line := strings.Repeat("A", 1023) + "\n"
+		for i := 0; i < selfTestHugeSize/len(line); i++ {
+			if _, err := w.Write([]byte(line)); err != nil {
+				return
+			}
+		}
+	})
+
+	cert, err := selfSignedCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	httpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	httpsListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		httpListener.Close()
+		return nil, err
+	}
+
+	server := &SelfTestServer{
+		HTTPPort:  httpListener.Addr().(*net.TCPAddr).Port,
+		HTTPSPort: httpsListener.Addr().(*net.TCPAddr).Port,
+		http:      &http.Server{Handler: mux},
+		https:     &http.Server{Handler: mux, TLSConfig: &tls.Config{Certificates: []tls.Certificate{cert}}},
+	}
+	go server.http.Serve(httpListener)
+	go server.https.ServeTLS(httpsListener, "", "")
+	return server, nil
+}
+
+// Close stops the server.
+func (s *SelfTestServer) Close() {
+	s.http.Close()
+	s.https.Close()
+}
+
+// Hosts returns the hosts to give to the port scanner.
+func (s *SelfTestServer) Hosts() []string {
+	return []string{"localhost"}
+}
+
+// Ports returns the ports the hosts should be scanned on.
+func (s *SelfTestServer) Ports() []int {
+	return []int{s.HTTPPort, s.HTTPSPort}
+}
+
+// URLs returns the URLs of the special pages, which are requested directly.
+func (s *SelfTestServer) URLs() []string {
+	base := fmt.Sprintf("http://127.0.0.1:%d", s.HTTPPort)
+	return []string{base + "/redirect", base + "/slow", base + "/huge"}
+}
+
+// Verify checks that the session contains the expected results of a run
+// against the server.
+func (s *SelfTestServer) Verify(sess *Session) []SelfTestCheck {
+	var checks []SelfTestCheck
+	check := func(name string, passed bool, format string, args ...interface{}) {
+		checks = append(checks, SelfTestCheck{Name: name, Passed: passed, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	httpURL := fmt.Sprintf("http://localhost:%d/", s.HTTPPort)
+	httpsURL := fmt.Sprintf("https://localhost:%d/", s.HTTPSPort)
+	redirectURL, slowURL, hugeURL := s.URLs()[0], s.URLs()[1], s.URLs()[2]
+
+	if addrs, err := net.LookupHost("localhost"); err != nil {
+		check("DNS resolution", false, "%v", err)
+	} else {
+		check("DNS resolution", len(addrs) > 0, "localhost resolved to %s", strings.Join(addrs, ", "))
+	}
+
+	check("Port scanning", sess.Stats.PortOpen >= 2, "%d of 2 open ports found", sess.Stats.PortOpen)
+
+	page := sess.Pages[httpURL]
+	check("HTTP", page != nil && strings.HasPrefix(page.Status, "200"), "%s: %s", httpURL, selfTestStatus(page))
+	check("Page title", page != nil && page.PageTitle == selfTestTitle, "%s: title %q", httpURL, selfTestTitleOf(page))
+
+	page = sess.Pages[httpsURL]
+	check("HTTPS", page != nil && strings.HasPrefix(page.Status, "200") && page.Certificate != nil, "%s: %s", httpsURL, selfTestStatus(page))
+
+	page = sess.Pages[redirectURL]
+	check("Redirects", page != nil && strings.HasPrefix(page.Status, "200") && len(page.RedirectChain) > 0, "%s: %s", redirectURL, selfTestStatus(page))
+
+	page = sess.Pages[slowURL]
+	check("Slow responses", page != nil && strings.HasPrefix(page.Status, "200"), "%s: %s", slowURL, selfTestStatus(page))
+
+	page = sess.Pages[hugeURL]
+	check("Huge bodies", page != nil && page.BodySize == selfTestHugeSize, "%s: %s", hugeURL, selfTestStatus(page))
+
+	screenshots := 0
+	for _, page := range sess.Pages {
+		if !page.HasScreenshot {
+			continue
+		}
+		if info, err := os.Stat(sess.GetFilePath(page.ScreenshotPath)); err == nil && info.Size() > 0 {
+			screenshots++
+		}
+	}
+	check("Screenshots", len(sess.Pages) > 0 && screenshots == len(sess.Pages), "%d of %d pages have a screenshot", screenshots, len(sess.Pages))
+
+	var missing []string
+	for _, name := range []string{"aquatone_report.html", sess.SessionFilename(), "aquatone_urls.txt"} {
+		if info, err := os.Stat(sess.GetFilePath(name)); err != nil || info.Size() == 0 {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		check("Output files", false, "%s not written to %s", strings.Join(missing, ", "), *sess.Options.OutDir)
+	} else {
+		check("Output files", true, "written to %s", *sess.Options.OutDir)
+	}
+
+	return checks
+}
+
+func selfTestStatus(page *Page) string {
+	if page == nil {
+		return "no response"
+	}
+	return fmt.Sprintf("%s, %d bytes in %dms", page.Status, page.BodySize, page.ResponseTime)
+}
+
+func selfTestTitleOf(page *Page) string {
+	if page == nil {
+		return ""
+	}
+	return page.PageTitle
+}
+
+func selfSignedCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost", Organization: []string{selfTestTitle}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/url"
 	"os"
 	"path"
@@ -15,9 +16,11 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"text/template"
 	"time"
 
 	"github.com/asaskevich/EventBus"
+	"github.com/google/uuid"
 	"github.com/remeh/sizedwaitgroup"
 )
 
@@ -34,6 +37,12 @@ type Stats struct {
 	ResponseCode5xx      uint32    `json:"responseCode5xx"`
 	ScreenshotSuccessful uint32    `json:"screenshotSuccessful"`
 	ScreenshotFailed     uint32    `json:"screenshotFailed"`
+	BrowserCrashes       uint32    `json:"browserCrashes"`
+	BrowserRestarts      uint32    `json:"browserRestarts"`
+	HostsSkipped         uint32    `json:"hostsSkipped"`
+	URLsSkipped          uint32    `json:"urlsSkipped"`
+	RateLimited          uint32    `json:"rateLimited"`
+	RateLimitedPages     uint32    `json:"rateLimitedPages"`
 }
 
 func (s *Stats) Duration() time.Duration {
@@ -80,36 +89,98 @@ func (s *Stats) IncrementScreenshotFailed() {
 	atomic.AddUint32(&s.ScreenshotFailed, 1)
 }
 
+func (s *Stats) IncrementBrowserCrashes() {
+	atomic.AddUint32(&s.BrowserCrashes, 1)
+}
+
+func (s *Stats) IncrementBrowserRestarts() {
+	atomic.AddUint32(&s.BrowserRestarts, 1)
+}
+
+func (s *Stats) IncrementRateLimited() {
+	atomic.AddUint32(&s.RateLimited, 1)
+}
+
+func (s *Stats) IncrementRateLimitedPages() {
+	atomic.AddUint32(&s.RateLimitedPages, 1)
+}
+
 type Session struct {
 	sync.Mutex
+	ID                     string                        `json:"id,omitempty"`
 	Version                string                        `json:"version"`
+	Operator               string                        `json:"operator,omitempty"`
+	ScanHost               string                        `json:"scanHost,omitempty"`
+	Meta                   map[string]string             `json:"meta,omitempty"`
+	Authorization          string                        `json:"authorization,omitempty"`
 	Options                Options                       `json:"-"`
 	Out                    *Logger                       `json:"-"`
 	Stats                  *Stats                        `json:"stats"`
 	Pages                  map[string]*Page              `json:"pages"`
+	Hosts                  map[string]*Host              `json:"hosts,omitempty"`
 	PageSimilarityClusters map[string][]string           `json:"pageSimilarityClusters"`
+	PortAddrs              map[string][]string           `json:"portAddrs"`
+	GonePages              map[string]*Page              `json:"gonePages,omitempty"`
+	DNSFindings            []DNSFinding                  `json:"dnsFindings,omitempty"`
+	Unresponsive           []UnresponsiveURL             `json:"unresponsive,omitempty"`
+	HostHealth             map[string]*HostHealth        `json:"hostHealth,omitempty"`
+	AgentTimings           map[string]*AgentTiming       `json:"agentTimings"`
+	Clock                  Clock                         `json:"-"`
+	Dialer                 Dialer                        `json:"-"`
+	Runner                 CommandRunner                 `json:"-"`
+	Random                 *Random                       `json:"-"`
+	Failures               []Failure                     `json:"-"`
+	FailConditions         []FailCondition               `json:"-"`
+	ExtractFilter          *Filter                       `json:"-"`
 	Ports                  []int                         `json:"-"`
+	FilenameTemplate       *template.Template            `json:"-"`
+	BrowserProxy           string                        `json:"-"`
 	EventBus               EventBus.Bus                  `json:"-"`
 	WaitGroup              sizedwaitgroup.SizedWaitGroup `json:"-"`
+	filenames              map[string]string
+	agentTimingsMutex      sync.Mutex
+	diskSpace              int32
+	hostCount              int64
+	urlCount               int64
+	tunneled               bool
+	hostAddrs              map[string][]string
+	dnsCache               *dnsCache
+	responseStore          *ResponseStore
+	responseStoreOnce      sync.Once
+	screenshotMetaMutex    sync.Mutex
+	throttles              map[string]*hostThrottle
+	hostSlots              map[string]chan struct{}
 }
 
 func (s *Session) Start() {
+	s.initHooks()
 	s.Pages = make(map[string]*Page)
+	s.Hosts = make(map[string]*Host)
 	s.PageSimilarityClusters = make(map[string][]string)
+	s.PortAddrs = make(map[string][]string)
+	s.filenames = make(map[string]string)
+	s.hostAddrs = make(map[string][]string)
+	s.dnsCache = newDNSCache()
+	s.AgentTimings = make(map[string]*AgentTiming)
 	s.initStats()
 	s.initLogger()
 	s.initPorts()
 	s.initThreads()
 	s.initEventBus()
 	s.initWaitGroup()
-	s.initDirectories()
+	if *s.Options.Command != CommandShow && *s.Options.Command != CommandClean && *s.Options.Command != CommandExtract && *s.Options.Command != CommandTemplateLint {
+		s.initDirectories()
+	}
 }
 
 func (s *Session) End() {
-	s.Stats.FinishedAt = time.Now()
+	s.Stats.FinishedAt = s.Clock.Now()
 }
 
 func (s *Session) AddPage(url string) (*Page, error) {
+	if normalized, err := s.NormalizeURL(url); err == nil {
+		url = normalized
+	}
 	s.Lock()
 	defer s.Unlock()
 	if page, ok := s.Pages[url]; ok {
@@ -121,11 +192,63 @@ func (s *Session) AddPage(url string) (*Page, error) {
 		return nil, err
 	}
 
+	if s.FilenameTemplate != nil {
+		s.assignFilename(page)
+	}
+
+	// Hosts of pages that weren't in the input were found by following
+	// redirects, routes and frames
+	if _, ok := s.Hosts[hostAddrsKey(page.Hostname)]; !ok {
+		s.addHost(page.Hostname, HostSourceCrawl)
+	}
 	s.Pages[url] = page
 	return page, nil
 }
 
+func (s *Session) AddFailure(target string, stage string, reason string, err error) {
+	s.Lock()
+	defer s.Unlock()
+	failure := Failure{
+		Target: target,
+		Stage:  stage,
+		Reason: reason,
+	}
+	if err != nil {
+		failure.Error = err.Error()
+	}
+	s.Failures = append(s.Failures, failure)
+}
+
+// SetPortAddrs records the IP addresses that answered on an open port of a
+// host, so later connections to the port go to the same addresses.
+func (s *Session) SetPortAddrs(host string, port int, addrs []string) {
+	s.Lock()
+	defer s.Unlock()
+	s.PortAddrs[net.JoinHostPort(host, strconv.Itoa(port))] = addrs
+}
+
+// GetPortAddrs returns the IP addresses that answered on the port of a
+// host:port address during port scanning.
+func (s *Session) GetPortAddrs(address string) []string {
+	s.Lock()
+	defer s.Unlock()
+	return s.PortAddrs[address]
+}
+
+// DialAddress returns the address to connect to for a host:port address,
+// using the first IP address that answered during port scanning when known.
+func (s *Session) DialAddress(address string) string {
+	if addrs := s.GetPortAddrs(address); len(addrs) > 0 {
+		_, port, _ := net.SplitHostPort(address)
+		return net.JoinHostPort(addrs[0], port)
+	}
+	return address
+}
+
 func (s *Session) GetPage(url string) *Page {
+	if normalized, err := s.NormalizeURL(url); err == nil {
+		url = normalized
+	}
 	if page, ok := s.Pages[url]; ok {
 		return page
 	}
@@ -146,34 +269,14 @@ func (s *Session) initStats() {
 		return
 	}
 	s.Stats = &Stats{
-		StartedAt: time.Now(),
+		StartedAt: s.Clock.Now(),
 	}
 }
 
 func (s *Session) initPorts() {
-	var ports []int
-	switch *s.Options.Ports {
-	case "small":
-		ports = SmallPortList
-	case "", "medium", "default":
-		ports = MediumPortList
-	case "large":
-		ports = LargePortList
-	case "xlarge", "huge":
-		ports = XLargePortList
-	default:
-		for _, p := range strings.Split(*s.Options.Ports, ",") {
-			port, err := strconv.Atoi(strings.TrimSpace(p))
-			if err != nil {
-				s.Out.Fatal("Invalid port range given\n")
-				os.Exit(1)
-			}
-			if port < 1 || port > 65535 {
-				s.Out.Fatal("Invalid port given: %v\n", port)
-				os.Exit(1)
-			}
-			ports = append(ports, port)
-		}
+	ports, err := ParsePorts(*s.Options.Ports)
+	if err != nil {
+		s.Out.FatalWithCode(ExitInvalidOptions, "%v\n", err)
 	}
 	s.Ports = ports
 }
@@ -182,6 +285,7 @@ func (s *Session) initLogger() {
 	s.Out = &Logger{}
 	s.Out.SetDebug(*s.Options.Debug)
 	s.Out.SetSilent(*s.Options.Silent)
+	s.Out.SetNoColor(*s.Options.NoColor)
 }
 
 func (s *Session) initThreads() {
@@ -189,6 +293,13 @@ func (s *Session) initThreads() {
 		numCPUs := runtime.NumCPU()
 		s.Options.Threads = &numCPUs
 	}
+	if *s.Options.MaxPerHost == 0 {
+		perHost := *s.Options.Threads / 2
+		if perHost < 1 {
+			perHost = 1
+		}
+		s.Options.MaxPerHost = &perHost
+	}
 }
 
 func (s *Session) initEventBus() {
@@ -205,8 +316,7 @@ func (s *Session) initDirectories() {
 		if _, err := os.Stat(d); os.IsNotExist(err) {
 			err = os.MkdirAll(d, 0755)
 			if err != nil {
-				s.Out.Fatal("Failed to create required directory %s\n", d)
-				os.Exit(1)
+				s.Out.FatalWithCode(ExitOutputDir, "Failed to create required directory %s\n", d)
 			}
 		}
 	}
@@ -232,27 +342,121 @@ func (s *Session) GetFilePath(p string) string {
 	return path.Join(*s.Options.OutDir, p)
 }
 
+// ReadFile reads a file of the output directory, or from the response store
+// if it isn't on disk.
 func (s *Session) ReadFile(p string) ([]byte, error) {
 	content, err := ioutil.ReadFile(s.GetFilePath(p))
 	if err != nil {
+		if store := s.store(); os.IsNotExist(err) && store != nil && store.Has(p) {
+			return store.Get(p)
+		}
 		return content, err
 	}
 	return content, nil
 }
 
+// WriteFile writes a file of the output directory, or adds it to the
+// response store with --response-store if it is kept there. Copies of the
+// file left by earlier scans in the other place are removed.
+func (s *Session) WriteFile(p string, content []byte) error {
+	store := s.store()
+	if *s.Options.ResponseStore && store != nil && storedFile(p, len(content)) {
+		if err := store.Put(p, content); err != nil {
+			return err
+		}
+		os.Remove(s.GetFilePath(p))
+		return nil
+	}
+	if err := ioutil.WriteFile(s.GetFilePath(p), content, 0644); err != nil {
+		return err
+	}
+	if store != nil {
+		return store.Delete(p)
+	}
+	return nil
+}
+
+// RemoveFile removes a file of the output directory, from the disk and the
+// response store.
+func (s *Session) RemoveFile(p string) error {
+	err := os.Remove(s.GetFilePath(p))
+	if store := s.store(); store != nil {
+		if err := store.Delete(p); err != nil {
+			return err
+		}
+	}
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
 func (s *Session) ToJSON() string {
 	sessionJSON, _ := json.Marshal(s)
 	return string(sessionJSON)
 }
 
+// SaveToFile writes the session to the output directory, in the format
+// given by the extension of filename.
 func (s *Session) SaveToFile(filename string) error {
-	path := s.GetFilePath(filename)
-	err := ioutil.WriteFile(path, []byte(s.ToJSON()), 0644)
+	return s.SaveToPath(s.GetFilePath(filename))
+}
+
+// SaveToPath writes the session to path, in the format given by its
+// extension, like a session file loaded from anywhere with --session.
+func (s *Session) SaveToPath(path string) error {
+	f, err := os.Create(path)
 	if err != nil {
 		return err
 	}
+	if err := writeSession(f, s, sessionFileFormat(path)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
 
-	return nil
+func (s *Session) SaveFailuresToFile(filename string) error {
+	s.Lock()
+	defer s.Unlock()
+	summary := make(map[string]int)
+	for _, f := range s.Failures {
+		summary[f.Reason]++
+	}
+	failures := s.Failures
+	if failures == nil {
+		failures = []Failure{}
+	}
+
+	failuresJSON, err := json.MarshalIndent(struct {
+		Summary  map[string]int `json:"summary"`
+		Failures []Failure      `json:"failures"`
+	}{summary, failures}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.GetFilePath(filename), failuresJSON, 0644)
+}
+
+// FailureRate returns the percentage of HTTP requests that failed.
+func (s *Session) FailureRate() float64 {
+	total := s.Stats.RequestSuccessful + s.Stats.RequestFailed
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Stats.RequestFailed) / float64(total) * 100
+}
+
+// LoadSession reads a session previously written with SaveToFile, in any of
+// the session formats.
+func LoadSession(path string) (*Session, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readSession(f)
 }
 
 func (s *Session) Asset(name string) ([]byte, error) {
@@ -269,32 +473,198 @@ func NewSession() (*Session, error) {
 		return nil, err
 	}
 
-	if *session.Options.ChromePath != "" {
-		if _, err := os.Stat(*session.Options.ChromePath); os.IsNotExist(err) {
-			return nil, fmt.Errorf("Chrome path %s does not exist", *session.Options.ChromePath)
+	c := &optionChecker{}
+	c.exists(*session.Options.ChromePath, "Chrome path", "--chrome-path")
+	c.exists(*session.Options.SessionPath, "Session path", "--session")
+	c.exists(*session.Options.TriagePath, "Triage file", "--triage")
+	c.exists(*session.Options.Baseline, "Baseline session", "--baseline")
+	for _, path := range *session.Options.TargetsFiles {
+		if path != "-" {
+			c.exists(path, "Targets file", "--targets-file")
 		}
 	}
 
-	if *session.Options.SessionPath != "" {
-		if _, err := os.Stat(*session.Options.SessionPath); os.IsNotExist(err) {
-			return nil, fmt.Errorf("Session path %s does not exist", *session.Options.SessionPath)
+	if *session.Options.Nmap && *session.Options.Nessus {
+		c.fail("Give only one of --nmap and --nessus, or the format with --input-format.", "--nmap and --nessus given together")
+	}
+
+	if *session.Options.CompareScreenshots != "" {
+		if FindSessionFile(*session.Options.CompareScreenshots) == "" {
+			c.fail("Give the output directory of an earlier scan with --compare-screenshots.", "No Aquatone session found in %s to compare screenshots with", *session.Options.CompareScreenshots)
 		}
 	}
 
 	if *session.Options.TemplatePath != "" {
 		if _, err := os.Stat(*session.Options.TemplatePath); os.IsNotExist(err) {
-			return nil, fmt.Errorf("Template path %s does not exist", *session.Options.TemplatePath)
+			c.fail("Check the path given with --template-path.", "Template path %s does not exist", *session.Options.TemplatePath)
+		} else {
+			c.check(checkTemplate(*session.Options.TemplatePath), "Fix the template or leave out --template-path to use the built-in report.")
 		}
 	}
 
+	if *session.Options.Command == CommandTemplateLint {
+		c.exists(*session.Options.LintPath, "Template path", "template lint")
+	}
+
+	c.exists(*session.Options.ReportLogo, "Report logo", "--report-logo")
+
+	session.Meta, err = ParseMeta(*session.Options.Meta)
+	c.check(err, "Give metadata as key=value, like --meta engagement=ACME-2024.")
+	session.Operator, session.ScanHost = scanOperator()
+	session.ID = uuid.New().String()
+
+	if *session.Options.AuthorizationFile != "" {
+		authorization, err := ioutil.ReadFile(*session.Options.AuthorizationFile)
+		if err != nil {
+			c.fail("Check the path given with --authorization-file.", "Unable to read authorization file: %v", err)
+		}
+		session.Authorization = strings.TrimSpace(string(authorization))
+	}
+
+	_, err = newSourceDialer(*session.Options.SourceIP, *session.Options.Interface)
+	c.check(err, "Give an address or interface of this machine with --source-ip or --interface.")
+
+	if *session.Options.Via != "" {
+		c.check(ValidateVia(*session.Options.Via), "Give the jump host as an SSH URL, like --via ssh://user@bastion.example.com.")
+	}
+
+	if *session.Options.ReportBaseURL != "" {
+		u, err := url.Parse(*session.Options.ReportBaseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https" && !strings.HasPrefix(u.Path, "/")) {
+			c.fail("Give an URL like https://reports.example.com/scan/ or a path like /scan/ with --report-base-url.", "Invalid report base URL %q: must be an http(s) URL or an absolute path", *session.Options.ReportBaseURL)
+		}
+	}
+
+	if *session.Options.FilenameTemplate != "" {
+		session.FilenameTemplate, err = ParseFilenameTemplate(*session.Options.FilenameTemplate)
+		c.check(err, "Use the fields .Scheme, .Host, .Port, .Path and .PathHash, like --filename-template '{{.Scheme}}_{{.Host}}_{{.Port}}'.")
+	}
+
+	session.FailConditions, err = ParseFailConditions(*session.Options.FailOn)
+	c.check(err, "Give conditions separated by commas, like --fail-on 'takeover,new-host,5xx>10'.")
+
+	envArchivePassphrase := os.Getenv("AQUATONE_ARCHIVE_PASSPHRASE")
+	if *session.Options.ArchivePassphrase == "" && envArchivePassphrase != "" {
+		session.Options.ArchivePassphrase = &envArchivePassphrase
+	}
+
+	if *session.Options.ArchivePassphrase != "" && *session.Options.Archive == "" {
+		c.fail("Give the archive to write with --archive, or unset AQUATONE_ARCHIVE_PASSPHRASE.", "Archive passphrase given without --archive")
+	}
+
+	if *session.Options.Display != "" && !*session.Options.Headful {
+		c.fail("Add --headful to open Chrome windows on the display, or leave out --display.", "Display given without --headful")
+	}
+
+	if *session.Options.Command == CommandExtract {
+		if *session.Options.SessionPath == "" {
+			c.fail("Give the session file, like --session aquatone_session.json.", "Session file to extract from must be given with --session")
+		}
+		if !isExtractName(*session.Options.ExtractWhat) {
+			c.fail("Give one of the values listed with --what.", "Unknown value to extract %q (valid values: %s)", *session.Options.ExtractWhat, strings.Join(extractNames, ", "))
+		}
+		session.ExtractFilter, err = ParseFilter(*session.Options.ExtractWhere)
+		c.check(err, "Fix the filter expression given with --where, like --where 'status=200 && tech~wordpress'.")
+	}
+
+	if *session.Options.Command == CommandImport {
+		c.exists(*session.Options.ImportPath, "Results to import", "import")
+	}
+
+	if sessionFormat, err := ParseSessionFormat(*session.Options.SessionFormat); c.check(err, "Leave out --session-format to write JSON.") {
+		session.Options.SessionFormat = &sessionFormat
+	}
+
+	probeMethod, err := ParseProbeMethod(*session.Options.ProbeMethod)
+	if c.check(err, "Give an HTTP method, like --probe-method POST.") {
+		session.Options.ProbeMethod = &probeMethod
+	}
+
+	probeBody, err := ReadProbeBody(*session.Options.ProbeBody)
+	if c.check(err, "Give the body as text, or as @ followed by the path of a file with it.") {
+		session.Options.ProbeBody = &probeBody
+	}
+
+	if probeBody != "" && (probeMethod == "GET" || probeMethod == "HEAD") {
+		c.fail("Set a method that takes a body with --probe-method, like --probe-method POST.", "Probe body given with %s requests", probeMethod)
+	}
+
+	if saveBody, err := ParseSaveBody(*session.Options.SaveBody); c.check(err, "Leave out --save-body to save the start of bodies.") {
+		session.Options.SaveBody = &saveBody
+	}
+
+	// Chrome takes the window size as width,height
+	if width, height, err := ParseResolution(*session.Options.Resolution); c.check(err, fmt.Sprintf("Give the width and height of screenshots in pixels, like --resolution 1440,900 or 1920x1080, or one of the presets %s.", strings.Join(resolutionPresetNames, ", "))) {
+		resolution := fmt.Sprintf("%d,%d", width, height)
+		session.Options.Resolution = &resolution
+	}
+
+	if *session.Options.BodySampleSize <= 0 {
+		c.fail("Give the size in KB, like --body-sample-size 64.", "Body sample size must be greater than 0")
+	}
+
+	if *session.Options.MaxHosts < 0 || *session.Options.MaxURLs < 0 {
+		c.fail("Use 0 for no limit with --max-hosts and --max-urls.", "Maximum number of hosts and URLs must not be negative")
+	}
+
+	if *session.Options.MaxCIDRHosts <= 0 {
+		c.fail("Give the number of addresses, like --max-cidr-hosts 256 to allow /24 ranges.", "Maximum number of addresses of CIDR ranges must be greater than 0")
+	}
+
+	if *session.Options.MaxClientRedirects < 0 {
+		c.fail("Use 0 with --max-client-redirects to not follow client-side redirects.", "Maximum number of client-side redirects must not be negative")
+	}
+
+	if *session.Options.MaxPerHost < 0 {
+		c.fail("Use 0 with --max-per-host to allow half of --threads per host.", "Maximum number of requests per host must not be negative")
+	}
+
+	if *session.Options.MaxRetryAfter < 0 {
+		c.fail("Use 0 with --max-retry-after to never retry rate limited URLs.", "Maximum Retry-After delay must not be negative")
+	}
+
+	if *session.Options.SPARoutes < 0 {
+		c.fail("Use 0 with --spa-routes to only record the routes.", "Number of single page app routes to request must not be negative")
+	}
+
+	if *session.Options.ChromeInstances < 1 {
+		c.fail("Give the number of Chrome processes to run at the same time, like --chrome-instances 4.", "Number of Chrome instances must be at least 1")
+	}
+
+	if *session.Options.KeepSessions < 0 {
+		c.fail("Use 0 with --keep-sessions to only keep the current session.", "Number of sessions to keep must not be negative")
+	}
+
+	command := *session.Options.Command
+	scanning := (command == "" || command == CommandSelfTest) && *session.Options.SessionPath == ""
+	if scanning {
+		c.checkScanOptions(session.Options)
+	}
+
+	if command == CommandSelfTest && *session.Options.OutDir == "." {
+		dir, err := ioutil.TempDir("", "aquatone-selftest-")
+		if err != nil {
+			return nil, err
+		}
+		session.Options.OutDir = &dir
+	}
+
 	envOutPath := os.Getenv("AQUATONE_OUT_PATH")
 	if *session.Options.OutDir == "." && envOutPath != "" {
 		session.Options.OutDir = &envOutPath
 	}
 
-	outdir := filepath.Clean(*session.Options.OutDir)
+	outdir := filepath.Clean(strings.TrimSpace(*session.Options.OutDir))
 	session.Options.OutDir = &outdir
 
+	if command != CommandShow && command != CommandExtract && command != CommandTemplateLint {
+		c.checkOutDir(outdir)
+	}
+
+	if err := c.err(); err != nil {
+		return nil, err
+	}
+
 	session.Version = Version
 	session.Start()
 
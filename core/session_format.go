@@ -0,0 +1,124 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Formats of the session file, set with --session-format. The session file
+// is named aquatone_session followed by the format as extension.
+const (
+	SessionFormatJSON     = "json"
+	SessionFormatJSONGzip = "json.gz"
+	SessionFormatMsgpack  = "msgpack"
+)
+
+var sessionFormats = []string{SessionFormatJSON, SessionFormatJSONGzip, SessionFormatMsgpack}
+
+// ParseSessionFormat returns the --session-format for the given value.
+func ParseSessionFormat(s string) (string, error) {
+	for _, format := range sessionFormats {
+		if strings.EqualFold(s, format) {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("Invalid value %q for --session-format (valid values: %s)", s, strings.Join(sessionFormats, ", "))
+}
+
+// SessionFilename returns the name of the session file in the output
+// directory for the --session-format of the scan.
+func (s *Session) SessionFilename() string {
+	return "aquatone_session." + *s.Options.SessionFormat
+}
+
+// sessionFileFormat returns the format of a session file from its name,
+// JSON unless it ends in .json.gz or .msgpack.
+func sessionFileFormat(filename string) string {
+	for _, format := range sessionFormats {
+		if strings.HasSuffix(filename, "."+format) {
+			return format
+		}
+	}
+	return SessionFormatJSON
+}
+
+// FindSessionFile returns the path of the session file in dir, or an empty
+// string if there is none. If scans with different --session-format left
+// more than one, the one written last is returned.
+func FindSessionFile(dir string) string {
+	var found string
+	var modified int64
+	for _, format := range sessionFormats {
+		path := filepath.Join(dir, "aquatone_session."+format)
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if found == "" || info.ModTime().UnixNano() > modified {
+			found = path
+			modified = info.ModTime().UnixNano()
+		}
+	}
+	return found
+}
+
+// writeSession writes the session in the given format.
+func writeSession(w io.Writer, s *Session, format string) error {
+	if format == SessionFormatMsgpack {
+		return writeMsgpack(w, s)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if format == SessionFormatJSONGzip {
+		gz := gzip.NewWriter(w)
+		if _, err := gz.Write(data); err != nil {
+			return err
+		}
+		return gz.Close()
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// readSession reads a session file in any of the formats. The format is
+// detected from the content rather than the name, so renamed files load
+// too: gzip data starts with its magic number and JSON with a brace, and
+// anything else is taken for MessagePack.
+func readSession(r io.Reader) (*Session, error) {
+	br := bufio.NewReader(r)
+	start, _ := br.Peek(2)
+
+	var data []byte
+	var err error
+	switch {
+	case bytes.Equal(start, []byte{0x1f, 0x8b}):
+		var gz *gzip.Reader
+		if gz, err = gzip.NewReader(br); err != nil {
+			return nil, err
+		}
+		data, err = ioutil.ReadAll(gz)
+	case len(start) == 0 || strings.IndexByte("{ \t\r\n", start[0]) >= 0:
+		data, err = ioutil.ReadAll(br)
+	default:
+		return readMsgpack(br)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testSession() *Session {
+	startedAt := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	return &Session{
+		ID:      "5f0c6e0a-7b4e-4d8e-9b51-3a1c2f4d5e6f",
+		Version: Version,
+		Meta:    map[string]string{"engagement": "ACME-42"},
+		Stats: &Stats{
+			StartedAt:         startedAt,
+			FinishedAt:        startedAt.Add(90 * time.Second),
+			PortOpen:          3,
+			RequestSuccessful: 2,
+			ResponseCode2xx:   2,
+		},
+		Pages: map[string]*Page{
+			"https://example.com/": {
+				UUID:         "d2b1f5c4-1e0a-4c4b-8f3e-2a9d7c6b5a40",
+				URL:          "https://example.com/",
+				Hostname:     "example.com",
+				Addrs:        []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"},
+				Status:       "200 OK",
+				ResponseTime: 123,
+				PageTitle:    "Example Domain – ünïcode",
+				BodySize:     1256,
+				Headers:      []Header{{Name: "Server", Value: "ECS", DecreasesSecurity: true}},
+				Certificate: &Certificate{
+					Subject:   "CN=example.com",
+					NotBefore: startedAt.Add(-24 * time.Hour),
+					NotAfter:  startedAt.Add(365 * 24 * time.Hour),
+					DNSNames:  []string{"example.com", "www.example.com"},
+				},
+				Tags:         []Tag{{Text: "Login", Type: "info"}},
+				Technologies: []Technology{{Name: "nginx", Version: "1.25.3", Categories: []string{"Web servers"}}},
+				Notes:        []Note{NewNote("Exposed .git directory", SeverityHigh)},
+			},
+		},
+		Hosts: map[string]*Host{
+			"example.com": {Hostname: "example.com", IPs: []string{"93.184.216.34"}, OpenPorts: []int{80, 443}},
+		},
+		PageSimilarityClusters: map[string][]string{"cluster": {"https://example.com/"}},
+		PortAddrs:              map[string][]string{},
+		AgentTimings:           map[string]*AgentTiming{},
+	}
+}
+
+func TestSessionFormatsRoundTrip(t *testing.T) {
+	for _, format := range sessionFormats {
+		t.Run(format, func(t *testing.T) {
+			session := testSession()
+			path := filepath.Join(t.TempDir(), "aquatone_session."+format)
+			if err := session.SaveToPath(path); err != nil {
+				t.Fatalf("SaveToPath() failed: %v", err)
+			}
+			loaded, err := LoadSession(path)
+			if err != nil {
+				t.Fatalf("LoadSession() failed: %v", err)
+			}
+			if got, want := loaded.ToJSON(), session.ToJSON(); got != want {
+				t.Errorf("session changed in round trip\ngot:  %s\nwant: %s", got, want)
+			}
+			if !loaded.Stats.StartedAt.Equal(session.Stats.StartedAt) {
+				t.Errorf("StartedAt = %v; want %v", loaded.Stats.StartedAt, session.Stats.StartedAt)
+			}
+		})
+	}
+}
+
+func TestSessionFileFormat(t *testing.T) {
+	tests := map[string]string{
+		"aquatone_session.json":    SessionFormatJSON,
+		"aquatone_session.json.gz": SessionFormatJSONGzip,
+		"aquatone_session.msgpack": SessionFormatMsgpack,
+		"session.txt":              SessionFormatJSON,
+	}
+	for filename, want := range tests {
+		if got := sessionFileFormat(filename); got != want {
+			t.Errorf("sessionFileFormat(%q) = %q; want %q", filename, got, want)
+		}
+	}
+}
+
+func TestReadSessionDetectsFormat(t *testing.T) {
+	session := testSession()
+	for _, format := range sessionFormats {
+		var buf bytes.Buffer
+		if err := writeSession(&buf, session, format); err != nil {
+			t.Fatalf("writeSession(%s) failed: %v", format, err)
+		}
+		// The format is detected from the content, not from a file name
+		loaded, err := readSession(&buf)
+		if err != nil {
+			t.Fatalf("readSession(%s) failed: %v", format, err)
+		}
+		if loaded.ID != session.ID || len(loaded.Pages) != len(session.Pages) {
+			t.Errorf("readSession(%s) = %+v; want the written session", format, loaded)
+		}
+	}
+}
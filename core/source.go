@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// sourceDialer binds outgoing connections to the address given with
+// --source-ip, or an address of the network interface given with
+// --interface, for multi-homed machines where traffic must leave from a
+// whitelisted address. The address of the destination's family is used,
+// falling back to the other family when the source has only one.
+type sourceDialer struct {
+	ipv4 net.IP
+	ipv6 net.IP
+}
+
+// newSourceDialer returns a dialer bound to the source IP or interface, or
+// nil if neither is given.
+func newSourceDialer(sourceIP string, iface string) (*sourceDialer, error) {
+	var ips []net.IP
+	switch {
+	case sourceIP != "" && iface != "":
+		return nil, fmt.Errorf("Only one of --source-ip and --interface can be given")
+	case sourceIP != "":
+		ip := net.ParseIP(sourceIP)
+		if ip == nil {
+			return nil, fmt.Errorf("Invalid source IP address %q", sourceIP)
+		}
+		ips = append(ips, ip)
+	case iface != "":
+		i, err := net.InterfaceByName(iface)
+		if err != nil {
+			return nil, fmt.Errorf("Unknown network interface %q", iface)
+		}
+		addrs, err := i.Addrs()
+		if err != nil {
+			return nil, fmt.Errorf("Unable to get addresses of network interface %s: %v", iface, err)
+		}
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && !ipNet.IP.IsLinkLocalUnicast() {
+				ips = append(ips, ipNet.IP)
+			}
+		}
+		if len(ips) == 0 {
+			return nil, fmt.Errorf("Network interface %s has no usable addresses", iface)
+		}
+	default:
+		return nil, nil
+	}
+
+	d := &sourceDialer{}
+	for _, ip := range ips {
+		if ip.To4() != nil && d.ipv4 == nil {
+			d.ipv4 = ip
+		} else if ip.To4() == nil && d.ipv6 == nil {
+			d.ipv6 = ip
+		}
+	}
+	return d, nil
+}
+
+func (d *sourceDialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	local := d.ipv4
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+			local = d.ipv6
+		}
+	}
+	if local == nil {
+		local = d.ipv4
+		if local == nil {
+			local = d.ipv6
+		}
+	}
+
+	dialer := &net.Dialer{}
+	switch {
+	case strings.HasPrefix(network, "tcp"):
+		dialer.LocalAddr = &net.TCPAddr{IP: local}
+	case strings.HasPrefix(network, "udp"):
+		dialer.LocalAddr = &net.UDPAddr{IP: local}
+	}
+	return dialer.DialContext(ctx, network, address)
+}
+
+// SourceBound reports whether outgoing connections are bound to a source
+// address with --source-ip or --interface.
+func (s *Session) SourceBound() bool {
+	_, ok := s.Dialer.(*sourceDialer)
+	return ok
+}
+
+// StartBrowserProxy starts a SOCKS proxy on the loopback interface that
+// opens connections with the session dialer, for the browser to take
+// screenshots through.
+func (s *Session) StartBrowserProxy() error {
+	proxy, err := startSOCKSProxy(s.Dialer, s.Out)
+	if err != nil {
+		return err
+	}
+	s.BrowserProxy = proxy
+	return nil
+}
@@ -0,0 +1,305 @@
+package core
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Files of the response store in the output directory.
+const (
+	StoreFilename      = "aquatone_store.zst"
+	StoreIndexFilename = "aquatone_store.idx"
+)
+
+// MaxStoredBodySize is the size of the largest body kept in the response
+// store. Larger bodies are written to html/ as before, as reading them back
+// means decompressing them as a whole.
+const MaxStoredBodySize = 1024 * 1024
+
+// storeRecord is a line of the store index. A record with Deleted set
+// removes the file of an earlier record with the same name.
+type storeRecord struct {
+	Name    string `json:"name"`
+	Offset  int64  `json:"offset,omitempty"`
+	Length  int64  `json:"length,omitempty"`
+	Deleted bool   `json:"deleted,omitempty"`
+}
+
+// ResponseStore keeps the small files written for pages, like raw requests,
+// response headers and bodies, in a single file instead of one file each,
+// which thousands of pages turn into a burden for the file system. Every file
+// is appended as a zstd frame of its own and the index, a file of JSON
+// lines, gets a line with the name, offset and length of the frame, so files
+// are read without decompressing anything else. Both files are only ever
+// appended to; a file written again or removed leaves its old frame behind
+// until the store is compacted.
+type ResponseStore struct {
+	sync.Mutex
+	data    *os.File
+	index   *os.File
+	size    int64
+	records map[string]storeRecord
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// OpenResponseStore opens the response store in dir, creating it if it
+// doesn't exist. Index lines pointing past the end of the data, left by a
+// scan that was killed while writing, are ignored.
+func OpenResponseStore(dir string) (*ResponseStore, error) {
+	data, err := os.OpenFile(filepath.Join(dir, StoreFilename), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	index, err := os.OpenFile(filepath.Join(dir, StoreIndexFilename), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		data.Close()
+		return nil, err
+	}
+	info, err := data.Stat()
+	if err != nil {
+		data.Close()
+		index.Close()
+		return nil, err
+	}
+
+	store := &ResponseStore{
+		data:    data,
+		index:   index,
+		size:    info.Size(),
+		records: make(map[string]storeRecord),
+	}
+	scanner := bufio.NewScanner(index)
+	for scanner.Scan() {
+		var record storeRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		if record.Deleted {
+			delete(store.records, record.Name)
+		} else if record.Offset+record.Length <= store.size {
+			store.records[record.Name] = record
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	if store.encoder, err = zstd.NewWriter(nil); err != nil {
+		store.Close()
+		return nil, err
+	}
+	if store.decoder, err = zstd.NewReader(nil); err != nil {
+		store.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// ResponseStoreExists reports whether dir has a response store.
+func ResponseStoreExists(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, StoreIndexFilename))
+	return err == nil
+}
+
+// Put adds the file with the given name, a path relative to the output
+// directory like headers/example.com.txt, to the store.
+func (st *ResponseStore) Put(name string, content []byte) error {
+	frame := st.encoder.EncodeAll(content, nil)
+	st.Lock()
+	defer st.Unlock()
+	if _, err := st.data.Write(frame); err != nil {
+		return err
+	}
+	record := storeRecord{Name: name, Offset: st.size, Length: int64(len(frame))}
+	st.size += record.Length
+	if err := st.appendRecord(record); err != nil {
+		return err
+	}
+	st.records[name] = record
+	return nil
+}
+
+// Get returns the content of the file with the given name. The error is
+// os.ErrNotExist if the store doesn't have it.
+func (st *ResponseStore) Get(name string) ([]byte, error) {
+	st.Lock()
+	record, ok := st.records[name]
+	st.Unlock()
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	frame := make([]byte, record.Length)
+	if _, err := st.data.ReadAt(frame, record.Offset); err != nil {
+		return nil, err
+	}
+	content, err := st.decoder.DecodeAll(frame, nil)
+	if err != nil {
+		return nil, fmt.Errorf("corrupt file %s in response store: %v", name, err)
+	}
+	return content, nil
+}
+
+// Has reports whether the store has the file with the given name.
+func (st *ResponseStore) Has(name string) bool {
+	st.Lock()
+	defer st.Unlock()
+	_, ok := st.records[name]
+	return ok
+}
+
+// Delete removes the file with the given name from the store.
+func (st *ResponseStore) Delete(name string) error {
+	st.Lock()
+	defer st.Unlock()
+	if _, ok := st.records[name]; !ok {
+		return nil
+	}
+	if err := st.appendRecord(storeRecord{Name: name, Deleted: true}); err != nil {
+		return err
+	}
+	delete(st.records, name)
+	return nil
+}
+
+// Names returns the names of the files in the store in sorted order.
+func (st *ResponseStore) Names() []string {
+	st.Lock()
+	defer st.Unlock()
+	var names []string
+	for name := range st.records {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (st *ResponseStore) appendRecord(record storeRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = st.index.Write(append(line, '\n'))
+	return err
+}
+
+// Close closes the files of the store.
+func (st *ResponseStore) Close() error {
+	if st.encoder != nil {
+		st.encoder.Close()
+	}
+	if st.decoder != nil {
+		st.decoder.Close()
+	}
+	st.index.Close()
+	return st.data.Close()
+}
+
+// CompactResponseStore rewrites the response store in dir with only the
+// files that keep returns true for, and returns the names of the files that
+// were dropped and the number of bytes freed. Frames are copied as they are,
+// without decompressing them.
+func CompactResponseStore(dir string, keep func(name string) bool) ([]string, int64, error) {
+	store, err := OpenResponseStore(dir)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer store.Close()
+
+	tmpDir := filepath.Join(dir, ".aquatone_store")
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return nil, 0, err
+	}
+	defer os.RemoveAll(tmpDir)
+	tmp, err := OpenResponseStore(tmpDir)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var dropped []string
+	for _, name := range store.Names() {
+		if !keep(name) {
+			dropped = append(dropped, name)
+			continue
+		}
+		record := store.records[name]
+		frame := make([]byte, record.Length)
+		if _, err := store.data.ReadAt(frame, record.Offset); err != nil {
+			tmp.Close()
+			return nil, 0, err
+		}
+		if _, err := tmp.data.Write(frame); err != nil {
+			tmp.Close()
+			return nil, 0, err
+		}
+		if err := tmp.appendRecord(storeRecord{Name: name, Offset: tmp.size, Length: record.Length}); err != nil {
+			tmp.Close()
+			return nil, 0, err
+		}
+		tmp.size += record.Length
+	}
+	freed := store.size - tmp.size
+	if err := tmp.Close(); err != nil {
+		return nil, 0, err
+	}
+
+	for _, name := range []string{StoreFilename, StoreIndexFilename} {
+		if err := os.Rename(filepath.Join(tmpDir, name), filepath.Join(dir, name)); err != nil {
+			return nil, 0, err
+		}
+	}
+	return dropped, freed, nil
+}
+
+// storedFile reports whether a file is kept in the response store when it
+// is used: raw requests and headers always are, bodies unless they are
+// larger than MaxStoredBodySize.
+func storedFile(name string, size int) bool {
+	return strings.HasPrefix(name, "headers/") || (strings.HasPrefix(name, "html/") && size <= MaxStoredBodySize)
+}
+
+// store returns the response store of the output directory. It is opened on
+// first use, if the scan was started with --response-store or an earlier
+// scan created one, and is nil otherwise.
+func (s *Session) store() *ResponseStore {
+	s.responseStoreOnce.Do(func() {
+		dir := s.GetFilePath("")
+		if !*s.Options.ResponseStore && !ResponseStoreExists(dir) {
+			return
+		}
+		store, err := OpenResponseStore(dir)
+		if err != nil {
+			s.Out.Error("Unable to open response store in %s: %v\n", dir, err)
+			return
+		}
+		s.responseStore = store
+	})
+	return s.responseStore
+}
+
+// StoredFiles returns the names of the files in the response store of the
+// output directory, or nil if it has none.
+func (s *Session) StoredFiles() []string {
+	store := s.store()
+	if store == nil {
+		return nil
+	}
+	return store.Names()
+}
+
+// CloseResponseStore closes the response store, if it was opened.
+func (s *Session) CloseResponseStore() error {
+	if s.responseStore == nil {
+		return nil
+	}
+	return s.responseStore.Close()
+}
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Summary is the single line of JSON printed at the end of a scan in silent
+// mode, meant to be consumed by scripts.
+type Summary struct {
+	Version        string            `json:"version"`
+	Stats          *Stats            `json:"stats"`
+	Duration       float64           `json:"duration"`
+	Pages          int               `json:"pages"`
+	Hosts          int               `json:"hosts"`
+	Clusters       int               `json:"clusters"`
+	Failures       int               `json:"failures"`
+	FailureRate    float64           `json:"failureRate"`
+	Baseline       map[string]int    `json:"baseline,omitempty"`
+	Outputs        map[string]string `json:"outputs"`
+	Findings       []SummaryFinding  `json:"findings"`
+	DNSFindings    []DNSFinding      `json:"dnsFindings"`
+	FailConditions []string          `json:"failConditions"`
+}
+
+// SummaryFinding is a warning or danger tag of a page.
+type SummaryFinding struct {
+	URL      string `json:"url"`
+	Finding  string `json:"finding"`
+	Severity string `json:"severity"`
+}
+
+// Summary returns a summary of the session. The fail conditions that were
+// met are included so scripts don't have to rely on the exit code alone.
+func (s *Session) Summary(met []FailCondition) *Summary {
+	summary := &Summary{
+		Version:        s.Version,
+		Stats:          s.Stats,
+		Duration:       s.Stats.Duration().Seconds(),
+		Pages:          len(s.Pages),
+		Clusters:       len(s.PageSimilarityClusters),
+		Failures:       len(s.Failures),
+		FailureRate:    s.FailureRate(),
+		Outputs:        s.summaryOutputs(),
+		Findings:       []SummaryFinding{},
+		DNSFindings:    []DNSFinding{},
+		FailConditions: []string{},
+	}
+
+	hosts := make(map[string]struct{})
+	var urls []string
+	for url, page := range s.Pages {
+		hosts[page.Hostname] = struct{}{}
+		urls = append(urls, url)
+		if page.Baseline != "" {
+			if summary.Baseline == nil {
+				summary.Baseline = make(map[string]int)
+			}
+			summary.Baseline[page.Baseline]++
+		}
+	}
+	summary.Hosts = len(hosts)
+	if len(s.GonePages) > 0 {
+		if summary.Baseline == nil {
+			summary.Baseline = make(map[string]int)
+		}
+		summary.Baseline[BaselineGone] = len(s.GonePages)
+	}
+
+	sort.Strings(urls)
+	for _, url := range urls {
+		for _, tag := range s.Pages[url].Tags {
+			if tag.Type != "danger" && tag.Type != "warning" {
+				continue
+			}
+			summary.Findings = append(summary.Findings, SummaryFinding{
+				URL:      url,
+				Finding:  tag.Text,
+				Severity: tag.Type,
+			})
+		}
+	}
+
+	summary.DNSFindings = append(summary.DNSFindings, s.DNSFindings...)
+
+	for _, condition := range met {
+		summary.FailConditions = append(summary.FailConditions, condition.String())
+	}
+
+	return summary
+}
+
+// summaryOutputs returns the paths of the files written by the scan.
+func (s *Session) summaryOutputs() map[string]string {
+	outputs := map[string]string{
+		"manifest": s.GetFilePath(ManifestFilename),
+	}
+	for name, p := range layoutFiles {
+		if name == "html" && *s.Options.SaveBody == SaveBodyNone {
+			continue
+		}
+		outputs[name] = s.GetFilePath(p)
+	}
+
+	optional := map[string]*string{
+		"burp":       s.Options.ExportBurp,
+		"zap":        s.Options.ExportZAP,
+		"defectdojo": s.Options.ExportDefectDojo,
+		"cyclonedx":  s.Options.ExportCycloneDX,
+		"stix":       s.Options.ExportSTIX,
+		"archive":    s.Options.Archive,
+	}
+	for name, path := range optional {
+		if *path != "" {
+			outputs[name] = *path
+		}
+	}
+	return outputs
+}
+
+func (s *Summary) ToJSON() string {
+	summaryJSON, _ := json.Marshal(s)
+	return string(summaryJSON)
+}
@@ -0,0 +1,54 @@
+package core
+
+import "sort"
+
+// Confidence levels of takeover findings. A takeover is confirmed when
+// --verify-takeover found that the resource the hostname points to can be
+// claimed, refuted when the resource was found to exist, and unverified when
+// the fingerprint matched but there was no way to verify it or it wasn't
+// asked for.
+const (
+	TakeoverConfirmed  = "confirmed"
+	TakeoverUnverified = "unverified"
+	TakeoverRefuted    = "refuted"
+)
+
+// Takeover is a page that matched the takeover fingerprint of a service.
+// Evidence is the part of the response body the fingerprint matched, and
+// Link points to the service's documentation on claiming or removing the
+// custom domain.
+type Takeover struct {
+	Service      string `json:"service"`
+	CNAME        string `json:"cname,omitempty"`
+	Evidence     string `json:"evidence"`
+	Confidence   string `json:"confidence"`
+	Verification string `json:"verification,omitempty"`
+	Link         string `json:"link"`
+}
+
+// SetTakeover records the takeover finding of the page.
+func (p *Page) SetTakeover(takeover Takeover) {
+	p.Lock()
+	defer p.Unlock()
+	p.Takeover = &takeover
+}
+
+// TakeoverPages returns the pages with takeover findings, confirmed ones
+// first and refuted ones last, and by URL otherwise.
+func (s *Session) TakeoverPages() []*Page {
+	rank := map[string]int{TakeoverConfirmed: 0, TakeoverUnverified: 1, TakeoverRefuted: 2}
+	var pages []*Page
+	for _, page := range s.Pages {
+		if page.Takeover != nil {
+			pages = append(pages, page)
+		}
+	}
+	sort.Slice(pages, func(i, j int) bool {
+		ri, rj := rank[pages[i].Takeover.Confidence], rank[pages[j].Takeover.Confidence]
+		if ri != rj {
+			return ri < rj
+		}
+		return pages[i].URL < pages[j].URL
+	})
+	return pages
+}
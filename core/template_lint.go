@@ -0,0 +1,415 @@
+package core
+
+import (
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template/parse"
+)
+
+// templateMethods are the methods with pointer receivers that report
+// templates may call. They only read the session; all other methods with
+// pointer receivers of the data model can change it, write files or connect
+// to hosts, and are off limits to templates. Methods with value receivers
+// can't change anything and are always allowed.
+var templateMethods = map[string]bool{
+	"Session.FailureRate":        true,
+	"Session.GetHost":            true,
+	"Session.GetPage":            true,
+	"Session.GetPageByUUID":      true,
+	"Session.HostQuarantined":    true,
+	"Session.QuarantinedHosts":   true,
+	"Session.SortedAgentTimings": true,
+	"Session.SortedHosts":        true,
+	"Session.TakeoverPages":      true,
+	"Session.ToJSON":             true,
+	"Page.BaseFilename":          true,
+	"Page.DestinationURL":        true,
+	"Page.HasHeader":             true,
+	"Page.HasTag":                true,
+	"Page.IsIPHost":              true,
+	"Page.ParsedURL":             true,
+	"Page.RequiresAuth":          true,
+	"Stats.Duration":             true,
+	"AgentTiming.Average":        true,
+	"AgentTiming.Elapsed":        true,
+}
+
+// Result types of the builtin functions of Go templates. Functions missing
+// here, like and, or and index, return values of any type.
+var templateBuiltinTypes = map[string]reflect.Type{
+	"eq":       reflect.TypeOf(false),
+	"ne":       reflect.TypeOf(false),
+	"lt":       reflect.TypeOf(false),
+	"le":       reflect.TypeOf(false),
+	"gt":       reflect.TypeOf(false),
+	"ge":       reflect.TypeOf(false),
+	"not":      reflect.TypeOf(false),
+	"len":      reflect.TypeOf(0),
+	"print":    reflect.TypeOf(""),
+	"printf":   reflect.TypeOf(""),
+	"println":  reflect.TypeOf(""),
+	"html":     reflect.TypeOf(""),
+	"js":       reflect.TypeOf(""),
+	"urlquery": reflect.TypeOf(""),
+}
+
+// TemplateProblem is an error or warning found in a report template.
+type TemplateProblem struct {
+	Location string
+	Message  string
+	Warning  bool
+}
+
+func (p TemplateProblem) String() string {
+	level := "error"
+	if p.Warning {
+		level = "warning"
+	}
+	return fmt.Sprintf("%s: %s: %s", p.Location, level, p.Message)
+}
+
+// LintTemplate checks a report template against the data model it is
+// executed with, the session, before a scan has to run into its errors. The
+// fields and methods the template refers to are resolved on the types of
+// the session, following the dot through with and range actions, variables
+// and nested templates. Unknown fields and calls of methods that aren't
+// part of the data model are errors. Fields left out of the session file are
+// warnings, as they are internals that can change between versions. Values
+// of interface types can't be checked and are trusted.
+func LintTemplate(name string, text string) ([]TemplateProblem, error) {
+	funcs := NewReport(nil, text).funcs("")
+	tmpl, err := template.New(name).Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &templateLinter{
+		tmpl:     tmpl,
+		funcs:    funcs,
+		visiting: make(map[string]bool),
+		seen:     make(map[string]bool),
+	}
+	root := reflect.TypeOf(&Session{})
+	l.walkTemplate(tmpl.Tree, root)
+	return l.problems, nil
+}
+
+// checkTemplate lints the template given with --template-path, so that a
+// broken template fails the scan when it starts rather than when the report
+// is written at its end.
+func checkTemplate(path string) error {
+	text, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	problems, err := LintTemplate(filepath.Base(path), string(text))
+	if err != nil {
+		return fmt.Errorf("Template %s is invalid: %v", path, err)
+	}
+	for _, problem := range problems {
+		if !problem.Warning {
+			return fmt.Errorf("Template %s is invalid: %s (run aquatone template lint %s for all problems)", path, problem, path)
+		}
+	}
+	return nil
+}
+
+type templateLinter struct {
+	tmpl     *template.Template
+	funcs    template.FuncMap
+	tree     *parse.Tree
+	root     reflect.Type
+	problems []TemplateProblem
+	visiting map[string]bool
+	seen     map[string]bool
+}
+
+// walkTemplate checks a template executed with a dot of the given type.
+func (l *templateLinter) walkTemplate(tree *parse.Tree, dot reflect.Type) {
+	if tree == nil || tree.Root == nil || l.visiting[tree.Name] {
+		return
+	}
+	l.visiting[tree.Name] = true
+	defer delete(l.visiting, tree.Name)
+
+	outer, outerRoot := l.tree, l.root
+	l.tree, l.root = tree, dot
+	l.walk(tree.Root, dot, map[string]reflect.Type{"$": dot})
+	l.tree, l.root = outer, outerRoot
+}
+
+func (l *templateLinter) walk(node parse.Node, dot reflect.Type, vars map[string]reflect.Type) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			l.walk(child, dot, vars)
+		}
+	case *parse.ActionNode:
+		l.pipe(n.Pipe, dot, vars)
+	case *parse.IfNode:
+		l.pipe(n.Pipe, dot, vars)
+		l.walk(n.List, dot, copyTemplateVars(vars))
+		l.walk(n.ElseList, dot, copyTemplateVars(vars))
+	case *parse.WithNode:
+		inner := copyTemplateVars(vars)
+		t := l.pipe(n.Pipe, dot, inner)
+		l.walk(n.List, t, inner)
+		l.walk(n.ElseList, dot, copyTemplateVars(vars))
+	case *parse.RangeNode:
+		inner := copyTemplateVars(vars)
+		t := l.rangePipe(n.Pipe, dot, inner)
+		l.walk(n.List, templateElemType(t), inner)
+		l.walk(n.ElseList, dot, copyTemplateVars(vars))
+	case *parse.TemplateNode:
+		t := dot
+		if n.Pipe != nil {
+			t = l.pipe(n.Pipe, dot, vars)
+		} else {
+			t = nil
+		}
+		named := l.tmpl.Lookup(n.Name)
+		if named == nil {
+			l.errorf(n, "no template named %q", n.Name)
+			return
+		}
+		l.walkTemplate(named.Tree, t)
+	}
+}
+
+// pipe checks a pipeline and returns the type of its result, declaring its
+// variables in vars.
+func (l *templateLinter) pipe(pipe *parse.PipeNode, dot reflect.Type, vars map[string]reflect.Type) reflect.Type {
+	if pipe == nil {
+		return nil
+	}
+	var t reflect.Type
+	for _, cmd := range pipe.Cmds {
+		t = l.command(cmd, dot, vars)
+	}
+	for _, decl := range pipe.Decl {
+		vars[decl.Ident[0]] = t
+	}
+	return t
+}
+
+// rangePipe checks the pipeline of a range action, whose variables are the
+// key and element of what is ranged over.
+func (l *templateLinter) rangePipe(pipe *parse.PipeNode, dot reflect.Type, vars map[string]reflect.Type) reflect.Type {
+	decl := pipe.Decl
+	pipe.Decl = nil
+	t := l.pipe(pipe, dot, vars)
+	pipe.Decl = decl
+	switch len(decl) {
+	case 1:
+		vars[decl[0].Ident[0]] = templateElemType(t)
+	case 2:
+		vars[decl[0].Ident[0]] = templateKeyType(t)
+		vars[decl[1].Ident[0]] = templateElemType(t)
+	}
+	return t
+}
+
+func (l *templateLinter) command(cmd *parse.CommandNode, dot reflect.Type, vars map[string]reflect.Type) reflect.Type {
+	for _, arg := range cmd.Args[1:] {
+		l.arg(arg, dot, vars)
+	}
+	if ident, ok := cmd.Args[0].(*parse.IdentifierNode); ok {
+		if t, ok := templateBuiltinTypes[ident.Ident]; ok {
+			return t
+		}
+		if f, ok := l.funcs[ident.Ident]; ok {
+			return reflect.TypeOf(f).Out(0)
+		}
+		return nil
+	}
+	return l.arg(cmd.Args[0], dot, vars)
+}
+
+// arg checks an argument of a command and returns its type, or nil if it
+// isn't known.
+func (l *templateLinter) arg(node parse.Node, dot reflect.Type, vars map[string]reflect.Type) reflect.Type {
+	switch n := node.(type) {
+	case *parse.DotNode:
+		return dot
+	case *parse.FieldNode:
+		return l.chain(n, dot, n.Ident)
+	case *parse.VariableNode:
+		t, ok := vars[n.Ident[0]]
+		if !ok {
+			return nil
+		}
+		return l.chain(n, t, n.Ident[1:])
+	case *parse.ChainNode:
+		return l.chain(n, l.arg(n.Node, dot, vars), n.Field)
+	case *parse.PipeNode:
+		return l.pipe(n, dot, copyTemplateVars(vars))
+	case *parse.StringNode:
+		return reflect.TypeOf("")
+	case *parse.BoolNode:
+		return reflect.TypeOf(false)
+	}
+	return nil
+}
+
+// chain resolves the fields and methods of a chain like .Stats.StartedAt on
+// type t.
+func (l *templateLinter) chain(node parse.Node, t reflect.Type, idents []string) reflect.Type {
+	for _, ident := range idents {
+		if t == nil {
+			return nil
+		}
+		if method, ok := templateMethod(t, ident); ok {
+			l.checkMethod(node, t, method)
+			if method.Type.NumOut() == 0 {
+				return nil
+			}
+			t = method.Type.Out(0)
+			continue
+		}
+
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			field, ok := t.FieldByName(ident)
+			if !ok || field.PkgPath != "" {
+				l.errorf(node, "%s has no field or method %s%s", templateTypeName(t), ident, templateSuggestion(t, ident))
+				return nil
+			}
+			if field.Tag.Get("json") == "-" && t.PkgPath() == reflect.TypeOf(Session{}).PkgPath() {
+				l.warnf(node, "%s.%s is internal and not part of the session file, so it can change or go away in any version", templateTypeName(t), ident)
+			}
+			t = field.Type
+		case reflect.Map:
+			t = t.Elem()
+		case reflect.Interface:
+			return nil
+		default:
+			l.errorf(node, "can't evaluate field %s of %s", ident, t)
+			return nil
+		}
+	}
+	return t
+}
+
+// checkMethod reports calls of methods with pointer receivers of the data
+// model that aren't in templateMethods.
+func (l *templateLinter) checkMethod(node parse.Node, t reflect.Type, method reflect.Method) {
+	base := t
+	for base.Kind() == reflect.Ptr {
+		base = base.Elem()
+	}
+	if base.PkgPath() != reflect.TypeOf(Session{}).PkgPath() {
+		return
+	}
+	if _, ok := base.MethodByName(method.Name); ok {
+		return
+	}
+	if !templateMethods[base.Name()+"."+method.Name] {
+		l.errorf(node, "%s.%s can change the session, write files or connect to hosts, and can't be called from templates", templateTypeName(base), method.Name)
+	}
+}
+
+func (l *templateLinter) errorf(node parse.Node, format string, args ...interface{}) {
+	l.report(node, false, fmt.Sprintf(format, args...))
+}
+
+func (l *templateLinter) warnf(node parse.Node, format string, args ...interface{}) {
+	l.report(node, true, fmt.Sprintf(format, args...))
+}
+
+// report adds a problem, once per location and message, as templates
+// executed more than once would report their problems again.
+func (l *templateLinter) report(node parse.Node, warning bool, message string) {
+	location, _ := l.tree.ErrorContext(node)
+	key := location + message
+	if l.seen[key] {
+		return
+	}
+	l.seen[key] = true
+	l.problems = append(l.problems, TemplateProblem{Location: location, Message: message, Warning: warning})
+}
+
+// templateMethod looks up a method the way templates do, including methods
+// with pointer receivers on addressable values.
+func templateMethod(t reflect.Type, name string) (reflect.Method, bool) {
+	if method, ok := t.MethodByName(name); ok {
+		return method, true
+	}
+	if t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface {
+		return reflect.PtrTo(t).MethodByName(name)
+	}
+	return reflect.Method{}, false
+}
+
+// templateSuggestion returns a hint for a field name that doesn't exist but
+// differs from one that does in case only, like pageTitle for PageTitle, or
+// is the name of a field in the session file.
+func templateSuggestion(t reflect.Type, name string) string {
+	var candidates []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if strings.EqualFold(field.Name, name) || jsonName == name {
+			candidates = append(candidates, field.Name)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.Strings(candidates)
+	return fmt.Sprintf(" (did you mean %s?)", strings.Join(candidates, " or "))
+}
+
+func templateTypeName(t reflect.Type) string {
+	if t.PkgPath() == reflect.TypeOf(Session{}).PkgPath() {
+		return t.Name()
+	}
+	return t.String()
+}
+
+func templateElemType(t reflect.Type) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return t.Elem()
+	case reflect.Int:
+		return t
+	}
+	return nil
+}
+
+func templateKeyType(t reflect.Type) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Map:
+		return t.Key()
+	case reflect.Slice, reflect.Array:
+		return reflect.TypeOf(0)
+	}
+	return nil
+}
+
+func copyTemplateVars(vars map[string]reflect.Type) map[string]reflect.Type {
+	copied := make(map[string]reflect.Type, len(vars))
+	for name, t := range vars {
+		copied[name] = t
+	}
+	return copied
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// minThrottleInterval is the time between requests to a host after it
+	// first rate limited the scan. It doubles every time the host does it
+	// again, up to maxThrottleInterval.
+	minThrottleInterval = 500 * time.Millisecond
+	maxThrottleInterval = 30 * time.Second
+	// defaultRetryAfter is how long to wait after a 429 response without a
+	// Retry-After header.
+	defaultRetryAfter = 5 * time.Second
+)
+
+// hostThrottle spaces out the requests to a host that rate limited the scan.
+type hostThrottle struct {
+	interval time.Duration
+	next     time.Time
+	slowedAt time.Time
+}
+
+// RetryAfter reports whether a response with the status code and
+// Retry-After header means the client is rate limited, and how long to wait
+// before trying again. A 429 Too Many Requests always means it is, and a
+// 503 Service Unavailable only with a Retry-After header, as servers that
+// are really down rarely send one. The header is either a number of seconds
+// or an HTTP date.
+func RetryAfter(statusCode int, header string, now time.Time) (time.Duration, bool) {
+	if statusCode != http.StatusTooManyRequests && statusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	header = strings.TrimSpace(header)
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	if statusCode == http.StatusTooManyRequests {
+		return defaultRetryAfter, true
+	}
+	return 0, false
+}
+
+// ThrottleHost slows down requests to a host that rate limited the scan. No
+// request goes to the host before delay has passed, and after that they are
+// spaced out by an interval that doubles when the host still rate limits the
+// scan once it has been in effect. It returns the interval, and whether it
+// was changed.
+func (s *Session) ThrottleHost(host string, delay time.Duration) (time.Duration, bool) {
+	s.Lock()
+	defer s.Unlock()
+	if s.throttles == nil {
+		s.throttles = make(map[string]*hostThrottle)
+	}
+	now := s.Clock.Now()
+	key := hostAddrsKey(host)
+	throttle, ok := s.throttles[key]
+	changed := false
+	if !ok {
+		throttle = &hostThrottle{interval: minThrottleInterval, slowedAt: now}
+		s.throttles[key] = throttle
+		changed = true
+	} else if throttle.interval < maxThrottleInterval && now.Sub(throttle.slowedAt) >= throttle.interval {
+		// Requests that were already under way when the host was slowed
+		// down don't count
+		throttle.interval *= 2
+		if throttle.interval > maxThrottleInterval {
+			throttle.interval = maxThrottleInterval
+		}
+		throttle.slowedAt = now
+		changed = true
+	}
+	if until := now.Add(delay); until.After(throttle.next) {
+		throttle.next = until
+	}
+	return throttle.interval, changed
+}
+
+// WaitForHost blocks until the next request may be sent to the host, and
+// takes the turn. Requests to hosts that never rate limited the scan go out
+// right away.
+func (s *Session) WaitForHost(host string) {
+	s.Lock()
+	throttle, ok := s.throttles[hostAddrsKey(host)]
+	if !ok {
+		s.Unlock()
+		return
+	}
+	now := s.Clock.Now()
+	wait := throttle.next.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	throttle.next = now.Add(wait + throttle.interval)
+	s.Unlock()
+	time.Sleep(wait)
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+// Triage is the state of triaging pages in the report with the keyboard.
+// The report exports it as a JSON file that can be merged back into the
+// session with --triage.
+type Triage struct {
+	Flagged []string `json:"flagged"`
+	Hidden  []string `json:"hidden"`
+}
+
+// LoadTriage reads a triage file exported from the report.
+func LoadTriage(path string) (*Triage, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var triage Triage
+	if err := json.Unmarshal(data, &triage); err != nil {
+		return nil, err
+	}
+	return &triage, nil
+}
+
+// ApplyTriage marks the pages of the session as flagged or hidden according
+// to the triage. The report starts out with the state stored in the session,
+// so the triage holds the complete state and pages it doesn't mention are
+// unflagged and shown again. The number of URLs in the triage that were not
+// found in the session is returned.
+func (s *Session) ApplyTriage(triage *Triage) int {
+	s.Lock()
+	defer s.Unlock()
+
+	flagged := make(map[string]bool)
+	hidden := make(map[string]bool)
+	for _, url := range triage.Flagged {
+		flagged[url] = true
+	}
+	for _, url := range triage.Hidden {
+		hidden[url] = true
+	}
+
+	found := 0
+	for url, page := range s.Pages {
+		page.Flagged = flagged[url]
+		page.Hidden = hidden[url]
+		if flagged[url] || hidden[url] {
+			found++
+		}
+	}
+
+	mentioned := make(map[string]bool)
+	for url := range flagged {
+		mentioned[url] = true
+	}
+	for url := range hidden {
+		mentioned[url] = true
+	}
+	return len(mentioned) - found
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// UnresponsiveURL is a URL that was requested but never responded, with the
+// reason it failed, so it can be retried or looked into.
+type UnresponsiveURL struct {
+	URL    string `json:"url"`
+	Reason string `json:"reason"`
+	Error  string `json:"error,omitempty"`
+}
+
+// AddUnresponsive records a URL that failed to respond. The URLs are kept
+// sorted, so sessions of the same scan compare equal.
+func (s *Session) AddUnresponsive(url string, reason string, err error) {
+	s.Lock()
+	defer s.Unlock()
+	i := sort.Search(len(s.Unresponsive), func(i int) bool {
+		return s.Unresponsive[i].URL >= url
+	})
+	if i < len(s.Unresponsive) && s.Unresponsive[i].URL == url {
+		return
+	}
+	unresponsive := UnresponsiveURL{URL: url, Reason: reason}
+	if err != nil {
+		unresponsive.Error = err.Error()
+	}
+	s.Unresponsive = append(s.Unresponsive, UnresponsiveURL{})
+	copy(s.Unresponsive[i+1:], s.Unresponsive[i:])
+	s.Unresponsive[i] = unresponsive
+}
+
+// SaveUnresponsiveToFile writes the unresponsive URLs with their failure
+// reason, one per line. The file can be fed back to Aquatone as it is to
+// retry them, as the reasons are not taken for hosts.
+func (s *Session) SaveUnresponsiveToFile(filename string) error {
+	s.Lock()
+	var lines []string
+	for _, u := range s.Unresponsive {
+		lines = append(lines, fmt.Sprintf("%s %s", u.URL, u.Reason))
+	}
+	s.Unlock()
+
+	content := strings.Join(lines, "\n")
+	if len(lines) > 0 {
+		content += "\n"
+	}
+	return ioutil.WriteFile(s.GetFilePath(filename), []byte(content), 0644)
+}
@@ -2,28 +2,116 @@ package core
 
 import (
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 var (
 	securePorts = []int{443, 832, 981, 1010, 1311, 2083, 2087, 2095, 2096, 4712,
 		7000, 8172, 8243, 8333, 8443, 8834, 9443, 12443, 18091, 18092}
+
+	defaultPorts = map[string]string{
+		"http":  "80",
+		"https": "443",
+	}
 )
 
 func HostAndPortToURL(host string, port int, protocol string) string {
-	var url string
-	if protocol != "" {
-		url = fmt.Sprintf("%s://%s", protocol, host)
-	} else if isSecurePort(port) {
-		url = fmt.Sprintf("https://%s", host)
-	} else {
-		url = fmt.Sprintf("http://%s", host)
-	}
-	if isStandardPort(port) {
-		url = fmt.Sprintf("%s/", url)
-	} else {
-		url = fmt.Sprintf("%s:%d/", url, port)
-	}
-	return url
+	if protocol == "" {
+		protocol = "http"
+		if isSecurePort(port) {
+			protocol = "https"
+		}
+	}
+	rawURL := fmt.Sprintf("%s://%s/", protocol, net.JoinHostPort(host, strconv.Itoa(port)))
+	if normalized, err := NormalizeURL(rawURL, false); err == nil {
+		return normalized
+	}
+	return rawURL
+}
+
+// NormalizeURL returns the canonical form of a URL, so the same page
+// reached through different inputs, like the port scanner and a URL given
+// as a target, is only processed once. The scheme and host are lowercased,
+// a trailing dot on the host and the default port of the scheme are
+// removed, dot segments in the path are resolved and an empty path becomes
+// /. The fragment is removed unless keepFragment is true, as it is never
+// sent to the server. The query is left alone.
+func NormalizeURL(rawURL string, keepFragment bool) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("%q is not an absolute URL", rawURL)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	port := u.Port()
+	if port == defaultPorts[u.Scheme] {
+		port = ""
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port != "" {
+		host += ":" + port
+	}
+	u.Host = host
+
+	if escaped := removeDotSegments(u.EscapedPath()); escaped != u.EscapedPath() {
+		if u.Path, err = url.PathUnescape(escaped); err != nil {
+			return "", err
+		}
+		u.RawPath = escaped
+	}
+	if u.Path == "" {
+		u.Path = "/"
+		u.RawPath = ""
+	}
+	if !keepFragment {
+		u.Fragment = ""
+		u.RawFragment = ""
+	}
+	return u.String(), nil
+}
+
+// NormalizeURL returns the canonical form of a URL, keeping the fragment
+// with --keep-fragments.
+func (s *Session) NormalizeURL(rawURL string) (string, error) {
+	return NormalizeURL(rawURL, *s.Options.KeepFragments)
+}
+
+// removeDotSegments resolves . and .. segments in a URL path as described
+// in RFC 3986, section 5.2.4.
+func removeDotSegments(p string) string {
+	if !strings.Contains(p, ".") {
+		return p
+	}
+	var out []string
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		switch segment {
+		case ".":
+			if last {
+				out = append(out, "")
+			}
+		case "..":
+			if len(out) > 1 {
+				out = out[:len(out)-1]
+			}
+			if last {
+				out = append(out, "")
+			}
+		default:
+			out = append(out, segment)
+		}
+	}
+	return strings.Join(out, "/")
 }
 
 func isSecurePort(port int) bool {
@@ -34,10 +122,3 @@ func isSecurePort(port int) bool {
 	}
 	return false
 }
-
-func isStandardPort(port int) bool {
-	if port == 80 || port == 443 {
-		return true
-	}
-	return false
-}
@@ -0,0 +1,150 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Names of the well-known URIs (RFC 8615) fetched with --well-known, in the
+// order they are listed in the report.
+const (
+	WellKnownSecurityTxt    = "security.txt"
+	WellKnownChangePassword = "change-password"
+	WellKnownOpenIDConfig   = "openid-configuration"
+	WellKnownOAuthServer    = "oauth-authorization-server"
+	WellKnownMTASTS         = "mta-sts.txt"
+	WellKnownAppleAppSite   = "apple-app-site-association"
+	WellKnownAssetLinks     = "assetlinks.json"
+	WellKnownNodeInfo       = "nodeinfo"
+	WellKnownHostMeta       = "host-meta"
+	WellKnownGPC            = "gpc.json"
+)
+
+var WellKnownNames = []string{
+	WellKnownSecurityTxt,
+	WellKnownChangePassword,
+	WellKnownOpenIDConfig,
+	WellKnownOAuthServer,
+	WellKnownMTASTS,
+	WellKnownAppleAppSite,
+	WellKnownAssetLinks,
+	WellKnownNodeInfo,
+	WellKnownHostMeta,
+	WellKnownGPC,
+}
+
+// Contents of well-known URIs are kept up to this many bytes.
+const maxWellKnownContent = 8192
+
+// WellKnownURI is a well-known URI fetched from a web server with
+// --well-known. Every URI that was fetched is recorded, with Found telling
+// whether the server publishes it. Location is where change-password
+// redirects to, and Contacts and Expires are the fields of security.txt.
+type WellKnownURI struct {
+	Name      string   `json:"name"`
+	URL       string   `json:"url"`
+	Found     bool     `json:"found"`
+	Status    string   `json:"status,omitempty"`
+	Location  string   `json:"location,omitempty"`
+	Content   string   `json:"content,omitempty"`
+	Truncated bool     `json:"truncated,omitempty"`
+	Contacts  []string `json:"contacts,omitempty"`
+	Expires   string   `json:"expires,omitempty"`
+}
+
+// SetContent keeps the start of the content of the URI, and the fields of
+// security.txt.
+func (w *WellKnownURI) SetContent(body []byte) {
+	if len(body) > maxWellKnownContent {
+		body, w.Truncated = body[:maxWellKnownContent], true
+	}
+	w.Content = string(body)
+	if w.Name == WellKnownSecurityTxt {
+		w.Contacts, w.Expires = ParseSecurityTxt(body)
+	}
+}
+
+// Expired reports whether the Expires field of security.txt is in the
+// past at the given time.
+func (w *WellKnownURI) Expired(now time.Time) bool {
+	expires, err := time.Parse(time.RFC3339, w.Expires)
+	return err == nil && expires.Before(now)
+}
+
+// ValidWellKnown reports whether body looks like the document a well-known
+// URI is defined to serve, so catch-all pages and soft 404s answering with
+// 200 OK aren't taken for it. change-password has no content of its own and
+// is checked against a URI that shouldn't exist instead.
+func ValidWellKnown(name string, body []byte) bool {
+	switch name {
+	case WellKnownSecurityTxt:
+		contacts, _ := ParseSecurityTxt(body)
+		return len(contacts) > 0 && !looksLikeHTML(body)
+	case WellKnownMTASTS:
+		return bytes.Contains(body, []byte("STSv1")) && !looksLikeHTML(body)
+	case WellKnownHostMeta:
+		return bytes.Contains(body, []byte("<XRD")) || jsonHasKey(body, "links")
+	case WellKnownOpenIDConfig, WellKnownOAuthServer:
+		return jsonHasKey(body, "issuer")
+	case WellKnownNodeInfo:
+		return jsonHasKey(body, "links")
+	case WellKnownGPC:
+		return jsonHasKey(body, "gpc")
+	case WellKnownAppleAppSite:
+		return jsonHasKey(body, "applinks") || jsonHasKey(body, "webcredentials") || jsonHasKey(body, "appclips")
+	case WellKnownAssetLinks:
+		var statements []map[string]json.RawMessage
+		return json.Unmarshal(body, &statements) == nil && len(statements) > 0 && statements[0]["target"] != nil
+	}
+	return true
+}
+
+// ParseSecurityTxt returns the Contact and Expires fields of a security.txt
+// file (RFC 9116).
+func ParseSecurityTxt(body []byte) ([]string, string) {
+	var contacts []string
+	var expires string
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(parts[1])
+		switch strings.ToLower(strings.TrimSpace(parts[0])) {
+		case "contact":
+			contacts = append(contacts, value)
+		case "expires":
+			expires = value
+		}
+	}
+	return contacts, expires
+}
+
+func looksLikeHTML(body []byte) bool {
+	start := bytes.ToLower(bytes.TrimSpace(body))
+	if len(start) > 512 {
+		start = start[:512]
+	}
+	return bytes.Contains(start, []byte("<html")) || bytes.Contains(start, []byte("<!doctype"))
+}
+
+func jsonHasKey(body []byte, key string) bool {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return false
+	}
+	_, ok := doc[key]
+	return ok
+}
+
+// AddWellKnownURI records a well-known URI fetched from the web server of
+// the page.
+func (p *Page) AddWellKnownURI(uri WellKnownURI) {
+	p.Lock()
+	defer p.Unlock()
+	p.WellKnown = append(p.WellKnown, uri)
+}
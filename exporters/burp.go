@@ -0,0 +1,99 @@
+package exporters
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/mk990/aquatone/core"
+)
+
+type burpItems struct {
+	XMLName     xml.Name   `xml:"items"`
+	BurpVersion string     `xml:"burpVersion,attr"`
+	ExportTime  string     `xml:"exportTime,attr"`
+	Items       []burpItem `xml:"item"`
+}
+
+type burpHost struct {
+	IP   string `xml:"ip,attr"`
+	Name string `xml:",chardata"`
+}
+
+type burpData struct {
+	Base64 bool   `xml:"base64,attr"`
+	Data   string `xml:",chardata"`
+}
+
+type burpItem struct {
+	Time           string   `xml:"time"`
+	URL            string   `xml:"url"`
+	Host           burpHost `xml:"host"`
+	Port           string   `xml:"port"`
+	Protocol       string   `xml:"protocol"`
+	Method         string   `xml:"method"`
+	Path           string   `xml:"path"`
+	Extension      string   `xml:"extension"`
+	Request        burpData `xml:"request"`
+	Status         string   `xml:"status"`
+	ResponseLength int      `xml:"responselength"`
+	MimeType       string   `xml:"mimetype"`
+	Response       burpData `xml:"response"`
+	Comment        string   `xml:"comment"`
+}
+
+// BurpExporter writes pages as a Burp Suite items XML document with
+// request/response pairs that can be loaded into Burp's site map.
+type BurpExporter struct{}
+
+func NewBurpExporter() *BurpExporter {
+	return &BurpExporter{}
+}
+
+func (e *BurpExporter) Export(s *core.Session, w io.Writer) error {
+	items := burpItems{
+		BurpVersion: "aquatone " + core.Version,
+		ExportTime:  time.Now().Format(time.UnixDate),
+	}
+
+	for _, page := range sortedPages(s) {
+		u := page.ParsedURL()
+		request, err := s.ReadFile(page.RequestPath)
+		if page.RequestPath == "" || err != nil {
+			request = []byte(fmt.Sprintf("GET %s HTTP/1.1\r\nHost: %s\r\n\r\n", u.RequestURI(), u.Host))
+		}
+		response := rawResponse(s, page)
+
+		var ip string
+		if len(page.Addrs) > 0 {
+			ip = page.Addrs[0]
+		}
+
+		items.Items = append(items.Items, burpItem{
+			Time:           s.Stats.StartedAt.Format(time.UnixDate),
+			URL:            page.URL,
+			Host:           burpHost{IP: ip, Name: u.Hostname()},
+			Port:           portForURL(page),
+			Protocol:       u.Scheme,
+			Method:         "GET",
+			Path:           u.RequestURI(),
+			Extension:      "null",
+			Request:        burpData{Base64: true, Data: base64.StdEncoding.EncodeToString(request)},
+			Status:         strings.SplitN(page.Status, " ", 2)[0],
+			ResponseLength: len(response),
+			MimeType:       "HTML",
+			Response:       burpData{Base64: true, Data: base64.StdEncoding.EncodeToString(response)},
+			Comment:        page.PageTitle,
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(items)
+}
@@ -0,0 +1,107 @@
+package exporters
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mk990/aquatone/core"
+)
+
+type cycloneDXBOM struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string          `json:"timestamp"`
+	Tools     []cycloneDXTool `json:"tools"`
+}
+
+type cycloneDXTool struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type cycloneDXComponent struct {
+	Type       string              `json:"type"`
+	BOMRef     string              `json:"bom-ref"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	Group      string              `json:"group,omitempty"`
+	Properties []cycloneDXProperty `json:"properties"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CycloneDXExporter writes the detected technologies of every host as a
+// CycloneDX JSON bill of materials.
+type CycloneDXExporter struct{}
+
+func NewCycloneDXExporter() *CycloneDXExporter {
+	return &CycloneDXExporter{}
+}
+
+func (e *CycloneDXExporter) Export(s *core.Session, w io.Writer) error {
+	bom := cycloneDXBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + uuid.New().String(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Name: core.Name, Version: core.Version}},
+		},
+		Components: []cycloneDXComponent{},
+	}
+
+	components := make(map[string]*cycloneDXComponent)
+	for _, page := range sortedPages(s) {
+		for _, tech := range page.Technologies {
+			ref := fmt.Sprintf("%s/%s@%s", page.Hostname, tech.Name, tech.Version)
+			component, ok := components[ref]
+			if !ok {
+				component = &cycloneDXComponent{
+					Type:    "application",
+					BOMRef:  ref,
+					Name:    tech.Name,
+					Version: tech.Version,
+					Group:   page.Hostname,
+					Properties: []cycloneDXProperty{
+						{Name: "aquatone:host", Value: page.Hostname},
+						{Name: "aquatone:categories", Value: strings.Join(tech.Categories, ", ")},
+					},
+				}
+				if tech.Website != "" {
+					component.Properties = append(component.Properties, cycloneDXProperty{Name: "aquatone:website", Value: tech.Website})
+				}
+				components[ref] = component
+			}
+			component.Properties = append(component.Properties, cycloneDXProperty{Name: "aquatone:url", Value: page.URL})
+		}
+	}
+
+	var refs []string
+	for ref := range components {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	for _, ref := range refs {
+		bom.Components = append(bom.Components, *components[ref])
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(bom)
+}
@@ -0,0 +1,72 @@
+package exporters
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/mk990/aquatone/core"
+)
+
+type defectDojoReport struct {
+	Findings []defectDojoFinding `json:"findings"`
+}
+
+type defectDojoFinding struct {
+	Title          string   `json:"title"`
+	Description    string   `json:"description"`
+	Severity       string   `json:"severity"`
+	Date           string   `json:"date"`
+	References     string   `json:"references,omitempty"`
+	Endpoints      []string `json:"endpoints"`
+	StaticFinding  bool     `json:"static_finding"`
+	DynamicFinding bool     `json:"dynamic_finding"`
+}
+
+// DefectDojoExporter writes findings in the format accepted by DefectDojo's
+// Generic Findings Import.
+type DefectDojoExporter struct{}
+
+func NewDefectDojoExporter() *DefectDojoExporter {
+	return &DefectDojoExporter{}
+}
+
+func (e *DefectDojoExporter) Export(s *core.Session, w io.Writer) error {
+	report := defectDojoReport{Findings: []defectDojoFinding{}}
+	date := s.Stats.StartedAt.Format("2006-01-02")
+
+	for _, page := range sortedPages(s) {
+		for _, f := range pageFindings(page) {
+			references := f.Reference
+			if page.HasScreenshot {
+				if references != "" {
+					references += "\n"
+				}
+				references += "Screenshot: " + page.ScreenshotPath
+			}
+			report.Findings = append(report.Findings, defectDojoFinding{
+				Title:          f.Title,
+				Description:    f.Description,
+				Severity:       f.Severity,
+				Date:           date,
+				References:     references,
+				Endpoints:      []string{page.URL},
+				DynamicFinding: true,
+			})
+		}
+	}
+
+	for _, f := range dnsFindings(s) {
+		report.Findings = append(report.Findings, defectDojoFinding{
+			Title:          f.Title,
+			Description:    f.Description,
+			Severity:       f.Severity,
+			Date:           date,
+			Endpoints:      []string{f.Hostname},
+			DynamicFinding: true,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
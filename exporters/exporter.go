@@ -0,0 +1,12 @@
+package exporters
+
+import (
+	"io"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// Exporter writes session data in a format consumed by third-party tools.
+type Exporter interface {
+	Export(s *core.Session, w io.Writer) error
+}
@@ -0,0 +1,108 @@
+package exporters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mk990/aquatone/core"
+)
+
+const (
+	SeverityInfo   = "Info"
+	SeverityLow    = "Low"
+	SeverityMedium = "Medium"
+	SeverityHigh   = "High"
+)
+
+// finding is a tool independent representation of an issue found on a page.
+type finding struct {
+	Title       string
+	Description string
+	Severity    string
+	Page        *core.Page
+	Hostname    string
+	Reference   string
+}
+
+// pageFindings collects the issues recorded on a page: takeover tags,
+// headers that decrease security, directory listings and notes.
+func pageFindings(page *core.Page) []finding {
+	var findings []finding
+
+	for _, tag := range page.Tags {
+		if tag.Text != "Domain Takeover" {
+			continue
+		}
+		findings = append(findings, finding{
+			Title:       fmt.Sprintf("Domain takeover: %s", page.Hostname),
+			Description: fmt.Sprintf("%s appears to point to an unclaimed resource at a third-party service and may be vulnerable to subdomain takeover.", page.Hostname),
+			Severity:    SeverityHigh,
+			Page:        page,
+			Reference:   tag.Link,
+		})
+	}
+
+	for _, header := range page.Headers {
+		if !header.DecreasesSecurity {
+			continue
+		}
+		findings = append(findings, finding{
+			Title:       fmt.Sprintf("Insecure response header: %s", header.Name),
+			Description: fmt.Sprintf("The response from %s contains the header `%s: %s` which discloses information or weakens security.", page.URL, header.Name, header.Value),
+			Severity:    SeverityLow,
+			Page:        page,
+		})
+	}
+
+	if strings.HasPrefix(page.PageTitle, "Index of /") || strings.HasPrefix(page.PageTitle, "Directory listing for") {
+		findings = append(findings, finding{
+			Title:       fmt.Sprintf("Directory listing: %s", page.URL),
+			Description: fmt.Sprintf("%s returns a directory listing (%s).", page.URL, page.PageTitle),
+			Severity:    SeverityMedium,
+			Page:        page,
+		})
+	}
+
+	for _, note := range page.Notes {
+		findings = append(findings, finding{
+			Title:       note.Text,
+			Description: fmt.Sprintf("%s: %s", page.URL, note.Text),
+			Severity:    noteSeverity(note),
+			Page:        page,
+		})
+	}
+
+	return findings
+}
+
+// dnsFindings turns the dangling DNS records of the session into findings.
+// Records that can be claimed right away by registering a domain are rated
+// high, the others medium as they need more work to confirm.
+func dnsFindings(s *core.Session) []finding {
+	var findings []finding
+	for _, f := range s.DNSFindings {
+		severity := SeverityMedium
+		if f.Type == core.DNSFindingExpiredCNAME || strings.Contains(f.Detail, "not registered") {
+			severity = SeverityHigh
+		}
+		findings = append(findings, finding{
+			Title:       fmt.Sprintf("Dangling DNS record: %s %s", f.Hostname, f.Record),
+			Description: fmt.Sprintf("%s has a dangling DNS record (%s): %s.", f.Hostname, f.Type, f.Detail),
+			Severity:    severity,
+			Hostname:    f.Hostname,
+		})
+	}
+	return findings
+}
+
+func noteSeverity(note core.Note) string {
+	switch note.Level() {
+	case core.SeverityHigh:
+		return SeverityHigh
+	case core.SeverityMedium:
+		return SeverityMedium
+	case core.SeverityLow:
+		return SeverityLow
+	}
+	return SeverityInfo
+}
@@ -0,0 +1,246 @@
+package exporters
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mk990/aquatone/core"
+)
+
+type nmapRun struct {
+	XMLName          xml.Name     `xml:"nmaprun"`
+	Scanner          string       `xml:"scanner,attr"`
+	Args             string       `xml:"args,attr"`
+	Start            int64        `xml:"start,attr"`
+	StartStr         string       `xml:"startstr,attr"`
+	Version          string       `xml:"version,attr"`
+	XMLOutputVersion string       `xml:"xmloutputversion,attr"`
+	ScanInfo         nmapScanInfo `xml:"scaninfo"`
+	Hosts            []nmapHost   `xml:"host"`
+	RunStats         nmapRunStats `xml:"runstats"`
+}
+
+type nmapScanInfo struct {
+	Type        string `xml:"type,attr"`
+	Protocol    string `xml:"protocol,attr"`
+	NumServices int    `xml:"numservices,attr"`
+	Services    string `xml:"services,attr"`
+}
+
+type nmapHost struct {
+	StartTime int64          `xml:"starttime,attr"`
+	EndTime   int64          `xml:"endtime,attr"`
+	Status    nmapStatus     `xml:"status"`
+	Addresses []nmapAddress  `xml:"address"`
+	Hostnames []nmapHostname `xml:"hostnames>hostname"`
+	Ports     []nmapPort     `xml:"ports>port"`
+}
+
+type nmapStatus struct {
+	State     string `xml:"state,attr"`
+	Reason    string `xml:"reason,attr"`
+	ReasonTTL int    `xml:"reason_ttl,attr"`
+}
+
+type nmapAddress struct {
+	Addr     string `xml:"addr,attr"`
+	AddrType string `xml:"addrtype,attr"`
+}
+
+type nmapHostname struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type nmapPort struct {
+	Protocol string       `xml:"protocol,attr"`
+	PortID   int          `xml:"portid,attr"`
+	State    nmapStatus   `xml:"state"`
+	Service  *nmapService `xml:"service"`
+}
+
+type nmapService struct {
+	Name   string `xml:"name,attr"`
+	Tunnel string `xml:"tunnel,attr,omitempty"`
+	Method string `xml:"method,attr"`
+	Conf   int    `xml:"conf,attr"`
+}
+
+type nmapRunStats struct {
+	Finished nmapFinished `xml:"finished"`
+	Hosts    nmapHosts    `xml:"hosts"`
+}
+
+type nmapFinished struct {
+	Time    int64  `xml:"time,attr"`
+	TimeStr string `xml:"timestr,attr"`
+	Elapsed string `xml:"elapsed,attr"`
+	Summary string `xml:"summary,attr"`
+	Exit    string `xml:"exit,attr"`
+}
+
+type nmapHosts struct {
+	Up    int `xml:"up,attr"`
+	Down  int `xml:"down,attr"`
+	Total int `xml:"total,attr"`
+}
+
+// NmapExporter writes the open ports found by the port scan as Nmap XML, as
+// if Nmap had run a connect scan (-sT) of the scanned ports. There is a host
+// per IP address that answered, with the hostnames that resolved to it.
+// Ports of web servers that were requested are given the http service, with
+// an ssl tunnel for HTTPS, so tools reading the XML don't have to guess.
+type NmapExporter struct{}
+
+func NewNmapExporter() *NmapExporter {
+	return &NmapExporter{}
+}
+
+func (e *NmapExporter) Export(s *core.Session, w io.Writer) error {
+	schemes := make(map[string]string)
+	for _, page := range sortedPages(s) {
+		address := net.JoinHostPort(page.Hostname, portForURL(page))
+		if schemes[address] != "https" {
+			schemes[address] = page.ParsedURL().Scheme
+		}
+	}
+
+	hosts := make(map[string]*nmapHost)
+	var addrs []string
+	for address, answered := range s.PortAddrs {
+		hostname, portStr, err := net.SplitHostPort(address)
+		if err != nil {
+			continue
+		}
+		port, _ := strconv.Atoi(portStr)
+		// Hosts reached through a jump host may not have been resolved
+		if len(answered) == 0 {
+			answered = []string{hostname}
+		}
+		for _, addr := range answered {
+			host, ok := hosts[addr]
+			if !ok {
+				host = newNmapHost(s, addr)
+				hosts[addr] = host
+				addrs = append(addrs, addr)
+			}
+			if hostname != addr && !containsNmapHostname(host.Hostnames, hostname) {
+				host.Hostnames = append(host.Hostnames, nmapHostname{Name: hostname, Type: "user"})
+			}
+			if !containsNmapPort(host.Ports, port) {
+				host.Ports = append(host.Ports, newNmapPort(port, schemes[address]))
+			}
+		}
+	}
+
+	sort.Strings(addrs)
+	run := nmapRun{
+		Scanner:          core.Name,
+		Args:             fmt.Sprintf("%s -p %s", core.Name, joinPorts(s.Ports)),
+		Start:            s.Stats.StartedAt.Unix(),
+		StartStr:         s.Stats.StartedAt.Format("Mon Jan 2 15:04:05 2006"),
+		Version:          core.Version,
+		XMLOutputVersion: "1.05",
+		ScanInfo: nmapScanInfo{
+			Type:        "connect",
+			Protocol:    "tcp",
+			NumServices: len(s.Ports),
+			Services:    joinPorts(s.Ports),
+		},
+		RunStats: nmapRunStats{
+			Finished: nmapFinished{
+				Time:    s.Stats.FinishedAt.Unix(),
+				TimeStr: s.Stats.FinishedAt.Format("Mon Jan 2 15:04:05 2006"),
+				Elapsed: fmt.Sprintf("%.2f", s.Stats.Duration().Seconds()),
+				Summary: fmt.Sprintf("%s done; %d IP addresses (%d hosts up)", core.Name, len(addrs), len(addrs)),
+				Exit:    "success",
+			},
+			Hosts: nmapHosts{Up: len(addrs), Total: len(addrs)},
+		},
+	}
+	for _, addr := range addrs {
+		host := hosts[addr]
+		sort.Slice(host.Hostnames, func(i, j int) bool {
+			return host.Hostnames[i].Name < host.Hostnames[j].Name
+		})
+		sort.Slice(host.Ports, func(i, j int) bool {
+			return host.Ports[i].PortID < host.Ports[j].PortID
+		})
+		run.Hosts = append(run.Hosts, *host)
+	}
+
+	if _, err := io.WriteString(w, xml.Header+"<!DOCTYPE nmaprun>\n"); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(run); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func newNmapHost(s *core.Session, addr string) *nmapHost {
+	host := &nmapHost{
+		StartTime: s.Stats.StartedAt.Unix(),
+		EndTime:   s.Stats.FinishedAt.Unix(),
+		Status:    nmapStatus{State: "up", Reason: "user-set"},
+	}
+	if ip := net.ParseIP(addr); ip == nil {
+		host.Hostnames = append(host.Hostnames, nmapHostname{Name: addr, Type: "user"})
+	} else if ip.To4() == nil {
+		host.Addresses = append(host.Addresses, nmapAddress{Addr: addr, AddrType: "ipv6"})
+	} else {
+		host.Addresses = append(host.Addresses, nmapAddress{Addr: addr, AddrType: "ipv4"})
+	}
+	return host
+}
+
+func newNmapPort(port int, scheme string) nmapPort {
+	p := nmapPort{
+		Protocol: "tcp",
+		PortID:   port,
+		State:    nmapStatus{State: "open", Reason: "syn-ack"},
+	}
+	switch scheme {
+	case "http":
+		p.Service = &nmapService{Name: "http", Method: "probed", Conf: 10}
+	case "https":
+		p.Service = &nmapService{Name: "http", Tunnel: "ssl", Method: "probed", Conf: 10}
+	}
+	return p
+}
+
+func containsNmapHostname(hostnames []nmapHostname, name string) bool {
+	for _, hostname := range hostnames {
+		if hostname.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsNmapPort(ports []nmapPort, port int) bool {
+	for _, p := range ports {
+		if p.PortID == port {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPorts(ports []int) string {
+	sorted := append([]int(nil), ports...)
+	sort.Ints(sorted)
+	var parts []string
+	for _, port := range sorted {
+		parts = append(parts, strconv.Itoa(port))
+	}
+	return strings.Join(parts, ",")
+}
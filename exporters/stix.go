@@ -0,0 +1,119 @@
+package exporters
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mk990/aquatone/core"
+)
+
+// stixNamespace is the UUIDv5 namespace defined by STIX 2.1 for
+// deterministic identifiers of cyber observable objects.
+var stixNamespace = uuid.MustParse("00abedb4-aa42-466c-9c01-fed23315a9b7")
+
+type stixBundle struct {
+	Type    string        `json:"type"`
+	ID      string        `json:"id"`
+	Objects []interface{} `json:"objects"`
+}
+
+type stixObservable struct {
+	Type           string            `json:"type"`
+	SpecVersion    string            `json:"spec_version"`
+	ID             string            `json:"id"`
+	Value          string            `json:"value,omitempty"`
+	ResolvesToRefs []string          `json:"resolves_to_refs,omitempty"`
+	Hashes         map[string]string `json:"hashes,omitempty"`
+	SerialNumber   string            `json:"serial_number,omitempty"`
+	Issuer         string            `json:"issuer,omitempty"`
+	Subject        string            `json:"subject,omitempty"`
+	ValidityStart  string            `json:"validity_not_before,omitempty"`
+	ValidityEnd    string            `json:"validity_not_after,omitempty"`
+}
+
+// STIXExporter writes discovered hostnames, IP addresses, certificates and
+// URLs as a bundle of STIX 2.1 cyber observable objects.
+type STIXExporter struct {
+	objects map[string]*stixObservable
+	order   []string
+}
+
+func NewSTIXExporter() *STIXExporter {
+	return &STIXExporter{}
+}
+
+func (e *STIXExporter) Export(s *core.Session, w io.Writer) error {
+	e.objects = make(map[string]*stixObservable)
+	e.order = nil
+
+	for _, page := range sortedPages(s) {
+		e.add("url", map[string]interface{}{"value": page.URL}, func(o *stixObservable) {
+			o.Value = page.URL
+		})
+
+		var addrRefs []string
+		for _, addr := range page.Addrs {
+			addrType := "ipv4-addr"
+			if ip := net.ParseIP(addr); ip != nil && ip.To4() == nil {
+				addrType = "ipv6-addr"
+			}
+			addrRefs = append(addrRefs, e.add(addrType, map[string]interface{}{"value": addr}, func(o *stixObservable) {
+				o.Value = addr
+			}))
+		}
+
+		if !page.IsIPHost() {
+			e.add("domain-name", map[string]interface{}{"value": page.Hostname}, func(o *stixObservable) {
+				o.Value = page.Hostname
+				for _, ref := range addrRefs {
+					if !containsString(o.ResolvesToRefs, ref) {
+						o.ResolvesToRefs = append(o.ResolvesToRefs, ref)
+					}
+				}
+			})
+		}
+
+		if cert := page.Certificate; cert != nil {
+			hashes := map[string]string{"SHA-256": cert.SHA256}
+			e.add("x509-certificate", map[string]interface{}{"hashes": hashes}, func(o *stixObservable) {
+				o.Hashes = hashes
+				o.SerialNumber = cert.SerialNumber
+				o.Issuer = cert.Issuer
+				o.Subject = cert.Subject
+				o.ValidityStart = cert.NotBefore.UTC().Format(time.RFC3339)
+				o.ValidityEnd = cert.NotAfter.UTC().Format(time.RFC3339)
+			})
+		}
+	}
+
+	bundle := stixBundle{
+		Type:    "bundle",
+		ID:      "bundle--" + uuid.New().String(),
+		Objects: []interface{}{},
+	}
+	for _, id := range e.order {
+		bundle.Objects = append(bundle.Objects, e.objects[id])
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(bundle)
+}
+
+// add creates the observable identified by its ID contributing properties if
+// it does not exist yet, applies update to it and returns its ID.
+func (e *STIXExporter) add(objectType string, idProperties map[string]interface{}, update func(o *stixObservable)) string {
+	canonical, _ := json.Marshal(idProperties)
+	id := objectType + "--" + uuid.NewSHA1(stixNamespace, canonical).String()
+	o, ok := e.objects[id]
+	if !ok {
+		o = &stixObservable{Type: objectType, SpecVersion: "2.1", ID: id}
+		e.objects[id] = o
+		e.order = append(e.order, id)
+	}
+	update(o)
+	return id
+}
@@ -0,0 +1,73 @@
+package exporters
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+
+	"github.com/mk990/aquatone/core"
+)
+
+type structureExport struct {
+	Pages      []structurePage `json:"pages"`
+	Similarity [][]float64     `json:"similarity"`
+}
+
+type structurePage struct {
+	UUID      string   `json:"uuid"`
+	URL       string   `json:"url"`
+	Cluster   string   `json:"cluster,omitempty"`
+	Structure []string `json:"structure"`
+}
+
+// StructureExporter writes the page structures pages are clustered by, the
+// sequence of start tags and element IDs of their bodies, with the matrix of
+// their similarities, so pages can be clustered or classified with other
+// tools without parsing the HTML again.
+type StructureExporter struct{}
+
+func NewStructureExporter() *StructureExporter {
+	return &StructureExporter{}
+}
+
+// Export writes the pages that have a structure, in the order of their URLs,
+// and the similarity of every pair of them in the same order. Similarities
+// range from 0 to 1, like those compared to the 0.80 threshold of the
+// clusters, and are computed once per pair, so the matrix is symmetric.
+func (e *StructureExporter) Export(s *core.Session, w io.Writer) error {
+	clusters := make(map[string]string)
+	for cluster, urls := range s.PageSimilarityClusters {
+		for _, url := range urls {
+			clusters[url] = cluster
+		}
+	}
+
+	export := structureExport{Pages: []structurePage{}, Similarity: [][]float64{}}
+	var structures [][]string
+	for _, page := range sortedPages(s) {
+		if len(page.PageStructure) == 0 {
+			continue
+		}
+		export.Pages = append(export.Pages, structurePage{
+			UUID:      page.UUID,
+			URL:       page.URL,
+			Cluster:   clusters[page.URL],
+			Structure: page.PageStructure,
+		})
+		structures = append(structures, page.PageStructure)
+	}
+
+	for i := range structures {
+		export.Similarity = append(export.Similarity, make([]float64, len(structures)))
+		export.Similarity[i][i] = 1
+		for j := 0; j < i; j++ {
+			// Four decimals keep the matrix of large scans small
+			similarity := math.Round(core.GetSimilarity(structures[i], structures[j])*10000) / 10000
+			export.Similarity[i][j] = similarity
+			export.Similarity[j][i] = similarity
+		}
+	}
+
+	// The matrix has a line for every similarity when indented
+	return json.NewEncoder(w).Encode(export)
+}
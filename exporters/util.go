@@ -0,0 +1,57 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// rawResponse reconstructs the raw HTTP response of a page from its stored
+// headers and body.
+func rawResponse(s *core.Session, page *core.Page) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "HTTP/1.1 %s\r\n", page.Status)
+	for _, header := range page.Headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", header.Name, header.Value)
+	}
+	buf.WriteString("\r\n")
+	if page.BodyPath != "" {
+		if body, err := s.ReadFile(page.BodyPath); err == nil {
+			buf.Write(body)
+		}
+	}
+	return buf.Bytes()
+}
+
+func sortedPages(s *core.Session) []*core.Page {
+	var pages []*core.Page
+	for _, page := range s.Pages {
+		pages = append(pages, page)
+	}
+	sort.Slice(pages, func(i, j int) bool {
+		return pages[i].URL < pages[j].URL
+	})
+	return pages
+}
+
+func portForURL(page *core.Page) string {
+	u := page.ParsedURL()
+	if port := u.Port(); port != "" {
+		return port
+	}
+	if u.Scheme == "https" {
+		return "443"
+	}
+	return "80"
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package exporters
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/mk990/aquatone/core"
+)
+
+type zapConfiguration struct {
+	XMLName xml.Name   `xml:"configuration"`
+	Context zapContext `xml:"context"`
+}
+
+type zapContext struct {
+	Name           string            `xml:"name"`
+	Description    string            `xml:"desc"`
+	InScope        bool              `xml:"inscope"`
+	IncludeRegexes []string          `xml:"incregexes"`
+	Authentication zapAuthentication `xml:"authentication"`
+}
+
+type zapAuthentication struct {
+	Type int `xml:"type"`
+}
+
+// ZAPExporter writes an OWASP ZAP context file that puts all discovered
+// origins in scope and lists authentication hints in the context
+// description.
+type ZAPExporter struct{}
+
+func NewZAPExporter() *ZAPExporter {
+	return &ZAPExporter{}
+}
+
+func (e *ZAPExporter) Export(s *core.Session, w io.Writer) error {
+	context := zapContext{
+		Name:    "Aquatone",
+		InScope: true,
+	}
+
+	seen := make(map[string]struct{})
+	var hints []string
+	for _, page := range sortedPages(s) {
+		u := page.ParsedURL()
+		origin := fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+		if _, ok := seen[origin]; !ok {
+			seen[origin] = struct{}{}
+			context.IncludeRegexes = append(context.IncludeRegexes, regexp.QuoteMeta(origin)+".*")
+		}
+
+		for _, header := range page.Headers {
+			if strings.EqualFold(header.Name, "WWW-Authenticate") {
+				hints = append(hints, fmt.Sprintf("%s: HTTP authentication (%s)", page.URL, header.Value))
+			}
+		}
+	}
+
+	context.Description = fmt.Sprintf("Generated by %s v%s from %d pages.", core.Name, core.Version, len(s.Pages))
+	if len(hints) > 0 {
+		context.Description += "\nAuthentication hints:\n" + strings.Join(hints, "\n")
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(zapConfiguration{Context: context})
+}
@@ -0,0 +1,167 @@
+package importers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mk990/aquatone/core"
+	"golang.org/x/net/html"
+)
+
+// EyeWitnessImporter imports an EyeWitness results directory. EyeWitness
+// keeps its results in a pickled database Go can't read, so the pages are
+// taken from its HTML reports instead (report.html, report_page2.html, ...),
+// which list the URL, resolved address, page title and response headers of
+// every page, and link to its screenshot in screens/ and its source in
+// source/.
+type EyeWitnessImporter struct{}
+
+func NewEyeWitnessImporter() *EyeWitnessImporter {
+	return &EyeWitnessImporter{}
+}
+
+func (i *EyeWitnessImporter) Import(s *core.Session, path string) (int, error) {
+	reports, err := filepath.Glob(filepath.Join(path, "report*.html"))
+	if err != nil {
+		return 0, err
+	}
+	if len(reports) == 0 {
+		return 0, fmt.Errorf("%s is not an EyeWitness results directory: no report.html found", path)
+	}
+	sort.Strings(reports)
+
+	imported := 0
+	seen := make(map[string]bool)
+	for _, report := range reports {
+		f, err := os.Open(report)
+		if err != nil {
+			return imported, err
+		}
+		doc, err := goquery.NewDocumentFromReader(f)
+		f.Close()
+		if err != nil {
+			return imported, fmt.Errorf("unable to parse %s: %v", report, err)
+		}
+
+		doc.Find("tr").Each(func(_ int, row *goquery.Selection) {
+			r := i.parseRow(path, row)
+			if r == nil || seen[r.URL] {
+				return
+			}
+			seen[r.URL] = true
+			if err := r.add(s, "eyewitness"); err != nil {
+				s.Out.Debug("Unable to import %s: %v\n", r.URL, err)
+				return
+			}
+			imported++
+		})
+	}
+	return imported, nil
+}
+
+// parseRow reads a page from a row of an EyeWitness report. The first cell
+// holds a link to the URL followed by "Label: value" lines, where labels are
+// in bold except for "Resolved to", and the second cell the screenshot.
+func (i *EyeWitnessImporter) parseRow(dir string, row *goquery.Selection) *result {
+	link := row.Find("a[target=_blank]").First()
+	href, _ := link.Attr("href")
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil
+	}
+	r := &result{URL: u.String()}
+
+	cell := link.Closest("td")
+	for _, line := range cellLines(cell) {
+		if line == strings.TrimSpace(link.Text()) || line == href {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		label, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		switch strings.ToLower(label) {
+		case "page title":
+			r.Title = value
+		case "resolved to":
+			r.Addrs = strings.Fields(value)
+		case "response code":
+			fmt.Sscan(value, &r.StatusCode)
+		case "error", "":
+		default:
+			if strings.ContainsAny(label, " \t") {
+				continue
+			}
+			r.Headers = append(r.Headers, core.NewHeader(label, value))
+		}
+	}
+
+	row.Find("a").EachWithBreak(func(_ int, a *goquery.Selection) bool {
+		href, _ := a.Attr("href")
+		if strings.HasPrefix(href, "source/") {
+			if body, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(href))); err == nil {
+				r.Body = body
+			}
+			return false
+		}
+		return true
+	})
+
+	screenshots := []string{}
+	row.Find("img[src], a[href^='screens/']").Each(func(_ int, sel *goquery.Selection) {
+		if src, ok := sel.Attr("src"); ok {
+			screenshots = append(screenshots, src)
+		} else if href, ok := sel.Attr("href"); ok {
+			screenshots = append(screenshots, href)
+		}
+	})
+	for _, screenshot := range screenshots {
+		if strings.Contains(screenshot, "://") {
+			continue
+		}
+		candidates := []string{
+			filepath.Join(dir, filepath.FromSlash(screenshot)),
+			filepath.Join(dir, "screens", filepath.Base(screenshot)),
+		}
+		for _, candidate := range candidates {
+			if data, err := ioutil.ReadFile(candidate); err == nil {
+				r.Screenshot = data
+				break
+			}
+		}
+		if r.Screenshot != nil {
+			break
+		}
+	}
+	return r
+}
+
+// cellLines returns the text of a table cell split at <br> elements.
+func cellLines(cell *goquery.Selection) []string {
+	var lines []string
+	var line strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		switch {
+		case n.Type == html.TextNode:
+			line.WriteString(n.Data)
+		case n.Type == html.ElementNode && n.Data == "br":
+			lines = append(lines, strings.TrimSpace(line.String()))
+			line.Reset()
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	for _, n := range cell.Nodes {
+		walk(n)
+	}
+	return append(lines, strings.TrimSpace(line.String()))
+}
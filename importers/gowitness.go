@@ -0,0 +1,151 @@
+package importers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// GowitnessImporter imports the SQLite database of gowitness. Version 3
+// stores results in the results table and version 2 in the urls table, with
+// headers and technologies in tables referring to them. Screenshots are read
+// from the screenshots folder next to the database, or from the database
+// itself when gowitness was told to store them there.
+type GowitnessImporter struct{}
+
+func NewGowitnessImporter() *GowitnessImporter {
+	return &GowitnessImporter{}
+}
+
+func (i *GowitnessImporter) Import(s *core.Session, path string) (int, error) {
+	db, err := openSQLite(path)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	resultsTable, idColumn := "results", "result_id"
+	if !db.hasTable(resultsTable) {
+		resultsTable, idColumn = "urls", "url_id"
+	}
+	results, err := db.rows(resultsTable)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not a gowitness database: %v", path, err)
+	}
+
+	headers := make(map[int64][]core.Header)
+	if db.hasTable("headers") {
+		rows, err := db.rows("headers")
+		if err != nil {
+			return 0, err
+		}
+		for _, row := range rows {
+			id := rowInt(row, idColumn)
+			headers[id] = append(headers[id], core.NewHeader(rowString(row, "key"), rowString(row, "value")))
+		}
+	}
+	technologies := make(map[int64][]string)
+	if db.hasTable("technologies") {
+		rows, err := db.rows("technologies")
+		if err != nil {
+			return 0, err
+		}
+		for _, row := range rows {
+			id := rowInt(row, idColumn)
+			technologies[id] = append(technologies[id], rowString(row, "value"))
+		}
+	}
+
+	imported := 0
+	for _, row := range results {
+		if rowInt(row, "failed") != 0 || rowString(row, "deleted_at") != "" {
+			continue
+		}
+		id := rowInt(row, "id")
+		r := &result{
+			URL:          rowString(row, "url"),
+			StatusCode:   int(rowInt(row, "response_code")),
+			Status:       rowString(row, "response_reason"),
+			Title:        rowString(row, "title"),
+			Headers:      headers[id],
+			Technologies: technologies[id],
+		}
+		if body := rowString(row, "html", "dom"); body != "" {
+			r.Body = []byte(body)
+		}
+		r.Screenshot = i.screenshot(s, path, row)
+		if err := r.add(s, "gowitness"); err != nil {
+			s.Out.Debug("Unable to import %s: %v\n", r.URL, err)
+			continue
+		}
+		imported++
+	}
+	return imported, nil
+}
+
+// screenshot returns the screenshot of a result from the screenshots folder
+// next to the database or the working directory, or from the database.
+func (i *GowitnessImporter) screenshot(s *core.Session, dbPath string, row sqliteRow) []byte {
+	if filename := rowString(row, "filename", "file_name"); filename != "" {
+		candidates := []string{
+			filepath.Join(filepath.Dir(dbPath), "screenshots", filepath.Base(filename)),
+			filepath.Join(filepath.Dir(dbPath), filename),
+			filename,
+		}
+		for _, candidate := range candidates {
+			if data, err := ioutil.ReadFile(candidate); err == nil {
+				return data
+			}
+		}
+		s.Out.Debug("Screenshot %s of %s not found\n", filename, rowString(row, "url"))
+	}
+	switch screenshot := row["screenshot"].(type) {
+	case []byte:
+		return screenshot
+	case string:
+		if data, err := base64.StdEncoding.DecodeString(screenshot); err == nil {
+			return data
+		}
+	}
+	return nil
+}
+
+// rowString returns the first of the columns that has a value, as a string.
+func rowString(row sqliteRow, columns ...string) string {
+	for _, column := range columns {
+		switch value := row[column].(type) {
+		case string:
+			if value != "" {
+				return value
+			}
+		case []byte:
+			if len(value) > 0 {
+				return string(value)
+			}
+		case int64:
+			return fmt.Sprint(value)
+		case float64:
+			return fmt.Sprint(value)
+		}
+	}
+	return ""
+}
+
+// rowInt returns the value of an integer column, or 0.
+func rowInt(row sqliteRow, column string) int64 {
+	switch value := row[column].(type) {
+	case int64:
+		return value
+	case float64:
+		return int64(value)
+	case string:
+		if strings.EqualFold(value, "true") {
+			return 1
+		}
+	}
+	return 0
+}
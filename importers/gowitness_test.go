@@ -0,0 +1,135 @@
+package importers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// newTestSession returns a session that writes to a temporary directory, as
+// NewSession returns it for the import command.
+func newTestSession(t *testing.T) *core.Session {
+	t.Helper()
+	outDir := t.TempDir()
+	chrome := filepath.Join(outDir, "chrome")
+	if err := os.WriteFile(chrome, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	osArgs := os.Args
+	defer func() { os.Args = osArgs }()
+	os.Args = []string{"aquatone", "--out", outDir, "--chrome-path", chrome, "--silent"}
+
+	sess, err := core.NewSession()
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+	return sess
+}
+
+func TestGowitnessImporter(t *testing.T) {
+	tests := []struct {
+		database   string
+		imported   int
+		url        string
+		status     string
+		title      string
+		header     string
+		technology string
+		body       bool
+		screenshot bool
+		skippedURL string
+	}{
+		{
+			database:   "gowitness_v3.sqlite3",
+			imported:   2,
+			url:        "https://portal.acme.test/",
+			status:     "200 OK",
+			title:      "Acme Portal",
+			header:     "Strict-Transport-Security",
+			technology: "jQuery",
+			body:       true,
+			screenshot: true,
+			skippedURL: "http://old.acme.test/",
+		},
+		{
+			database:   "gowitness_v2.sqlite3",
+			imported:   1,
+			url:        "https://intranet.acme.test/",
+			status:     "200 OK",
+			title:      "Intranet Login",
+			header:     "Server",
+			technology: "IIS",
+			skippedURL: "https://deleted.acme.test/",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.database, func(t *testing.T) {
+			sess := newTestSession(t)
+			imported, err := NewGowitnessImporter().Import(sess, filepath.Join("testdata", test.database))
+			if err != nil {
+				t.Fatalf("Import() failed: %v", err)
+			}
+			if imported != test.imported {
+				t.Errorf("imported %d results; want %d", imported, test.imported)
+			}
+
+			page := sess.GetPage(test.url)
+			if page == nil {
+				t.Fatalf("no page for %s in session", test.url)
+			}
+			if page.Status != test.status || page.PageTitle != test.title {
+				t.Errorf("page has status %q and title %q; want %q and %q", page.Status, page.PageTitle, test.status, test.title)
+			}
+			if !page.HasHeader(test.header) {
+				t.Errorf("header %s missing from %v", test.header, page.Headers)
+			}
+			found := false
+			for _, technology := range page.Technologies {
+				found = found || technology.Name == test.technology
+			}
+			if !found {
+				t.Errorf("technology %s missing from %v", test.technology, page.Technologies)
+			}
+
+			if test.body {
+				body, err := sess.ReadFile(page.BodyPath)
+				if err != nil {
+					t.Fatalf("body not saved: %v", err)
+				}
+				// The body spans overflow pages of the database
+				if len(body) != 3175 || !strings.HasSuffix(string(body), "</html>") {
+					t.Errorf("saved body has %d bytes; want the 3175 bytes of HTML", len(body))
+				}
+			}
+			if page.HasScreenshot != test.screenshot {
+				t.Errorf("HasScreenshot = %v; want %v", page.HasScreenshot, test.screenshot)
+			}
+			if test.screenshot {
+				if _, err := os.Stat(sess.GetFilePath(page.ScreenshotPath)); err != nil {
+					t.Errorf("screenshot not saved: %v", err)
+				}
+			}
+
+			if sess.GetPage(test.skippedURL) != nil {
+				t.Errorf("failed or deleted result %s was imported", test.skippedURL)
+			}
+		})
+	}
+}
+
+func TestDetectGowitnessDatabase(t *testing.T) {
+	importer, err := Detect(filepath.Join("testdata", "gowitness_v3.sqlite3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := importer.(*GowitnessImporter); !ok {
+		t.Errorf("Detect() = %T; want *GowitnessImporter", importer)
+	}
+	if _, err := Detect(filepath.Join("testdata", "make_gowitness.py")); err == nil {
+		t.Error("Detect() accepted a file that is not a database")
+	}
+}
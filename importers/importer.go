@@ -0,0 +1,141 @@
+package importers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// Importer adds the results of another screenshot tool to a session, so they
+// can be reported, compared with --baseline and merged like Aquatone's own.
+type Importer interface {
+	Import(s *core.Session, path string) (int, error)
+}
+
+// Detect returns the importer for the results at path: gowitness for an
+// SQLite database and EyeWitness for a directory.
+func Detect(path string) (Importer, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return NewEyeWitnessImporter(), nil
+	}
+	if isSQLite(path) {
+		return NewGowitnessImporter(), nil
+	}
+	return nil, fmt.Errorf("%s is neither a gowitness database nor an EyeWitness results directory", path)
+}
+
+// result is a page found by another tool.
+type result struct {
+	URL          string
+	StatusCode   int
+	Status       string
+	Title        string
+	Addrs        []string
+	Headers      []core.Header
+	Technologies []string
+	Body         []byte
+	Screenshot   []byte
+}
+
+// add adds the result as a page to the session and writes its headers, body
+// and screenshot to the output directory like a scan does. The host of the
+// page is recorded as found by the tool named source.
+func (r *result) add(s *core.Session, source string) error {
+	if u, err := url.Parse(r.URL); err == nil {
+		s.AddHost(u.Hostname(), source)
+		s.AddHostIPs(u.Hostname(), r.Addrs)
+	}
+	page, err := s.AddPage(r.URL)
+	if err != nil {
+		return err
+	}
+
+	page.Status = r.Status
+	if r.StatusCode != 0 && !strings.HasPrefix(r.Status, strconv.Itoa(r.StatusCode)) {
+		page.Status = strings.TrimSpace(fmt.Sprintf("%d %s", r.StatusCode, r.Status))
+	}
+	page.PageTitle = r.Title
+	page.Addrs = r.Addrs
+	for _, header := range r.Headers {
+		page.AddHeader(header.Name, header.Value)
+	}
+	for _, tech := range r.Technologies {
+		page.AddTechnology(tech, "", nil, "")
+	}
+	countStatus(s, r.StatusCode)
+
+	if page.Status != "" || len(page.Headers) > 0 {
+		content := page.Status + "\n"
+		for _, header := range page.Headers {
+			content += fmt.Sprintf("%v: %v\n", header.Name, header.Value)
+		}
+		headersPath := fmt.Sprintf("headers/%s.txt", page.BaseFilename())
+		if err := s.WriteFile(headersPath, []byte(content)); err != nil {
+			return err
+		}
+		page.HeadersPath = headersPath
+	}
+
+	if r.Body != nil {
+		page.BodySize = int64(len(r.Body))
+		page.BodyHash = fmt.Sprintf("%x", sha256.Sum256(r.Body))
+		var body []byte
+		body, page.BodySampled = s.SampleBody(r.Body)
+		bodyPath := fmt.Sprintf("html/%s.html", page.BaseFilename())
+		if err := s.WriteFile(bodyPath, body); err != nil {
+			return err
+		}
+		page.BodyPath = bodyPath
+	}
+
+	if len(r.Screenshot) > 0 {
+		ext := "png"
+		if http.DetectContentType(r.Screenshot) == "image/jpeg" {
+			ext = "jpg"
+		}
+		tempPath := fmt.Sprintf("screenshots/%s.tmp.%s", page.BaseFilename(), ext)
+		if err := ioutil.WriteFile(s.GetFilePath(tempPath), r.Screenshot, 0644); err != nil {
+			return err
+		}
+		screenshotPath, err := s.StoreScreenshot(tempPath, fmt.Sprintf("screenshots/%s.%s", page.BaseFilename(), ext))
+		if err != nil {
+			return err
+		}
+		page.ScreenshotPath = screenshotPath
+		page.HasScreenshot = true
+		if hash, err := core.PerceptualHash(bytes.NewReader(r.Screenshot)); err == nil {
+			page.ScreenshotHash = hash
+		}
+		s.Stats.IncrementScreenshotSuccessful()
+	}
+	return nil
+}
+
+func countStatus(s *core.Session, code int) {
+	if code == 0 {
+		return
+	}
+	s.Stats.IncrementRequestSuccessful()
+	switch {
+	case code >= 500:
+		s.Stats.IncrementResponseCode5xx()
+	case code >= 400:
+		s.Stats.IncrementResponseCode4xx()
+	case code >= 300:
+		s.Stats.IncrementResponseCode3xx()
+	default:
+		s.Stats.IncrementResponseCode2xx()
+	}
+}
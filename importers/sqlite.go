@@ -0,0 +1,440 @@
+package importers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
+// sqliteMagic starts every SQLite 3 database file.
+const sqliteMagic = "SQLite format 3\x00"
+
+// sqliteDB reads tables of an SQLite 3 database file. Only what's needed to
+// read all rows of ordinary tables is supported, so no SQLite driver and no
+// cgo are needed for the few databases of other tools that are imported.
+// Changes still in a write-ahead log (-wal file) are not seen.
+type sqliteDB struct {
+	f        *os.File
+	pageSize int
+	usable   int
+	pages    int
+	tables   map[string]sqliteTable
+}
+
+type sqliteTable struct {
+	rootPage int
+	columns  []string
+	rowidCol int
+}
+
+// sqliteRow maps column names to values, which are nil, int64, float64,
+// string or []byte.
+type sqliteRow map[string]interface{}
+
+func isSQLite(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	header := make([]byte, len(sqliteMagic))
+	if _, err := f.ReadAt(header, 0); err != nil {
+		return false
+	}
+	return string(header) == sqliteMagic
+}
+
+func openSQLite(path string) (*sqliteDB, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, 100)
+	if _, err := f.ReadAt(header, 0); err != nil || string(header[:16]) != sqliteMagic {
+		f.Close()
+		return nil, fmt.Errorf("%s is not an SQLite 3 database", path)
+	}
+	if encoding := binary.BigEndian.Uint32(header[56:60]); encoding > 1 {
+		f.Close()
+		return nil, fmt.Errorf("%s uses a UTF-16 text encoding, which is not supported", path)
+	}
+
+	db := &sqliteDB{f: f, pageSize: int(binary.BigEndian.Uint16(header[16:18]))}
+	if db.pageSize == 1 {
+		db.pageSize = 65536
+	}
+	db.usable = db.pageSize - int(header[20])
+	// Page sizes are powers of two from 512 to 65536, and at least 480
+	// bytes of a page are usable
+	if db.pageSize < 512 || db.pageSize&(db.pageSize-1) != 0 || db.usable < 480 {
+		f.Close()
+		return nil, fmt.Errorf("%s has an invalid page size, the database is probably corrupt", path)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	db.pages = int(info.Size() / int64(db.pageSize))
+
+	// The schema table is stored in page 1 with the columns type, name,
+	// tbl_name, rootpage and sql
+	db.tables = make(map[string]sqliteTable)
+	err = db.scan(1, func(rowid int64, values []interface{}) error {
+		if len(values) < 5 || values[0] != "table" {
+			return nil
+		}
+		name, _ := values[1].(string)
+		rootPage, _ := values[3].(int64)
+		sql, _ := values[4].(string)
+		columns, rowidCol := parseCreateTable(sql)
+		db.tables[strings.ToLower(name)] = sqliteTable{rootPage: int(rootPage), columns: columns, rowidCol: rowidCol}
+		return nil
+	})
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func (db *sqliteDB) Close() error {
+	return db.f.Close()
+}
+
+func (db *sqliteDB) hasTable(name string) bool {
+	_, ok := db.tables[strings.ToLower(name)]
+	return ok
+}
+
+// rows returns all rows of the table. Columns added with ALTER TABLE after a
+// row was written are nil in that row.
+func (db *sqliteDB) rows(name string) ([]sqliteRow, error) {
+	table, ok := db.tables[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("no table %s in database", name)
+	}
+	var rows []sqliteRow
+	err := db.scan(table.rootPage, func(rowid int64, values []interface{}) error {
+		row := make(sqliteRow)
+		for i, column := range table.columns {
+			var value interface{}
+			if i < len(values) {
+				value = values[i]
+			}
+			// INTEGER PRIMARY KEY columns are stored as the rowid
+			if i == table.rowidCol && value == nil {
+				value = rowid
+			}
+			row[column] = value
+		}
+		rows = append(rows, row)
+		return nil
+	})
+	return rows, err
+}
+
+func (db *sqliteDB) page(number int) ([]byte, error) {
+	if number < 1 || number > db.pages {
+		return nil, fmt.Errorf("invalid page number %d", number)
+	}
+	page := make([]byte, db.pageSize)
+	if _, err := db.f.ReadAt(page, int64(number-1)*int64(db.pageSize)); err != nil {
+		return nil, fmt.Errorf("unable to read page %d: %v", number, err)
+	}
+	return page, nil
+}
+
+// scan calls f with the rowid and values of every row in the table b-tree
+// with the given root page, in rowid order.
+func (db *sqliteDB) scan(rootPage int, f func(rowid int64, values []interface{}) error) error {
+	return db.scanPage(rootPage, f, 0, make(map[int]bool))
+}
+
+// scanPage scans the b-tree page with the given number. Pages already seen
+// in the scan are corrupt references, which would loop forever.
+func (db *sqliteDB) scanPage(number int, f func(rowid int64, values []interface{}) error, depth int, seen map[int]bool) error {
+	if depth > 64 {
+		return fmt.Errorf("b-tree is too deep, the database is probably corrupt")
+	}
+	if seen[number] {
+		return fmt.Errorf("page %d is referenced twice, the database is probably corrupt", number)
+	}
+	seen[number] = true
+	page, err := db.page(number)
+	if err != nil {
+		return err
+	}
+	offset := 0
+	if number == 1 {
+		offset = 100
+	}
+
+	pageType := page[offset]
+	cells := int(binary.BigEndian.Uint16(page[offset+3 : offset+5]))
+	if offset+12+cells*2 > len(page) {
+		return fmt.Errorf("page %d has too many cells, the database is probably corrupt", number)
+	}
+	switch pageType {
+	case 0x05:
+		// Interior page: cells are a left child page number and a key, and
+		// the rightmost child follows the page header
+		pointers := page[offset+12:]
+		for i := 0; i < cells; i++ {
+			cell := int(binary.BigEndian.Uint16(pointers[i*2:]))
+			if cell+4 > len(page) {
+				return fmt.Errorf("cell extends beyond page")
+			}
+			child := int(binary.BigEndian.Uint32(page[cell:]))
+			if err := db.scanPage(child, f, depth+1, seen); err != nil {
+				return err
+			}
+		}
+		return db.scanPage(int(binary.BigEndian.Uint32(page[offset+8:])), f, depth+1, seen)
+	case 0x0d:
+		// Leaf page: cells are the payload size, rowid and payload
+		pointers := page[offset+8:]
+		for i := 0; i < cells; i++ {
+			cell := int(binary.BigEndian.Uint16(pointers[i*2:]))
+			if cell >= len(page) {
+				return fmt.Errorf("cell extends beyond page")
+			}
+			payloadSize, n := sqliteVarint(page[cell:])
+			cell += n
+			rowid, m := sqliteVarint(page[cell:])
+			if n == 0 || m == 0 {
+				return fmt.Errorf("invalid cell")
+			}
+			cell += m
+			if payloadSize > uint64(db.pages)*uint64(db.pageSize) {
+				return fmt.Errorf("payload of cell is larger than the database")
+			}
+			payload, err := db.payload(page, cell, int(payloadSize))
+			if err != nil {
+				return err
+			}
+			values, err := sqliteRecord(payload)
+			if err != nil {
+				return err
+			}
+			if err := f(int64(rowid), values); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("page %d is not a table b-tree page", number)
+}
+
+// payload returns the payload of a table leaf cell starting at offset,
+// following overflow pages for payloads that don't fit in the page.
+func (db *sqliteDB) payload(page []byte, offset int, size int) ([]byte, error) {
+	maxLocal := db.usable - 35
+	if size <= maxLocal {
+		if offset+size > len(page) {
+			return nil, fmt.Errorf("cell extends beyond page")
+		}
+		return page[offset : offset+size], nil
+	}
+
+	minLocal := (db.usable-12)*32/255 - 23
+	local := minLocal + (size-minLocal)%(db.usable-4)
+	if local > maxLocal {
+		local = minLocal
+	}
+	if offset+local+4 > len(page) {
+		return nil, fmt.Errorf("cell extends beyond page")
+	}
+	payload := make([]byte, 0, size)
+	payload = append(payload, page[offset:offset+local]...)
+	next := int(binary.BigEndian.Uint32(page[offset+local:]))
+	for overflows := 0; len(payload) < size; overflows++ {
+		if next == 0 {
+			return nil, fmt.Errorf("overflow chain ends early")
+		}
+		if overflows >= db.pages {
+			return nil, fmt.Errorf("overflow chain loops, the database is probably corrupt")
+		}
+		overflow, err := db.page(next)
+		if err != nil {
+			return nil, err
+		}
+		next = int(binary.BigEndian.Uint32(overflow))
+		n := db.usable - 4
+		if remaining := size - len(payload); remaining < n {
+			n = remaining
+		}
+		payload = append(payload, overflow[4:4+n]...)
+	}
+	return payload, nil
+}
+
+// sqliteRecord decodes the values of a record.
+func sqliteRecord(payload []byte) ([]interface{}, error) {
+	headerSize, n := sqliteVarint(payload)
+	if n == 0 || headerSize < uint64(n) || headerSize > uint64(len(payload)) {
+		return nil, fmt.Errorf("invalid record header")
+	}
+	var types []uint64
+	for pos := n; pos < int(headerSize); {
+		serialType, n := sqliteVarint(payload[pos:])
+		if n == 0 {
+			return nil, fmt.Errorf("invalid record header")
+		}
+		types = append(types, serialType)
+		pos += n
+	}
+
+	values := make([]interface{}, 0, len(types))
+	data := payload[headerSize:]
+	for _, serialType := range types {
+		size := sqliteSerialSize(serialType)
+		if size < 0 || size > len(data) {
+			return nil, fmt.Errorf("record value extends beyond payload")
+		}
+		value := data[:size]
+		data = data[size:]
+
+		switch {
+		case serialType == 0:
+			values = append(values, nil)
+		case serialType >= 1 && serialType <= 6:
+			// Big-endian two's complement integers of 1 to 8 bytes
+			var i int64
+			if value[0]&0x80 != 0 {
+				i = -1
+			}
+			for _, b := range value {
+				i = i<<8 | int64(b)
+			}
+			values = append(values, i)
+		case serialType == 7:
+			values = append(values, math.Float64frombits(binary.BigEndian.Uint64(value)))
+		case serialType == 8:
+			values = append(values, int64(0))
+		case serialType == 9:
+			values = append(values, int64(1))
+		case serialType >= 12 && serialType%2 == 0:
+			values = append(values, append([]byte(nil), value...))
+		case serialType >= 13:
+			values = append(values, string(value))
+		default:
+			return nil, fmt.Errorf("invalid serial type %d", serialType)
+		}
+	}
+	return values, nil
+}
+
+// sqliteSerialSize returns the size of a value of the serial type, or -1 if
+// it is too large to be valid.
+func sqliteSerialSize(serialType uint64) int {
+	switch serialType {
+	case 1, 2, 3, 4:
+		return int(serialType)
+	case 5:
+		return 6
+	case 6, 7:
+		return 8
+	}
+	if serialType >= 12 {
+		if serialType > math.MaxInt32 {
+			return -1
+		}
+		return int(serialType-12) / 2
+	}
+	return 0
+}
+
+// sqliteVarint decodes a big-endian variable length integer of up to 9
+// bytes and returns it with its length, or 0 as length if b is too short.
+func sqliteVarint(b []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < 9; i++ {
+		if i >= len(b) {
+			return 0, 0
+		}
+		if i == 8 {
+			return v<<8 | uint64(b[i]), 9
+		}
+		v = v<<7 | uint64(b[i]&0x7f)
+		if b[i]&0x80 == 0 {
+			return v, i + 1
+		}
+	}
+	return v, 9
+}
+
+// parseCreateTable returns the column names of a CREATE TABLE statement and
+// the index of the INTEGER PRIMARY KEY column, which is an alias of the
+// rowid, or -1 if there is none.
+func parseCreateTable(sql string) ([]string, int) {
+	start := strings.Index(sql, "(")
+	end := strings.LastIndex(sql, ")")
+	if start == -1 || end <= start {
+		return nil, -1
+	}
+
+	var definitions []string
+	depth := 0
+	var current bytes.Buffer
+	for _, r := range sql[start+1 : end] {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			definitions = append(definitions, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	definitions = append(definitions, current.String())
+
+	var columns, types []string
+	rowidCol := -1
+	var primaryKey string
+	for _, definition := range definitions {
+		fields := strings.Fields(definition)
+		if len(fields) == 0 {
+			continue
+		}
+		switch strings.ToUpper(fields[0]) {
+		case "PRIMARY":
+			// Table constraint like PRIMARY KEY (`id`)
+			if open := strings.Index(definition, "("); open != -1 {
+				if names := strings.Split(strings.Trim(definition[open:], "() \t\n"), ","); len(names) == 1 {
+					primaryKey = unquoteIdentifier(strings.TrimSpace(names[0]))
+				}
+			}
+			continue
+		case "CONSTRAINT", "UNIQUE", "CHECK", "FOREIGN":
+			continue
+		}
+		columnType := ""
+		if len(fields) > 1 {
+			columnType = strings.ToUpper(fields[1])
+		}
+		if columnType == "INTEGER" && strings.Contains(strings.ToUpper(definition), "PRIMARY KEY") {
+			rowidCol = len(columns)
+		}
+		columns = append(columns, unquoteIdentifier(fields[0]))
+		types = append(types, columnType)
+	}
+	if rowidCol == -1 && primaryKey != "" {
+		for i, column := range columns {
+			if column == primaryKey && types[i] == "INTEGER" {
+				rowidCol = i
+			}
+		}
+	}
+	return columns, rowidCol
+}
+
+func unquoteIdentifier(name string) string {
+	return strings.Trim(name, "`\"[]'")
+}
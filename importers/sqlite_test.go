@@ -0,0 +1,264 @@
+package importers
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSQLiteVarint(t *testing.T) {
+	tests := []struct {
+		b    []byte
+		want uint64
+		n    int
+	}{
+		{[]byte{0x00}, 0, 1},
+		{[]byte{0x7f}, 127, 1},
+		{[]byte{0x81, 0x00}, 128, 2},
+		{[]byte{0x82, 0x2c, 0xff}, 300, 2},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, math.MaxUint64, 9},
+		{[]byte{0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x01}, 1<<57 | 1, 9},
+		{[]byte{0x81}, 0, 0},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, 0, 0},
+		{nil, 0, 0},
+	}
+	for _, test := range tests {
+		got, n := sqliteVarint(test.b)
+		if got != test.want || n != test.n {
+			t.Errorf("sqliteVarint(% x) = %d, %d; want %d, %d", test.b, got, n, test.want, test.n)
+		}
+	}
+}
+
+func TestSQLiteRecord(t *testing.T) {
+	float := make([]byte, 8)
+	binary.BigEndian.PutUint64(float, math.Float64bits(1.5))
+
+	tests := []struct {
+		name    string
+		payload []byte
+		want    []interface{}
+		err     bool
+	}{
+		{
+			name: "all serial types",
+			// Header of 9 bytes: NULL, 8-bit int, 24-bit int, float, 0, 1,
+			// blob of 2 bytes and text of 2 bytes
+			payload: append(append([]byte{9, 0, 1, 3, 7, 8, 9, 16, 17, 0xff, 0xff, 0xff, 0x38}, float...), 1, 2, 'h', 'i'),
+			want:    []interface{}{nil, int64(-1), int64(-200), 1.5, int64(0), int64(1), []byte{1, 2}, "hi"},
+		},
+		{
+			name:    "header size beyond payload",
+			payload: []byte{9, 0},
+			err:     true,
+		},
+		{
+			name:    "header size smaller than itself",
+			payload: []byte{0, 0},
+			err:     true,
+		},
+		{
+			name:    "value beyond payload",
+			payload: []byte{2, 6, 0, 0},
+			err:     true,
+		},
+		{
+			name:    "reserved serial type",
+			payload: []byte{2, 10},
+			err:     true,
+		},
+		{
+			name:    "huge serial type",
+			payload: []byte{10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			err:     true,
+		},
+		{
+			name:    "huge header size",
+			payload: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			err:     true,
+		},
+		{
+			name:    "truncated header",
+			payload: []byte{3, 0x81},
+			err:     true,
+		},
+	}
+	for _, test := range tests {
+		got, err := sqliteRecord(test.payload)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: sqliteRecord() = %v; want an error", test.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: sqliteRecord() failed: %v", test.name, err)
+		} else if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: sqliteRecord() = %#v; want %#v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestParseCreateTable(t *testing.T) {
+	tests := []struct {
+		sql      string
+		columns  []string
+		rowidCol int
+	}{
+		{
+			"CREATE TABLE `results` (`id` integer PRIMARY KEY AUTOINCREMENT,`url` text,`failed` numeric)",
+			[]string{"id", "url", "failed"}, 0,
+		},
+		{
+			"CREATE TABLE `urls` (`id` integer,`url` text,`title` text,PRIMARY KEY (`id`))",
+			[]string{"id", "url", "title"}, 0,
+		},
+		{
+			"CREATE TABLE headers (url_id INTEGER, key TEXT, value TEXT, CONSTRAINT fk FOREIGN KEY (url_id) REFERENCES urls(id))",
+			[]string{"url_id", "key", "value"}, -1,
+		},
+		{
+			`CREATE TABLE "t" ("name" VARCHAR(255) DEFAULT ('a,b'), [rowid_alias] INTEGER NOT NULL PRIMARY KEY, UNIQUE (name))`,
+			[]string{"name", "rowid_alias"}, 1,
+		},
+		{
+			"CREATE TABLE t (id TEXT PRIMARY KEY, n INTEGER)",
+			[]string{"id", "n"}, -1,
+		},
+		{
+			"CREATE TABLE t (a INTEGER, b INTEGER, PRIMARY KEY (a, b))",
+			[]string{"a", "b"}, -1,
+		},
+		{
+			"CREATE TABLE t AS SELECT 1",
+			nil, -1,
+		},
+	}
+	for _, test := range tests {
+		columns, rowidCol := parseCreateTable(test.sql)
+		if !reflect.DeepEqual(columns, test.columns) || rowidCol != test.rowidCol {
+			t.Errorf("parseCreateTable(%q) = %q, %d; want %q, %d", test.sql, columns, rowidCol, test.columns, test.rowidCol)
+		}
+	}
+}
+
+func TestSQLiteRows(t *testing.T) {
+	db, err := openSQLite(filepath.Join("testdata", "gowitness_v3.sqlite3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	results, err := db.rows("results")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("got %d results; want 3", len(results))
+	}
+	for i, row := range results {
+		// id is an INTEGER PRIMARY KEY, which is stored as the rowid
+		if row["id"] != int64(i+1) {
+			t.Errorf("id of result %d = %#v; want %d", i, row["id"], i+1)
+		}
+	}
+	// The HTML of the first result is spread over overflow pages
+	html, _ := results[0]["html"].(string)
+	if len(html) != 3175 || !strings.HasPrefix(html, "<html>") || !strings.HasSuffix(html, "</html>") {
+		t.Errorf("html of first result has %d bytes: %.40q...; want 3175 bytes of HTML", len(html), html)
+	}
+
+	// The headers need more than one page, so they are read through an
+	// interior page
+	headers, err := db.rows("headers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(headers) != 42 || headers[41]["id"] != int64(42) || headers[41]["key"] != "X-Padding-39" {
+		t.Errorf("got %d headers, last %v; want 42 ending with X-Padding-39", len(headers), headers[len(headers)-1])
+	}
+}
+
+// TestSQLiteCorruptDatabases checks that corrupt and truncated databases
+// make the reader return errors instead of panicking or looping forever.
+func TestSQLiteCorruptDatabases(t *testing.T) {
+	original, err := ioutil.ReadFile(filepath.Join("testdata", "gowitness_v3.sqlite3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const pageSize = 512
+	dir := t.TempDir()
+	read := func(data []byte) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("reader panicked: %v", r)
+			}
+		}()
+		path := filepath.Join(dir, "corrupt.sqlite3")
+		if err := ioutil.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		db, err := openSQLite(path)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		for _, table := range []string{"results", "headers", "technologies"} {
+			if _, err := db.rows(table); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	corrupt := func(f func(data []byte)) []byte {
+		data := append([]byte(nil), original...)
+		f(data)
+		return data
+	}
+
+	// Page of the first interior page, which is part of the headers table
+	interior := 0
+	for number := 2; number*pageSize <= len(original); number++ {
+		if original[(number-1)*pageSize] == 0x05 {
+			interior = number
+			break
+		}
+	}
+	if interior == 0 {
+		t.Fatal("no interior page in test database")
+	}
+
+	tests := map[string][]byte{
+		"truncated header": original[:60],
+		"truncated page 1": original[:300],
+		"truncated tables": original[:len(original)/2],
+		"page size 0":      corrupt(func(data []byte) { binary.BigEndian.PutUint16(data[16:], 0) }),
+		"page size 1000":   corrupt(func(data []byte) { binary.BigEndian.PutUint16(data[16:], 1000) }),
+		"too many cells":   corrupt(func(data []byte) { binary.BigEndian.PutUint16(data[103:], 0xffff) }),
+		"not a table page": corrupt(func(data []byte) { data[100] = 0x02 }),
+		"page loop": corrupt(func(data []byte) {
+			binary.BigEndian.PutUint32(data[(interior-1)*pageSize+8:], uint32(interior))
+		}),
+		"child out of range": corrupt(func(data []byte) {
+			binary.BigEndian.PutUint32(data[(interior-1)*pageSize+8:], 1<<30)
+		}),
+	}
+	for name, data := range tests {
+		if err := read(data); err == nil {
+			t.Errorf("%s: read database without error", name)
+		}
+	}
+
+	// Any single corrupt byte must not make the reader panic
+	for offset := 0; offset < len(original); offset++ {
+		for _, value := range []byte{0x00, 0xff} {
+			if original[offset] != value {
+				read(corrupt(func(data []byte) { data[offset] = value }))
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+#!/usr/bin/env python3
+# Writes the gowitness databases used by the importer tests. They use the
+# table layouts of gowitness v2 (urls) and v3 (results), and small pages so
+# the HTML of the first page spills onto overflow pages and the headers
+# tables need interior b-tree pages.
+import os
+import sqlite3
+import struct
+import zlib
+
+here = os.path.dirname(os.path.abspath(__file__))
+
+
+def png(width, height):
+    def chunk(kind, data):
+        return struct.pack(">I", len(data)) + kind + data + struct.pack(">I", zlib.crc32(kind + data))
+    raw = b"".join(b"\x00" + b"".join(bytes([x * 60 % 256, y * 60 % 256, 128]) for x in range(width)) for y in range(height))
+    return b"\x89PNG\r\n\x1a\n" + chunk(b"IHDR", struct.pack(">IIBBBBB", width, height, 8, 2, 0, 0, 0)) + chunk(b"IDAT", zlib.compress(raw)) + chunk(b"IEND", b"")
+
+
+html = "<html><head><title>Acme Portal</title></head><body>" + "".join("<p>Paragraph %d of the Acme portal.</p>" % i for i in range(80)) + "</body></html>"
+
+
+def create(path, statements, rows):
+    if os.path.exists(path):
+        os.remove(path)
+    db = sqlite3.connect(path)
+    db.execute("PRAGMA page_size = 512")
+    db.execute("PRAGMA journal_mode = DELETE")
+    for statement in statements:
+        db.execute(statement)
+    for table, values in rows:
+        for value in values:
+            db.execute("INSERT INTO %s VALUES (%s)" % (table, ",".join("?" * len(value))), value)
+    db.commit()
+    db.execute("VACUUM")
+    db.close()
+
+
+create(os.path.join(here, "gowitness_v3.sqlite3"), [
+    "CREATE TABLE `results` (`id` integer PRIMARY KEY AUTOINCREMENT,`url` text,`probed_at` datetime,`final_url` text,`response_code` integer,`response_reason` text,`protocol` text,`content_length` integer,`html` text,`title` text,`perception_hash` text,`file_name` text,`is_pdf` numeric,`failed` numeric,`failed_reason` text,`screenshot` text)",
+    "CREATE TABLE `headers` (`id` integer PRIMARY KEY AUTOINCREMENT,`result_id` integer,`key` text,`value` text,CONSTRAINT `fk_results_headers` FOREIGN KEY (`result_id`) REFERENCES `results`(`id`))",
+    "CREATE TABLE `technologies` (`id` integer PRIMARY KEY AUTOINCREMENT,`result_id` integer,`value` text,CONSTRAINT `fk_results_technologies` FOREIGN KEY (`result_id`) REFERENCES `results`(`id`))",
+], [
+    ("results", [
+        (None, "https://portal.acme.test/", "2024-03-01 12:00:00", "https://portal.acme.test/", 200, "OK", "HTTP/1.1", len(html), html, "Acme Portal", "", "", 0, 0, "", png(4, 3)),
+        (None, "http://old.acme.test/", "2024-03-01 12:00:01", "", 0, "", "", 0, "", "", "", "", 0, 1, "connection refused", ""),
+        (None, "https://api.acme.test/", "2024-03-01 12:00:02", "https://api.acme.test/", 404, "Not Found", "HTTP/2.0", 9, "not found", "", "", "", 0, 0, "", ""),
+    ]),
+    ("headers", [(None, 1, "Server", "nginx/1.25.3"), (None, 1, "Strict-Transport-Security", "max-age=31536000")] +
+     [(None, 3, "X-Padding-%02d" % i, "value %d of a header that fills pages" % i) for i in range(40)]),
+    ("technologies", [(None, 1, "Nginx"), (None, 1, "jQuery")]),
+])
+
+create(os.path.join(here, "gowitness_v2.sqlite3"), [
+    "CREATE TABLE `urls` (`id` integer,`created_at` datetime,`updated_at` datetime,`deleted_at` datetime,`url` text,`final_url` text,`response_code` integer,`response_reason` text,`proto` text,`content_length` integer,`title` text,`filename` text,`is_pdf` numeric,`perception_hash` text,`dom` text,`screenshot` text,PRIMARY KEY (`id`))",
+    "CREATE TABLE `headers` (`id` integer,`created_at` datetime,`updated_at` datetime,`deleted_at` datetime,`url_id` integer,`key` text,`value` text,PRIMARY KEY (`id`))",
+    "CREATE TABLE `technologies` (`id` integer,`created_at` datetime,`updated_at` datetime,`deleted_at` datetime,`url_id` integer,`value` text,PRIMARY KEY (`id`))",
+], [
+    ("urls", [
+        (None, "2023-05-01", "2023-05-01", None, "https://intranet.acme.test/", "https://intranet.acme.test/login", 200, "200 OK", "HTTP/1.1", 1024, "Intranet Login", "https-intranet.acme.test.png", 0, "", "", ""),
+        (None, "2023-05-01", "2023-05-01", "2023-05-02", "https://deleted.acme.test/", "", 200, "200 OK", "HTTP/1.1", 0, "Deleted", "", 0, "", "", ""),
+    ]),
+    ("headers", [(None, "2023-05-01", "2023-05-01", None, 1, "Server", "Microsoft-IIS/10.0")]),
+    ("technologies", [(None, "2023-05-01", "2023-05-01", None, 1, "IIS")]),
+])
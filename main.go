@@ -2,11 +2,16 @@ package main
 
 import (
 	"bufio"
-	"encoding/json"
+	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/pprof"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 	"path/filepath"
@@ -14,6 +19,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/mk990/aquatone/agents"
 	"github.com/mk990/aquatone/core"
+	"github.com/mk990/aquatone/exporters"
+	"github.com/mk990/aquatone/importers"
 	"github.com/mk990/aquatone/parsers"
 )
 
@@ -44,81 +51,454 @@ func hasSupportedScheme(s string) bool {
 	return false
 }
 
-func main() {
-	if sess, err = core.NewSession(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+// hostAndPort splits a target like example.com:8443, given without a
+// scheme, into its host and port. ok is false for URLs and targets without
+// a port. Such targets also parse as URLs with the host as their scheme.
+func hostAndPort(s string) (host string, port int, ok bool) {
+	if strings.Contains(s, "://") {
+		return "", 0, false
+	}
+	host, portStr, err := net.SplitHostPort(s)
+	if err != nil {
+		return "", 0, false
+	}
+	port, err = strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > 65535 {
+		return "", 0, false
 	}
+	return host, port, true
+}
 
-	if *sess.Options.Version {
-		sess.Out.Info("%s v%s", core.Name, core.Version)
-		os.Exit(0)
+// containsHosts reports whether any of the targets is a host to port scan,
+// rather than a URL or a host with a port.
+func containsHosts(targets []string) bool {
+	for _, target := range targets {
+		if _, _, ok := hostAndPort(target); !ok && !isURL(target) {
+			return true
+		}
 	}
+	return false
+}
 
-	fi, err := os.Stat(*sess.Options.OutDir)
+func writeExport(path string, description string, exporter exporters.Exporter) {
+	if path == "" {
+		return
+	}
 
-	outDir := strings.TrimSpace(*sess.Options.OutDir)
-	outDir = filepath.Clean(outDir)
-	if _, err := os.Stat(outDir); os.IsNotExist(err) {
-		err = os.MkdirAll(outDir, 0755)
-		if err != nil {
-			sess.Out.Fatal("Failed to create output directory %s: %v\n", outDir, err)
-			os.Exit(1)
+	sess.Out.Important("Writing %s export...", description)
+	f, err := os.Create(path)
+	if err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.Debug("Error: %v\n", err)
+		return
+	}
+	defer f.Close()
+
+	if err := exporter.Export(sess, f); err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.Debug("Error: %v\n", err)
+		return
+	}
+	sess.Out.Important(" done\n")
+}
+
+// analyzePages runs analysis that needs the full set of pages from the scan.
+// The baseline session is nil unless --baseline is given.
+func analyzePages(baseline *core.Session) {
+	sess.Out.Important("Classifying pages...")
+	classes := sess.ClassifyPages()
+	sess.Out.Important(" done\n")
+	sess.Out.Info("Classes: %d login, %d portal, %d parking, %d error, %d content\n", classes[core.ClassLogin], classes[core.ClassPortal], classes[core.ClassParking], classes[core.ClassError], classes[core.ClassContent])
+
+	if quarantined := sess.QuarantinedHosts(); len(quarantined) > 0 {
+		sess.Out.Warn("Quarantined hosts, their remaining ports and URLs were skipped: %s\n", strings.Join(quarantined, ", "))
+	}
+
+	sess.Out.Important("Analyzing response times...")
+	anomalous := sess.TagLatencyAnomalies()
+	sess.Out.Important(" done\n")
+	for _, page := range anomalous {
+		sess.Out.Warn("%s: anomalous response time of %dms\n", page.URL, page.ResponseTime)
+	}
+
+	if baseline != nil {
+		sess.Out.Important("Comparing with baseline...")
+		counts := sess.CompareBaseline(baseline, filepath.Dir(*sess.Options.Baseline))
+		sess.Out.Important(" done\n")
+		sess.Out.Info("Baseline: %d new, %d changed, %d unchanged, %d gone\n", counts[core.BaselineNew], counts[core.BaselineChanged], counts[core.BaselineUnchanged], counts[core.BaselineGone])
+	}
+}
+
+// writeScreenshotComparison writes a gallery of the screenshots of the
+// session next to those of the scan in the output directory given with
+// --compare-screenshots, with the pages that changed the most first.
+func writeScreenshotComparison(session *core.Session) {
+	dir := *sess.Options.CompareScreenshots
+	if dir == "" {
+		return
+	}
+
+	sess.Out.Important("Comparing screenshots with %s...", dir)
+	before, err := core.LoadSession(core.FindSessionFile(dir))
+	if err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.Debug("Error: %v\n", err)
+		return
+	}
+	template, err := sess.Asset("static/comparison_template.html")
+	if err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.Debug("Error: %v\n", err)
+		return
+	}
+	report := core.NewReport(session, string(template))
+	report.BaseURL = *sess.Options.ReportBaseURL
+	report.Title = *sess.Options.ReportTitle
+	report.Logo = *sess.Options.ReportLogo
+	report.Comparisons = core.CompareScreenshots(session, *sess.Options.OutDir, before, dir)
+	report.ComparedWith = dir
+
+	f, err := os.Create(sess.GetFilePath("aquatone_screenshot_comparison.html"))
+	if err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.Debug("Error: %v\n", err)
+		return
+	}
+	defer f.Close()
+	if err := report.Render(f); err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.Debug("Error: %v\n", err)
+		return
+	}
+	sess.Out.Important(" done\n")
+
+	changed := 0
+	for _, c := range report.Comparisons {
+		if c.Changed() {
+			changed++
 		}
 	}
+	sess.Out.Info("Screenshots: %d of %d pages changed, see %s\n\n", changed, len(report.Comparisons), sess.GetFilePath("aquatone_screenshot_comparison.html"))
+}
 
-	if !fi.IsDir() {
-		sess.Out.Fatal("Output destination must be a directory\n")
-		os.Exit(1)
+// inputParser returns the parser for the input format given with
+// --input-format, --nmap or --nessus, or for the format detected from the start of
+// the input.
+func inputParser(reader *bufio.Reader) parsers.Parser {
+	format := *sess.Options.InputFormat
+	if *sess.Options.Nmap {
+		format = "nmap"
+	}
+	if *sess.Options.Nessus {
+		format = "nessus"
+	}
+	if format == parsers.FormatAuto {
+		return parsers.Detect(reader)
 	}
+	parser, err := parsers.New(format)
+	if err != nil {
+		sess.Out.FatalWithCode(core.ExitInvalidOptions, "%v\n", err)
+	}
+	return parser
+}
 
-	sess.Out.Important("%s v%s started at %s\n\n", core.Name, core.Version, sess.Stats.StartedAt.Format(time.RFC3339))
+// readTargets reads the targets from the files given with --targets-file,
+// or from stdin. Every file is parsed in the format detected from its own
+// content, so an Nmap XML file and a list of hostnames can be given
+// together, and targets found in more than one file are scanned once. It
+// returns the targets with the input format each was first found in.
+func readTargets() ([]string, map[string]string) {
+	paths := *sess.Options.TargetsFiles
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
 
-	if *sess.Options.SessionPath != "" {
-		jsonSession, err := ioutil.ReadFile(*sess.Options.SessionPath)
-		if err != nil {
-			sess.Out.Fatal("Unable to read session file at %s: %s\n", *sess.Options.SessionPath, err)
-			os.Exit(1)
+	var targets []string
+	var wildcards []string
+	seenWildcards := make(map[string]bool)
+	sources := make(map[string]string)
+	for _, path := range paths {
+		name, input := "input", io.Reader(os.Stdin)
+		if path != "-" {
+			f, err := os.Open(path)
+			if err != nil {
+				sess.Out.FatalWithCode(core.ExitInvalidOptions, "Unable to read targets file: %s\n", err)
+			}
+			defer f.Close()
+			name, input = path, f
 		}
 
-		var parsedSession core.Session
-		if err := json.Unmarshal(jsonSession, &parsedSession); err != nil {
-			sess.Out.Fatal("Unable to parse session file at %s: %s\n", *sess.Options.SessionPath, err)
+		reader := bufio.NewReader(input)
+		parser := inputParser(reader)
+		sess.Out.Debug("Parsing %s as %s\n", name, parser.Name())
+		parsed, err := parser.Parse(reader)
+		if err != nil {
+			sess.Out.Fatal("Unable to parse %s as %s: %s\n", name, parser.Name(), err)
 			os.Exit(1)
 		}
+		for _, target := range parsed {
+			if _, ok := sources[target]; !ok {
+				sources[target] = parser.Name()
+				targets = append(targets, target)
+			}
+		}
+		if parser, ok := parser.(parsers.HostAddrsParser); ok && (parser.VerifiedHostAddrs() || *sess.Options.TrustResolution) {
+			for host, addrs := range parser.HostAddrs() {
+				sess.AddHostAddrs(host, addrs)
+			}
+		}
+		if parser, ok := parser.(*parsers.RegexParser); ok {
+			for _, wildcard := range parser.Wildcards {
+				if !seenWildcards[wildcard] {
+					seenWildcards[wildcard] = true
+					wildcards = append(wildcards, wildcard)
+				}
+			}
+			for _, cidr := range parser.CIDRs {
+				addrs, ok := parsers.ExpandCIDR(cidr, *sess.Options.MaxCIDRHosts)
+				if !ok {
+					sess.Out.Warn("Skipped %s, which has more than --max-cidr-hosts %d addresses\n", cidr, *sess.Options.MaxCIDRHosts)
+					continue
+				}
+				for _, addr := range addrs {
+					if _, ok := sources[addr]; !ok {
+						sources[addr] = parser.Name()
+						targets = append(targets, addr)
+					}
+				}
+			}
+		}
+	}
+	if len(paths) > 1 {
+		sess.Out.Debug("Read %d distinct targets from %d files\n", len(targets), len(paths))
+	}
 
-		sess.Out.Important("Loaded Aquatone session at %s\n", *sess.Options.SessionPath)
-		sess.Out.Important("Generating HTML report...")
-		var template []byte
-		if *sess.Options.TemplatePath != "" {
-			template, err = ioutil.ReadFile(*sess.Options.TemplatePath)
+	if len(wildcards) > 0 {
+		if *sess.Options.ExpandWildcards {
+			targets = expandWildcards(targets, wildcards)
 		} else {
-			template, err = sess.Asset("static/report_template.html")
+			sess.Out.Info("Stripped the wildcard from %d entries like *.%s; use --expand-wildcards to look up their subdomains\n", len(wildcards), wildcards[0])
 		}
+	}
+	return targets, sources
+}
 
+// expandWildcards adds the subdomains of the domains of wildcard entries
+// found in certificate transparency logs to the targets.
+func expandWildcards(targets []string, wildcards []string) []string {
+	seen := make(map[string]bool)
+	for _, target := range targets {
+		seen[target] = true
+	}
+	for _, domain := range wildcards {
+		sess.Out.Important("Looking up subdomains of *.%s...", domain)
+		names, err := agents.EnumerateSubdomains(sess, domain)
 		if err != nil {
-			sess.Out.Fatal("Can't read report template file\n")
-			os.Exit(1)
+			sess.Out.Error(" failed\n")
+			sess.Out.Warn("Unable to look up subdomains of %s: %v\n", domain, err)
+			continue
+		}
+		added := 0
+		for _, name := range names {
+			name, ok := parsers.SanitizeTarget(name)
+			if !ok || seen[name] {
+				continue
+			}
+			seen[name] = true
+			targets = append(targets, name)
+			added++
 		}
+		sess.Out.Important(" found %d\n", added)
+	}
+	return targets
+}
 
-		report := core.NewReport(&parsedSession, string(template))
-		f, err := os.OpenFile(sess.GetFilePath("aquatone_report.html"), os.O_RDWR|os.O_CREATE, 0644)
-		if err != nil {
-			sess.Out.Fatal("Error during report generation: %s\n", err)
-			os.Exit(1)
+// applyTriage merges the flagged and hidden pages of the triage file given
+// with --triage into the session.
+func applyTriage(session *core.Session) {
+	triage, err := core.LoadTriage(*sess.Options.TriagePath)
+	if err != nil {
+		sess.Out.FatalWithCode(core.ExitInvalidOptions, "Unable to load triage file at %s: %s\n", *sess.Options.TriagePath, err)
+	}
+	missing := session.ApplyTriage(triage)
+	sess.Out.Important("Merged triage of %d flagged and %d hidden pages\n", len(triage.Flagged), len(triage.Hidden))
+	if missing > 0 {
+		sess.Out.Warn("%d pages in the triage file are not in the session\n", missing)
+	}
+}
+
+// startProfiler serves the pprof endpoints for profiling and tracing a
+// running scan.
+func startProfiler(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		sess.Out.FatalWithCode(core.ExitInvalidOptions, "Unable to start pprof server on %s: %v\n", addr, err)
+	}
+	sess.Out.Important("Serving pprof on http://%s/debug/pprof/\n", listener.Addr())
+
+	go func() {
+		if err := http.Serve(listener, mux); err != nil {
+			sess.Out.Error("pprof server stopped: %v\n", err)
 		}
+	}()
+}
 
-		err = report.Render(f)
-		if err != nil {
-			sess.Out.Fatal("Error during report generation: %s\n", err)
-			os.Exit(1)
+// verifySelfTest prints the results of the self-test checks and exits.
+func verifySelfTest(server *core.SelfTestServer) {
+	failed := 0
+	sess.Out.Important("Self-test:\n")
+	for _, check := range server.Verify(sess) {
+		if check.Passed {
+			sess.Out.Info(" - %-15s : %s (%s)\n", check.Name, agents.Green("OK"), check.Detail)
+		} else {
+			failed++
+			sess.Out.Error(" - %-15s : FAILED (%s)\n", check.Name, check.Detail)
+		}
+	}
+	server.Close()
+
+	if failed > 0 {
+		sess.Out.FatalWithCode(core.ExitGeneric, "\n%d self-test checks failed\n", failed)
+	}
+	sess.Out.Important("\nAll self-test checks passed\n")
+	os.Exit(core.ExitOK)
+}
+
+// cleanOutputDir removes old sessions and unreferenced files from the output
+// directory for the clean subcommand.
+func cleanOutputDir() {
+	sess.Out.Important("Cleaning %s...", *sess.Options.OutDir)
+	result, err := sess.Clean(*sess.Options.KeepSessions)
+	if err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.FatalWithCode(core.ExitOutputDir, "Unable to clean output directory: %v\n", err)
+	}
+	sess.Out.Important(" done\n")
+
+	for _, name := range result.Sessions {
+		sess.Out.Debug("Removed session %s\n", name)
+	}
+	for _, name := range result.Files {
+		sess.Out.Debug("Removed %s\n", name)
+	}
+	sess.Out.Info("Removed %d old sessions and %d unreferenced files (%.1f MB)\n", len(result.Sessions), len(result.Files), float64(result.Bytes)/1024/1024)
+}
+
+// extractFromSession prints the URLs, hosts, technologies or headers of the
+// pages in the session matching --where for the extract subcommand. Only the
+// values are printed so the output can be piped into other tools.
+func extractFromSession() {
+	session, err := core.LoadSession(*sess.Options.SessionPath)
+	if err != nil {
+		sess.Out.FatalWithCode(core.ExitInvalidOptions, "Unable to load session file at %s: %s\n", *sess.Options.SessionPath, err)
+	}
+	values, err := session.Extract(*sess.Options.ExtractWhat, sess.ExtractFilter)
+	if err != nil {
+		sess.Out.FatalWithCode(core.ExitInvalidOptions, "%v\n", err)
+	}
+	for _, value := range values {
+		fmt.Println(value)
+	}
+}
+
+// lintTemplate checks a custom report template for the template lint
+// subcommand and exits with ExitInvalidOptions if it has errors.
+func lintTemplate() {
+	path := *sess.Options.LintPath
+	text, err := ioutil.ReadFile(path)
+	if err != nil {
+		sess.Out.FatalWithCode(core.ExitInvalidOptions, "Unable to read template %s: %v\n", path, err)
+	}
+	problems, err := core.LintTemplate(filepath.Base(path), string(text))
+	if err != nil {
+		sess.Out.FatalWithCode(core.ExitInvalidOptions, "%v\n", err)
+	}
+
+	failed := 0
+	for _, problem := range problems {
+		if problem.Warning {
+			sess.Out.Warn("%s\n", problem)
+		} else {
+			sess.Out.Error("%s\n", problem)
+			failed++
+		}
+	}
+	if failed > 0 {
+		sess.Out.FatalWithCode(core.ExitInvalidOptions, "%s has %d errors and %d warnings\n", path, failed, len(problems)-failed)
+	}
+	sess.Out.Info("%s is valid (%d warnings)\n", path, len(problems))
+}
+
+// importResults adds the pages of a gowitness database or EyeWitness
+// results directory to the session for the import subcommand, which is then
+// reported like a scan.
+func importResults() {
+	path := *sess.Options.ImportPath
+	importer, err := importers.Detect(path)
+	if err != nil {
+		sess.Out.FatalWithCode(core.ExitInvalidOptions, "%v\n", err)
+	}
+
+	sess.Out.Important("Importing %s...", path)
+	count, err := importer.Import(sess, path)
+	if err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.Fatal("Unable to import %s: %v\n", path, err)
+	}
+	sess.Out.Important(" done\n")
+	if count == 0 {
+		sess.Out.FatalWithCode(core.ExitNoTargets, "No pages found in %s.\n", path)
+	}
+	sess.Out.Info("Imported %d pages\n\n", count)
+}
+
+// showReport serves the output directory for the show subcommand.
+func showReport() {
+	server, err := core.NewReportServer(*sess.Options.OutDir, *sess.Options.BasicAuth, sess.Out)
+	if err != nil {
+		sess.Out.FatalWithCode(core.ExitOutputDir, "Unable to serve %s: %v\n", *sess.Options.OutDir, err)
+	}
+
+	if host, _, err := net.SplitHostPort(*sess.Options.Listen); err == nil && *sess.Options.BasicAuth == "" {
+		if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+			sess.Out.Warn("Serving the report on a non-loopback address without --auth\n")
+		}
+	}
+
+	sess.Out.Important("Serving report in %s on http://%s/ (press Ctrl-C to stop)\n", *sess.Options.OutDir, *sess.Options.Listen)
+	if err := http.ListenAndServe(*sess.Options.Listen, server); err != nil {
+		sess.Out.Fatal("Unable to serve report: %v\n", err)
+	}
+}
+
+// scan scans the targets read from stdin, or the self-test server with
+// the selftest command, which is returned to verify the results with.
+func scan() *core.SelfTestServer {
+	if *sess.Options.Via != "" {
+		via, _ := url.Parse(*sess.Options.Via)
+		sess.Out.Important("Connecting to jump host %s...", via.Redacted())
+		if err := sess.ConnectVia(); err != nil {
+			sess.Out.Error(" failed\n")
+			sess.Out.Fatal("%v\n", err)
 		}
 		sess.Out.Important(" done\n\n")
-		sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath("aquatone_report.html"))
-		os.Exit(0)
 	}
 
-	agents.NewTCPPortScanner().Register(sess)
+	// The browser can't bind to a source address, so screenshots are taken
+	// through a proxy that does
+	if sess.BrowserProxy == "" && sess.SourceBound() {
+		if err := sess.StartBrowserProxy(); err != nil {
+			sess.Out.Fatal("Unable to start proxy for the browser: %v\n", err)
+		}
+	}
+
 	agents.NewURLPublisher().Register(sess)
 	agents.NewURLRequester().Register(sess)
 	agents.NewURLHostnameResolver().Register(sess)
@@ -126,47 +506,114 @@ func main() {
 	agents.NewURLScreenshotter().Register(sess)
 	agents.NewURLTechnologyFingerprinter().Register(sess)
 	agents.NewURLTakeoverDetector().Register(sess)
+	agents.NewHostDanglingDNSDetector().Register(sess)
+	agents.NewURLAssetHasher().Register(sess)
+	agents.NewURLFormExtractor().Register(sess)
+	agents.NewURLLeakageDetector().Register(sess)
+	agents.NewURLDefaultPageDetector().Register(sess)
+	agents.NewURLContactExtractor().Register(sess)
+	agents.NewURLFrameExtractor().Register(sess)
+	agents.NewURLRouteExtractor().Register(sess)
+	if *sess.Options.JARM {
+		agents.NewURLJARMFingerprinter().Register(sess)
+	}
+	if *sess.Options.ProbeAPIs {
+		agents.NewURLAPIDetector().Register(sess)
+	}
+	if *sess.Options.WellKnown {
+		agents.NewURLWellKnownFetcher().Register(sess)
+	}
+	if *sess.Options.ProbeExposures {
+		agents.NewURLExposureProber().Register(sess)
+	}
 
-	reader := bufio.NewReader(os.Stdin)
-	var targets []string
+	var selfTest *core.SelfTestServer
+	if *sess.Options.Command == core.CommandSelfTest {
+		if selfTest, err = core.NewSelfTestServer(); err != nil {
+			sess.Out.Fatal("Unable to start self-test server: %s\n", err)
+		}
+		sess.Ports = selfTest.Ports()
+	}
 
-	if *sess.Options.Nmap {
-		parser := parsers.NewNmapParser()
-		targets, err = parser.Parse(reader)
-		if err != nil {
-			sess.Out.Fatal("Unable to parse input as Nmap/Masscan XML: %s\n", err)
-			os.Exit(1)
+	// Hosts are recorded as found in the input format of the file they were
+	// in; those missing from sources come from crt.sh with --expand-wildcards
+	var targets []string
+	sources := make(map[string]string)
+	if selfTest != nil {
+		targets = append(selfTest.Hosts(), selfTest.URLs()...)
+		for _, target := range targets {
+			sources[target] = core.HostSourceSelfTest
 		}
 	} else {
-		parser := parsers.NewRegexParser()
-		targets, err = parser.Parse(reader)
-		if err != nil {
-			sess.Out.Fatal("Unable to parse input.\n")
-			os.Exit(1)
-		}
+		targets, sources = readTargets()
 	}
 
 	if len(targets) == 0 {
-		sess.Out.Fatal("No targets found in input.\n")
-		os.Exit(1)
+		sess.Out.FatalWithCode(core.ExitNoTargets, "No targets found in input.\n")
+	}
+
+	// Hosts are only port scanned when there are any, so a list of URLs is
+	// requested as it is
+	portScan := !*sess.Options.NoPortScan && containsHosts(targets)
+	if portScan {
+		agents.NewTCPPortScanner().Register(sess)
 	}
 
 	sess.Out.Important("Targets    : %d\n", len(targets))
 	sess.Out.Important("Threads    : %d\n", *sess.Options.Threads)
-	sess.Out.Important("Ports      : %s\n", strings.Trim(strings.Replace(fmt.Sprint(sess.Ports), " ", ", ", -1), "[]"))
+	if portScan {
+		sess.Out.Important("Ports      : %s\n", strings.Trim(strings.Replace(fmt.Sprint(sess.Ports), " ", ", ", -1), "[]"))
+	} else {
+		sess.Out.Important("Ports      : not scanned\n")
+	}
 	sess.Out.Important("Output dir : %s\n\n", *sess.Options.OutDir)
 
 	sess.EventBus.Publish(core.SessionStart)
 
+	publishedURLs := make(map[string]bool)
+	publishedPorts := make(map[string]bool)
+	skippedHosts := 0
 	for _, target := range targets {
-		if isURL(target) {
-			if hasSupportedScheme(target) {
-				sess.EventBus.Publish(core.URL, target)
+		source, ok := sources[target]
+		if !ok {
+			source = core.HostSourceCrtsh
+		}
+		if host, port, ok := hostAndPort(target); ok {
+			// Only the given port is requested, with the scheme it answers
+			// to, instead of port scanning the host
+			sess.AddHost(host, source)
+			if !publishedPorts[target] && sess.AllowHost() {
+				publishedPorts[target] = true
+				sess.EventBus.Publish(core.TCPPort, port, host)
+			}
+		} else if isURL(target) {
+			if !hasSupportedScheme(target) {
+				continue
+			}
+			normalized, err := sess.NormalizeURL(target)
+			if err != nil {
+				sess.Out.Debug("Skipping invalid URL %s: %v\n", target, err)
+				continue
 			}
+			if u, err := url.Parse(normalized); err == nil {
+				sess.AddHost(u.Hostname(), source)
+			}
+			if !publishedURLs[normalized] && sess.AllowURL() {
+				publishedURLs[normalized] = true
+				sess.EventBus.Publish(core.URL, normalized)
+			}
+		} else if !portScan {
+			skippedHosts++
 		} else {
-			sess.EventBus.Publish(core.Host, target)
+			sess.AddHost(target, source)
+			if sess.AllowHost() {
+				sess.EventBus.Publish(core.TargetHost, target)
+			}
 		}
 	}
+	if skippedHosts > 0 {
+		sess.Out.Warn("Skipped %d hosts without a URL or port, as --no-portscan is given\n", skippedHosts)
+	}
 
 	time.Sleep(1 * time.Second)
 	sess.EventBus.WaitAsync()
@@ -177,15 +624,157 @@ func main() {
 	sess.EventBus.WaitAsync()
 	sess.WaitGroup.Wait()
 
+	if sess.Stats.HostsSkipped > 0 || sess.Stats.URLsSkipped > 0 {
+		sess.Out.Warn("Skipped %d hosts and %d URLs beyond --max-hosts and --max-urls\n\n", sess.Stats.HostsSkipped, sess.Stats.URLsSkipped)
+	}
+
+	return selfTest
+}
+
+func main() {
+	if sess, err = core.NewSession(); err != nil {
+		// Stdout only carries the summary with --silent
+		fmt.Fprintln(os.Stderr, err)
+		if problems, ok := err.(core.OptionErrors); ok {
+			os.Exit(problems.ExitCode())
+		}
+		os.Exit(core.ExitInvalidOptions)
+	}
+
+	if *sess.Options.Version {
+		sess.Out.Info("%s v%s", core.Name, core.Version)
+		os.Exit(0)
+	}
+
+	if *sess.Options.Command == core.CommandShow {
+		showReport()
+		return
+	}
+
+	if *sess.Options.Command == core.CommandClean {
+		cleanOutputDir()
+		return
+	}
+
+	if *sess.Options.Command == core.CommandExtract {
+		extractFromSession()
+		return
+	}
+
+	if *sess.Options.Command == core.CommandTemplateLint {
+		lintTemplate()
+		return
+	}
+
+	fi, err := os.Stat(*sess.Options.OutDir)
+
+	outDir := strings.TrimSpace(*sess.Options.OutDir)
+	outDir = filepath.Clean(outDir)
+	if _, err := os.Stat(outDir); os.IsNotExist(err) {
+		err = os.MkdirAll(outDir, 0755)
+		if err != nil {
+			sess.Out.FatalWithCode(core.ExitOutputDir, "Failed to create output directory %s: %v\n", outDir, err)
+		}
+	}
+
+	if !fi.IsDir() {
+		sess.Out.FatalWithCode(core.ExitOutputDir, "Output destination must be a directory\n")
+	}
+
+	var baseline *core.Session
+	if *sess.Options.Baseline != "" {
+		if baseline, err = core.LoadSession(*sess.Options.Baseline); err != nil {
+			sess.Out.FatalWithCode(core.ExitInvalidOptions, "Unable to load baseline session at %s: %s\n", *sess.Options.Baseline, err)
+		}
+	}
+
+	sess.Out.Important("%s v%s started at %s\n\n", core.Name, core.Version, sess.Stats.StartedAt.Format(time.RFC3339))
+
+	if *sess.Options.Pprof != "" {
+		startProfiler(*sess.Options.Pprof)
+	}
+
+	if *sess.Options.SessionPath != "" {
+		parsedSession, err := core.LoadSession(*sess.Options.SessionPath)
+		if err != nil {
+			sess.Out.Fatal("Unable to load session file at %s: %s\n", *sess.Options.SessionPath, err)
+			os.Exit(1)
+		}
+
+		sess.Out.Important("Loaded Aquatone session at %s\n", *sess.Options.SessionPath)
+		changed := false
+		if *sess.Options.TriagePath != "" {
+			applyTriage(parsedSession)
+			changed = true
+		}
+		if sess.Authorization != "" {
+			parsedSession.Authorization = sess.Authorization
+			changed = true
+		}
+		if changed {
+			if err := parsedSession.SaveToPath(*sess.Options.SessionPath); err != nil {
+				sess.Out.Fatal("Unable to write session file at %s: %s\n", *sess.Options.SessionPath, err)
+			}
+		}
+		sess.Out.Important("Generating HTML report...")
+		var template []byte
+		if *sess.Options.TemplatePath != "" {
+			template, err = ioutil.ReadFile(*sess.Options.TemplatePath)
+		} else {
+			template, err = sess.Asset("static/report_template.html")
+		}
+
+		if err != nil {
+			sess.Out.Fatal("Can't read report template file\n")
+			os.Exit(1)
+		}
+
+		report := core.NewReport(parsedSession, string(template))
+		report.BaseURL = *sess.Options.ReportBaseURL
+		report.Title = *sess.Options.ReportTitle
+		report.Logo = *sess.Options.ReportLogo
+		report.StoredFiles = sess.StoredFiles()
+		f, err := os.OpenFile(sess.GetFilePath("aquatone_report.html"), os.O_RDWR|os.O_CREATE, 0644)
+		if err != nil {
+			sess.Out.Fatal("Error during report generation: %s\n", err)
+			os.Exit(1)
+		}
+
+		err = report.Render(f)
+		if err != nil {
+			sess.Out.Fatal("Error during report generation: %s\n", err)
+			os.Exit(1)
+		}
+		sess.Out.Important(" done\n\n")
+		if err := parsedSession.SearchIndex().Save(sess.GetFilePath("aquatone_search_index.json")); err != nil {
+			sess.Out.Error("Failed to write search index!\n")
+			sess.Out.Debug("Error: %v\n", err)
+		}
+		writeScreenshotComparison(parsedSession)
+		sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath("aquatone_report.html"))
+		os.Exit(0)
+	}
+
+	sess.MonitorDiskSpace()
+
+	var selfTest *core.SelfTestServer
+	if *sess.Options.Command == core.CommandImport {
+		importResults()
+	} else {
+		selfTest = scan()
+		if selfTest != nil {
+			defer selfTest.Close()
+		}
+	}
+
 	sess.Out.Important("Calculating page structures...")
 	f, _ := os.OpenFile(sess.GetFilePath("aquatone_urls.txt"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	for _, page := range sess.Pages {
-		filename := sess.GetFilePath(fmt.Sprintf("html/%s.html", page.BaseFilename()))
-		body, err := os.Open(filename)
+		body, err := sess.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
 		if err != nil {
 			continue
 		}
-		structure, _ := core.GetPageStructure(body)
+		structure, _ := core.GetPageStructure(bytes.NewReader(body))
 		page.PageStructure = structure
 		f.WriteString(page.URL + "\n")
 	}
@@ -219,6 +808,17 @@ func main() {
 	}
 	sess.Out.Important(" done\n")
 
+	analyzePages(baseline)
+
+	// Bodies are kept until the pages are analyzed
+	if *sess.Options.SaveBody == core.SaveBodyNone {
+		sess.RemoveBodies()
+	}
+
+	if *sess.Options.TriagePath != "" {
+		applyTriage(sess)
+	}
+
 	sess.Out.Important("Generating HTML report...")
 	var template []byte
 	if *sess.Options.TemplatePath != "" {
@@ -232,6 +832,10 @@ func main() {
 		os.Exit(1)
 	}
 	report := core.NewReport(sess, string(template))
+	report.BaseURL = *sess.Options.ReportBaseURL
+	report.Title = *sess.Options.ReportTitle
+	report.Logo = *sess.Options.ReportLogo
+	report.StoredFiles = sess.StoredFiles()
 	f, err = os.OpenFile(sess.GetFilePath("aquatone_report.html"), os.O_RDWR|os.O_CREATE, 0644)
 	if err != nil {
 		sess.Out.Fatal("Error during report generation: %s\n", err)
@@ -244,23 +848,99 @@ func main() {
 	}
 	sess.Out.Important(" done\n\n")
 
+	writeScreenshotComparison(sess)
+
 	sess.End()
 
+	previousSession, _ := core.LoadSession(core.FindSessionFile(sess.GetFilePath("")))
+
 	sess.Out.Important("Writing session file...")
-	err = sess.SaveToFile("aquatone_session.json")
+	err = sess.SaveToFile(sess.SessionFilename())
 	if err != nil {
 		sess.Out.Error("Failed!\n")
 		sess.Out.Debug("Error: %v\n", err)
 	}
 
+	err = sess.SaveToHistory()
+	if err != nil {
+		sess.Out.Error("Failed to write session to history!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
+
+	err = sess.SaveFailuresToFile("aquatone_errors.json")
+	if err != nil {
+		sess.Out.Error("Failed to write errors file!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
+
+	err = sess.SaveUnresponsiveToFile("aquatone_unresponsive.txt")
+	if err != nil {
+		sess.Out.Error("Failed to write unresponsive URLs file!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
+
+	err = sess.SaveOpenPortsToFile("aquatone_open_ports.txt")
+	if err != nil {
+		sess.Out.Error("Failed to write open ports file!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
+
+	err = sess.SaveHostsToFile("aquatone_hosts.txt")
+	if err != nil {
+		sess.Out.Error("Failed to write hosts file!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
+
+	err = sess.SaveContactsToFile("aquatone_contacts.txt")
+	if err != nil {
+		sess.Out.Error("Failed to write contacts file!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
+
+	err = sess.SaveSearchIndexToFile("aquatone_search_index.json")
+	if err != nil {
+		sess.Out.Error("Failed to write search index!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
+
+	err = sess.SaveManifest()
+	if err != nil {
+		sess.Out.Error("Failed to write manifest!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
+
+	writeExport(*sess.Options.ExportBurp, "Burp Suite", exporters.NewBurpExporter())
+	writeExport(*sess.Options.ExportZAP, "OWASP ZAP context", exporters.NewZAPExporter())
+	writeExport(*sess.Options.ExportDefectDojo, "DefectDojo findings", exporters.NewDefectDojoExporter())
+	writeExport(*sess.Options.ExportCycloneDX, "CycloneDX technology inventory", exporters.NewCycloneDXExporter())
+	writeExport(*sess.Options.ExportSTIX, "STIX observables", exporters.NewSTIXExporter())
+	writeExport(*sess.Options.ExportNmapXML, "Nmap XML", exporters.NewNmapExporter())
+	writeExport(*sess.Options.ExportStructure, "page structure", exporters.NewStructureExporter())
+
+	if err := sess.CloseResponseStore(); err != nil {
+		sess.Out.Error("Failed to close response store!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
+
+	if *sess.Options.Archive != "" {
+		sess.Out.Important("Writing archive...")
+		if err := sess.WriteArchive(*sess.Options.Archive, *sess.Options.ArchivePassphrase); err != nil {
+			sess.Out.Error("Failed!\n")
+			sess.Out.Debug("Error: %v\n", err)
+		} else {
+			sess.Out.Important(" done\n")
+		}
+	}
+
 	sess.Out.Important("Time:\n")
 	sess.Out.Info(" - Started at  : %v\n", sess.Stats.StartedAt.Format(time.RFC3339))
 	sess.Out.Info(" - Finished at : %v\n", sess.Stats.FinishedAt.Format(time.RFC3339))
 	sess.Out.Info(" - Duration    : %v\n\n", sess.Stats.Duration().Round(time.Second))
 
 	sess.Out.Important("Requests:\n")
-	sess.Out.Info(" - Successful : %v\n", sess.Stats.RequestSuccessful)
-	sess.Out.Info(" - Failed     : %v\n\n", sess.Stats.RequestFailed)
+	sess.Out.Info(" - Successful   : %v\n", sess.Stats.RequestSuccessful)
+	sess.Out.Info(" - Failed       : %v\n", sess.Stats.RequestFailed)
+	sess.Out.Info(" - Rate limited : %v (%v pages still rate limited)\n\n", sess.Stats.RateLimited, sess.Stats.RateLimitedPages)
 
 	sess.Out.Info(" - 2xx : %v\n", sess.Stats.ResponseCode2xx)
 	sess.Out.Info(" - 3xx : %v\n", sess.Stats.ResponseCode3xx)
@@ -269,7 +949,41 @@ func main() {
 
 	sess.Out.Important("Screenshots:\n")
 	sess.Out.Info(" - Successful : %v\n", sess.Stats.ScreenshotSuccessful)
-	sess.Out.Info(" - Failed     : %v\n\n", sess.Stats.ScreenshotFailed)
+	sess.Out.Info(" - Failed     : %v\n", sess.Stats.ScreenshotFailed)
+	sess.Out.Info(" - Crashes    : %v (%v restarted)\n\n", sess.Stats.BrowserCrashes, sess.Stats.BrowserRestarts)
+
+	if lookups, hits := sess.DNSCacheStats(); lookups > 0 {
+		sess.Out.Debug("DNS cache: %d of %d lookups answered from the cache\n\n", hits, lookups)
+	}
+
+	if timings := sess.SortedAgentTimings(); len(timings) > 0 {
+		sess.Out.Important("Agent timing:\n")
+		for _, timing := range timings {
+			sess.Out.Info(" - %-28s : %6d items in %-10v (avg %v)\n", strings.TrimPrefix(timing.Agent, "agent:"), timing.Items, timing.Elapsed().Round(time.Millisecond), timing.Average().Round(time.Millisecond))
+		}
+		sess.Out.Info("\n")
+	}
 
 	sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath("aquatone_report.html"))
+
+	met := sess.MetFailConditions(previousSession)
+	if *sess.Options.Silent {
+		fmt.Println(sess.Summary(met).ToJSON())
+	}
+
+	if selfTest != nil {
+		verifySelfTest(selfTest)
+	}
+
+	if *sess.Options.FailureThreshold > 0 && sess.FailureRate() > *sess.Options.FailureThreshold {
+		sess.Out.FatalWithCode(core.ExitFailureThreshold, "Request failure rate of %.1f%% exceeds threshold of %.1f%%\n", sess.FailureRate(), *sess.Options.FailureThreshold)
+	}
+
+	if len(met) > 0 {
+		var conditions []string
+		for _, condition := range met {
+			conditions = append(conditions, condition.String())
+		}
+		sess.Out.FatalWithCode(core.ExitFailCondition, "Fail conditions met: %s\n", strings.Join(conditions, ", "))
+	}
 }
@@ -0,0 +1,83 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// AmassParser parses the JSON output of OWASP Amass (amass enum -json),
+// which has one discovered name per line with the addresses it resolved to.
+type AmassParser struct {
+	addrs map[string][]string
+}
+
+type amassResult struct {
+	Name      string `json:"name"`
+	Addresses []struct {
+		IP string `json:"ip"`
+	} `json:"addresses"`
+}
+
+func NewAmassParser() *AmassParser {
+	return &AmassParser{addrs: make(map[string][]string)}
+}
+
+func (p *AmassParser) Name() string {
+	return "amass"
+}
+
+// Sniff recognizes a first line that is a JSON object with the name and
+// addresses fields.
+func (p *AmassParser) Sniff(prefix []byte) bool {
+	line := prefix
+	if i := bytes.IndexByte(prefix, '\n'); i != -1 {
+		line = prefix[:i]
+	}
+	return bytes.HasPrefix(line, []byte("{")) && bytes.Contains(line, []byte(`"name"`)) && bytes.Contains(line, []byte(`"addresses"`))
+}
+
+// Parse returns the discovered names. Lines that are not JSON objects are
+// skipped.
+func (p *AmassParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if !bytes.HasPrefix(line, []byte("{")) {
+			continue
+		}
+		var result amassResult
+		if err := json.Unmarshal(line, &result); err != nil {
+			continue
+		}
+		name, ok := SanitizeTarget(result.Name)
+		if !ok {
+			continue
+		}
+		if _, found := p.addrs[name]; !found {
+			targets = append(targets, name)
+			p.addrs[name] = nil
+		}
+		for _, address := range result.Addresses {
+			if address.IP != "" && !containsString(p.addrs[name], address.IP) {
+				p.addrs[name] = append(p.addrs[name], address.IP)
+			}
+		}
+	}
+	return targets, scanner.Err()
+}
+
+// HostAddrs returns the addresses Amass resolved the names to.
+func (p *AmassParser) HostAddrs() map[string][]string {
+	return p.addrs
+}
+
+// VerifiedHostAddrs returns true, as Amass checks names against its
+// trusted resolvers before writing them.
+func (p *AmassParser) VerifiedHostAddrs() bool {
+	return true
+}
@@ -0,0 +1,100 @@
+package parsers
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net"
+	"strings"
+)
+
+// BurpParser parses the XML of items saved from the site map or proxy
+// history of Burp Suite (Save selected items), which has the URL of every
+// request Burp has seen with the response to it.
+type BurpParser struct {
+	addrs map[string][]string
+}
+
+type burpItem struct {
+	URL  string `xml:"url"`
+	Host struct {
+		IP   string `xml:"ip,attr"`
+		Name string `xml:",chardata"`
+	} `xml:"host"`
+	Method   string `xml:"method"`
+	MimeType string `xml:"mimetype"`
+}
+
+func NewBurpParser() *BurpParser {
+	return &BurpParser{addrs: make(map[string][]string)}
+}
+
+func (p *BurpParser) Name() string {
+	return "burp"
+}
+
+func (p *BurpParser) Sniff(prefix []byte) bool {
+	return bytes.HasPrefix(prefix, []byte("<")) && bytes.Contains(prefix, []byte("<items burpVersion"))
+}
+
+// Parse returns the URLs of the pages among the items: GET requests whose
+// response was HTML, or that Burp has no response for. Scripts, stylesheets,
+// images and other files pages load are left out, as are requests with
+// other methods. Items hold the full requests and responses, so they are
+// decoded one at a time.
+func (p *BurpParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	seen := make(map[string]bool)
+
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return targets, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "item" {
+			continue
+		}
+		var item burpItem
+		if err := decoder.DecodeElement(&item, &start); err != nil {
+			return targets, err
+		}
+		target := strings.TrimSpace(item.URL)
+		if target == "" || !strings.EqualFold(item.Method, "GET") {
+			continue
+		}
+		if mimeType := strings.ToUpper(strings.TrimSpace(item.MimeType)); mimeType != "" && mimeType != "HTML" {
+			continue
+		}
+		if !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+		p.addHostAddr(item)
+	}
+	return targets, nil
+}
+
+func (p *BurpParser) addHostAddr(item burpItem) {
+	hostname := strings.ToLower(strings.TrimSpace(item.Host.Name))
+	if hostname == "" || net.ParseIP(hostname) != nil || net.ParseIP(item.Host.IP) == nil {
+		return
+	}
+	if !containsString(p.addrs[hostname], item.Host.IP) {
+		p.addrs[hostname] = append(p.addrs[hostname], item.Host.IP)
+	}
+}
+
+// HostAddrs returns the addresses Burp connected to for the hostnames.
+func (p *BurpParser) HostAddrs() map[string][]string {
+	return p.addrs
+}
+
+// VerifiedHostAddrs returns true, as Burp connected to the addresses.
+func (p *BurpParser) VerifiedHostAddrs() bool {
+	return true
+}
@@ -0,0 +1,58 @@
+package parsers
+
+import (
+	"net"
+	"strings"
+)
+
+// parseCIDR returns the network of a CIDR range like 10.0.0.0/24 found in
+// the input, in its canonical form. ok is false if s isn't a CIDR range.
+func parseCIDR(s string) (string, bool) {
+	s = trimJunk(strings.Trim(s, "\"'<>()[]"))
+	if !strings.Contains(s, "/") || strings.Contains(s, "://") {
+		return "", false
+	}
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		return "", false
+	}
+	return network.String(), true
+}
+
+// ExpandCIDR returns the addresses of the hosts in a CIDR range, like
+// 10.0.0.1 to 10.0.0.254 for 10.0.0.0/24. The network and broadcast
+// addresses of IPv4 ranges are left out, except for /31 and /32 ranges,
+// which don't have them. ok is false if the range is invalid or has more
+// than max addresses, so a typo like /8 for /28 doesn't turn into millions
+// of hosts.
+func ExpandCIDR(cidr string, max int) ([]string, bool) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, false
+	}
+	ones, bits := network.Mask.Size()
+	if bits-ones >= 31 || 1<<uint(bits-ones) > max {
+		return nil, false
+	}
+
+	var addrs []string
+	ip := make(net.IP, len(network.IP))
+	copy(ip, network.IP)
+	for ; network.Contains(ip); incrementIP(ip) {
+		addrs = append(addrs, ip.String())
+	}
+	if ip.To4() != nil && bits-ones > 1 {
+		addrs = addrs[1 : len(addrs)-1]
+	}
+	return addrs, true
+}
+
+// incrementIP sets ip to the address after it.
+func incrementIP(ip net.IP) {
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			return
+		}
+	}
+}
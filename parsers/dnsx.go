@@ -0,0 +1,84 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// DnsxParser parses the JSON lines output of ProjectDiscovery's dnsx
+// (-json), which has one resolved hostname per line.
+type DnsxParser struct {
+	addrs map[string][]string
+}
+
+type dnsxResult struct {
+	Host string   `json:"host"`
+	A    []string `json:"a"`
+	AAAA []string `json:"aaaa"`
+}
+
+func NewDnsxParser() *DnsxParser {
+	return &DnsxParser{addrs: make(map[string][]string)}
+}
+
+func (p *DnsxParser) Name() string {
+	return "dnsx"
+}
+
+// Sniff recognizes a first line that is a JSON object with the host field
+// and the resolver or record fields dnsx writes. Output of httpx also has a
+// host field, but is recognized by its url field first.
+func (p *DnsxParser) Sniff(prefix []byte) bool {
+	line := prefix
+	if i := bytes.IndexByte(prefix, '\n'); i != -1 {
+		line = prefix[:i]
+	}
+	if !bytes.HasPrefix(line, []byte("{")) || !bytes.Contains(line, []byte(`"host"`)) {
+		return false
+	}
+	return bytes.Contains(line, []byte(`"resolver"`)) || bytes.Contains(line, []byte(`"a"`)) || bytes.Contains(line, []byte(`"aaaa"`))
+}
+
+// Parse returns the resolved hostnames. Lines that are not JSON objects are
+// skipped.
+func (p *DnsxParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if !bytes.HasPrefix(line, []byte("{")) {
+			continue
+		}
+		var result dnsxResult
+		if err := json.Unmarshal(line, &result); err != nil {
+			continue
+		}
+		host, ok := SanitizeTarget(result.Host)
+		if !ok {
+			continue
+		}
+		if _, found := p.addrs[host]; !found {
+			targets = append(targets, host)
+			p.addrs[host] = nil
+		}
+		for _, addr := range append(result.A, result.AAAA...) {
+			if !containsString(p.addrs[host], addr) {
+				p.addrs[host] = append(p.addrs[host], addr)
+			}
+		}
+	}
+	return targets, scanner.Err()
+}
+
+// HostAddrs returns the A and AAAA records of the hostnames.
+func (p *DnsxParser) HostAddrs() map[string][]string {
+	return p.addrs
+}
+
+func (p *DnsxParser) VerifiedHostAddrs() bool {
+	return false
+}
@@ -0,0 +1,103 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// HttpxParser parses the JSON lines output of ProjectDiscovery's httpx
+// (-json), which has one probed URL per line. The URLs are requested as they
+// are, without port scanning their hosts, and the addresses httpx connected
+// to are used instead of resolving the hostnames again.
+type HttpxParser struct {
+	addrs map[string][]string
+}
+
+type httpxResult struct {
+	URL    string   `json:"url"`
+	Host   string   `json:"host"`
+	A      []string `json:"a"`
+	AAAA   []string `json:"aaaa"`
+	Failed bool     `json:"failed"`
+}
+
+func NewHttpxParser() *HttpxParser {
+	return &HttpxParser{addrs: make(map[string][]string)}
+}
+
+func (p *HttpxParser) Name() string {
+	return "httpx"
+}
+
+// Sniff recognizes a first line that is a JSON object with the url field
+// and the input or status code fields httpx writes. Older versions of httpx
+// write status-code instead of status_code.
+func (p *HttpxParser) Sniff(prefix []byte) bool {
+	line := prefix
+	if i := bytes.IndexByte(prefix, '\n'); i != -1 {
+		line = prefix[:i]
+	}
+	if !bytes.HasPrefix(line, []byte("{")) || !bytes.Contains(line, []byte(`"url"`)) {
+		return false
+	}
+	return bytes.Contains(line, []byte(`"input"`)) || bytes.Contains(line, []byte(`"status_code"`)) || bytes.Contains(line, []byte(`"status-code"`))
+}
+
+// Parse returns the URLs of the results. Lines that are not JSON objects,
+// like log messages mixed into the output, are skipped, and so are URLs
+// that httpx failed to probe, written with -probe.
+func (p *HttpxParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if !bytes.HasPrefix(line, []byte("{")) {
+			continue
+		}
+		var result httpxResult
+		if err := json.Unmarshal(line, &result); err != nil || result.URL == "" || result.Failed {
+			continue
+		}
+		if !seen[result.URL] {
+			seen[result.URL] = true
+			targets = append(targets, result.URL)
+		}
+		p.addHostAddrs(result)
+	}
+	return targets, scanner.Err()
+}
+
+// addHostAddrs records the addresses of the hostname of a result. The host
+// field holds the address httpx connected to, and the a and aaaa fields all
+// addresses the hostname resolved to.
+func (p *HttpxParser) addHostAddrs(result httpxResult) {
+	u, err := url.Parse(result.URL)
+	if err != nil || u.Hostname() == "" || net.ParseIP(u.Hostname()) != nil {
+		return
+	}
+	hostname := strings.ToLower(u.Hostname())
+	addrs := append(append([]string{result.Host}, result.A...), result.AAAA...)
+	for _, addr := range addrs {
+		if net.ParseIP(addr) != nil && !containsString(p.addrs[hostname], addr) {
+			p.addrs[hostname] = append(p.addrs[hostname], addr)
+		}
+	}
+}
+
+// HostAddrs returns the addresses httpx found for the hostnames.
+func (p *HttpxParser) HostAddrs() map[string][]string {
+	return p.addrs
+}
+
+// VerifiedHostAddrs returns true, as httpx connected to the addresses.
+func (p *HttpxParser) VerifiedHostAddrs() bool {
+	return true
+}
@@ -0,0 +1,97 @@
+package parsers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// MasscanParser parses the JSON output of Masscan (-oJ). Masscan's XML
+// output is handled by NmapParser.
+type MasscanParser struct{}
+
+type masscanHost struct {
+	IP    string `json:"ip"`
+	Ports []struct {
+		Port    int    `json:"port"`
+		Proto   string `json:"proto"`
+		Status  string `json:"status"`
+		Service struct {
+			Name string `json:"name"`
+		} `json:"service"`
+	} `json:"ports"`
+}
+
+func NewMasscanParser() *MasscanParser {
+	return &MasscanParser{}
+}
+
+func (p *MasscanParser) Name() string {
+	return "masscan"
+}
+
+func (p *MasscanParser) Sniff(prefix []byte) bool {
+	return (bytes.HasPrefix(prefix, []byte("[")) || bytes.HasPrefix(prefix, []byte("{"))) &&
+		bytes.Contains(prefix, []byte(`"ip"`)) && bytes.Contains(prefix, []byte(`"ports"`))
+}
+
+// Parse returns URLs of the open TCP ports in the output. Ports are kept if
+// Masscan grabbed an HTTP or SSL banner from them or if they are common web
+// ports. Older versions of Masscan write a trailing comma after the last
+// host, so parsing stops without an error at the first record that can't be
+// decoded once any hosts have been read.
+func (p *MasscanParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return targets, err
+	}
+	data = trimPrefix(data)
+
+	// Output is either an array of hosts or, with --output-format ndjson,
+	// one host per line
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if bytes.HasPrefix(data, []byte("[")) {
+		if _, err := decoder.Token(); err != nil {
+			return targets, err
+		}
+	}
+
+	seen := make(map[string]bool)
+	hosts := 0
+	for decoder.More() {
+		var host masscanHost
+		if err := decoder.Decode(&host); err != nil {
+			if hosts > 0 {
+				break
+			}
+			return targets, err
+		}
+		hosts++
+		for _, port := range host.Ports {
+			if port.Proto != "tcp" || (port.Status != "" && port.Status != "open") {
+				continue
+			}
+			protocol := ""
+			switch port.Service.Name {
+			case "ssl":
+				protocol = "https"
+			case "http":
+				protocol = "http"
+			default:
+				if !isHTTPPort(port.Port) {
+					continue
+				}
+			}
+			target := core.HostAndPortToURL(host.IP, port.Port, protocol)
+			if !seen[target] {
+				seen[target] = true
+				targets = append(targets, target)
+			}
+		}
+	}
+	return targets, nil
+}
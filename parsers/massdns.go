@@ -0,0 +1,107 @@
+package parsers
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// maxCNAMEChain is the number of CNAME records that are followed to find
+// the addresses of a name.
+const maxCNAMEChain = 8
+
+// massdnsRecord matches a record in the simple text output of massdns
+// (-o S), like "www.example.com. A 192.0.2.1".
+var massdnsRecord = regexp.MustCompile(`^(\S+)\.\s+([A-Z]+)\s+(\S+)`)
+
+// MassdnsParser parses the simple text output of massdns (-o S), which has
+// one record per line.
+type MassdnsParser struct {
+	addrs map[string][]string
+}
+
+func NewMassdnsParser() *MassdnsParser {
+	return &MassdnsParser{addrs: make(map[string][]string)}
+}
+
+func (p *MassdnsParser) Name() string {
+	return "massdns"
+}
+
+// Sniff recognizes a first line that is an A, AAAA or CNAME record of a
+// fully qualified name.
+func (p *MassdnsParser) Sniff(prefix []byte) bool {
+	line := string(prefix)
+	if i := strings.IndexByte(line, '\n'); i != -1 {
+		line = line[:i]
+	}
+	match := massdnsRecord.FindStringSubmatch(strings.TrimSpace(line))
+	return match != nil && (match[2] == "A" || match[2] == "AAAA" || match[2] == "CNAME")
+}
+
+// Parse returns the names with A, AAAA or CNAME records. The output lists
+// the records of CNAME targets with the target as name, so names that are
+// only CNAME targets of other names are not returned, and their addresses
+// are given to the names pointing to them instead.
+func (p *MassdnsParser) Parse(r io.Reader) ([]string, error) {
+	var names []string
+	addrs := make(map[string][]string)
+	cnames := make(map[string]string)
+	cnameTargets := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		match := massdnsRecord.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if match == nil {
+			continue
+		}
+		name, ok := SanitizeTarget(match[1])
+		if !ok {
+			continue
+		}
+		switch match[2] {
+		case "A", "AAAA":
+			if !containsString(addrs[name], match[3]) {
+				addrs[name] = append(addrs[name], match[3])
+			}
+		case "CNAME":
+			target, ok := SanitizeTarget(match[3])
+			if !ok {
+				continue
+			}
+			cnames[name] = target
+			cnameTargets[target] = true
+		default:
+			continue
+		}
+		if _, found := p.addrs[name]; !found {
+			names = append(names, name)
+			p.addrs[name] = nil
+		}
+	}
+
+	var targets []string
+	for _, name := range names {
+		if cnameTargets[name] {
+			delete(p.addrs, name)
+			continue
+		}
+		target := name
+		for i := 0; i < maxCNAMEChain && len(addrs[target]) == 0 && cnames[target] != ""; i++ {
+			target = cnames[target]
+		}
+		p.addrs[name] = addrs[target]
+		targets = append(targets, name)
+	}
+	return targets, scanner.Err()
+}
+
+// HostAddrs returns the addresses of the names, following CNAME records.
+func (p *MassdnsParser) HostAddrs() map[string][]string {
+	return p.addrs
+}
+
+func (p *MassdnsParser) VerifiedHostAddrs() bool {
+	return false
+}
@@ -0,0 +1,170 @@
+package parsers
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/mk990/aquatone/core"
+)
+
+// NessusParser parses the XML exports of Nessus and Tenable.sc (.nessus
+// files), which list the findings of every scanned host with the port and
+// service they were made on.
+type NessusParser struct{}
+
+type nessusReportHost struct {
+	Name       string `xml:"name,attr"`
+	Properties []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"HostProperties>tag"`
+	Items []nessusReportItem `xml:"ReportItem"`
+}
+
+type nessusReportItem struct {
+	Port     int    `xml:"port,attr"`
+	Protocol string `xml:"protocol,attr"`
+	SvcName  string `xml:"svc_name,attr"`
+	PluginID string `xml:"pluginID,attr"`
+	Output   string `xml:"plugin_output"`
+}
+
+// Plugins of Nessus that tell what runs on a port.
+const (
+	nessusServiceDetection = "22964"
+	nessusTLSVersions      = "56984"
+	nessusCertificateInfo  = "10863"
+)
+
+func NewNessusParser() *NessusParser {
+	return &NessusParser{}
+}
+
+func (p *NessusParser) Name() string {
+	return "nessus"
+}
+
+func (p *NessusParser) Sniff(prefix []byte) bool {
+	return bytes.HasPrefix(prefix, []byte("<")) && bytes.Contains(prefix, []byte("<NessusClientData_v2"))
+}
+
+// Parse returns URLs of the open TCP ports of the hosts in the export, as
+// every finding on a port means it was open. Ports are kept if Nessus found
+// a web server on them or if they are common web ports, and use HTTPS if
+// Nessus found TLS on them or they are common HTTPS ports. Exports of large
+// scans run into hundreds of MB, so hosts are decoded one at a time.
+func (p *NessusParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	seen := make(map[string]bool)
+
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return targets, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "ReportHost" {
+			continue
+		}
+		var host nessusReportHost
+		if err := decoder.DecodeElement(&host, &start); err != nil {
+			return targets, err
+		}
+		for _, target := range p.hostToURLs(host) {
+			if !seen[target] {
+				seen[target] = true
+				targets = append(targets, target)
+			}
+		}
+	}
+	return targets, nil
+}
+
+func (p *NessusParser) hostToURLs(host nessusReportHost) []string {
+	type portInfo struct {
+		web bool
+		tls bool
+	}
+	var ports []int
+	info := make(map[int]*portInfo)
+	for _, item := range host.Items {
+		if item.Port <= 0 || item.Protocol != "tcp" {
+			continue
+		}
+		port, ok := info[item.Port]
+		if !ok {
+			port = &portInfo{}
+			info[item.Port] = port
+			ports = append(ports, item.Port)
+		}
+
+		// Services Nessus isn't sure about end in a question mark
+		switch strings.TrimSuffix(item.SvcName, "?") {
+		case "www", "http", "http-alt":
+			port.web = true
+		case "https":
+			port.web, port.tls = true, true
+		}
+		switch item.PluginID {
+		case nessusServiceDetection:
+			// Like "A web server is running on this port through TLSv1.2."
+			output := strings.ToLower(item.Output)
+			if strings.Contains(output, "web server") {
+				port.web = true
+			}
+			if strings.Contains(output, "through ssl") || strings.Contains(output, "through tls") {
+				port.tls = true
+			}
+		case nessusTLSVersions, nessusCertificateInfo:
+			port.tls = true
+		}
+	}
+
+	hostname := p.hostname(host)
+	var urls []string
+	for _, number := range ports {
+		port := info[number]
+		if !port.web && !isHTTPPort(number) {
+			continue
+		}
+		protocol := ""
+		if port.tls {
+			protocol = "https"
+		}
+		urls = append(urls, core.HostAndPortToURL(hostname, number, protocol))
+	}
+	return urls
+}
+
+// hostname returns the name to scan the host by. Hosts scanned by name keep
+// it, as the FQDN Nessus reports may come from the PTR record of the
+// address instead. Hosts scanned by address use the FQDN when Nessus found
+// one, like the hostnames Nmap found are used for Nmap input.
+func (p *NessusParser) hostname(host nessusReportHost) string {
+	var fqdn, ip string
+	for _, property := range host.Properties {
+		switch property.Name {
+		case "host-fqdn":
+			fqdn = strings.TrimSpace(property.Value)
+		case "host-ip":
+			ip = strings.TrimSpace(property.Value)
+		}
+	}
+	if host.Name != "" && net.ParseIP(host.Name) == nil {
+		return host.Name
+	}
+	if fqdn != "" {
+		return fqdn
+	}
+	if host.Name != "" {
+		return host.Name
+	}
+	return ip
+}
@@ -1,6 +1,7 @@
 package parsers
 
 import (
+	"bytes"
 	"io"
 	"io/ioutil"
 
@@ -15,6 +16,16 @@ func NewNmapParser() *NmapParser {
 	return &NmapParser{}
 }
 
+func (p *NmapParser) Name() string {
+	return "nmap"
+}
+
+// Sniff recognizes XML output of Nmap and Masscan, which both use the
+// nmaprun root element.
+func (p *NmapParser) Sniff(prefix []byte) bool {
+	return bytes.HasPrefix(prefix, []byte("<")) && bytes.Contains(prefix, []byte("<nmaprun"))
+}
+
 func (p *NmapParser) Parse(r io.Reader) ([]string, error) {
 	var targets []string
 	bytes, err := ioutil.ReadAll(r)
@@ -36,15 +47,6 @@ func (p *NmapParser) Parse(r io.Reader) ([]string, error) {
 	return targets, nil
 }
 
-func (p *NmapParser) isHTTPPort(port int) bool {
-	for _, p := range core.XLargePortList {
-		if p == port {
-			return true
-		}
-	}
-	return false
-}
-
 func (p *NmapParser) hostToURLs(host nmap.Host) []string {
 	var urls []string
 	for _, port := range host.Ports {
@@ -60,7 +62,7 @@ func (p *NmapParser) hostToURLs(host nmap.Host) []string {
 		} else if port.Service.Name == "http" || port.Service.Name == "http-alt" {
 			protocol = "http"
 		} else {
-			if !p.isHTTPPort(port.PortId) {
+			if !isHTTPPort(port.PortId) {
 				continue
 			}
 		}
@@ -81,3 +83,14 @@ func (p *NmapParser) hostToURLs(host nmap.Host) []string {
 
 	return urls
 }
+
+// isHTTPPort reports whether the port is in the largest list of web ports,
+// for ports without service information.
+func isHTTPPort(port int) bool {
+	for _, p := range core.XLargePortList {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// FormatAuto detects the format of the input from its first bytes.
+const FormatAuto = "auto"
+
+// sniffSize is the number of bytes of the input that parsers get to
+// recognize their format from.
+const sniffSize = 4096
+
+// Parser extracts targets, hostnames, IPs and URLs, from input in a format
+// like Nmap XML or plain text.
+type Parser interface {
+	// Name returns the name of the input format, as given with
+	// --input-format.
+	Name() string
+	// Sniff reports whether input starting with prefix is in the parser's
+	// format. The prefix is at most a few KB and may end in the middle of a
+	// record.
+	Sniff(prefix []byte) bool
+	Parse(r io.Reader) ([]string, error)
+}
+
+// HostAddrsParser is implemented by parsers of formats that include the
+// addresses hostnames resolve to, so they don't have to be looked up again.
+type HostAddrsParser interface {
+	Parser
+	// HostAddrs returns the addresses of the hostnames in the parsed input.
+	HostAddrs() map[string][]string
+	// VerifiedHostAddrs reports whether the tool that wrote the input
+	// verified the addresses, so they can be used without
+	// --trust-resolution. Tools that query lists of public resolvers get
+	// wrong answers from some of them.
+	VerifiedHostAddrs() bool
+}
+
+// registry holds the constructors of the known parsers, in the order their
+// formats are sniffed. The plain text parser accepts any input and is
+// always tried last.
+var registry = []func() Parser{
+	func() Parser { return NewNmapParser() },
+	func() Parser { return NewNessusParser() },
+	func() Parser { return NewBurpParser() },
+	func() Parser { return NewMasscanParser() },
+	func() Parser { return NewHttpxParser() },
+	func() Parser { return NewAmassParser() },
+	func() Parser { return NewDnsxParser() },
+	func() Parser { return NewMassdnsParser() },
+}
+
+// Register adds a parser for another input format. Formats are sniffed in
+// the order they are registered, after the built-in ones.
+func Register(newParser func() Parser) {
+	registry = append(registry, newParser)
+}
+
+// Formats returns the names of the known input formats.
+func Formats() []string {
+	names := []string{NewRegexParser().Name()}
+	for _, newParser := range registry {
+		names = append(names, newParser().Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New returns a parser for the named input format.
+func New(format string) (Parser, error) {
+	if p := NewRegexParser(); p.Name() == format {
+		return p, nil
+	}
+	for _, newParser := range registry {
+		if p := newParser(); p.Name() == format {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("Unknown input format %q (valid formats: %s, %s)", format, FormatAuto, strings.Join(Formats(), ", "))
+}
+
+// Detect returns a parser for the format of the input, recognized from its
+// first bytes without consuming them. Input that no parser recognizes is
+// parsed as plain text.
+func Detect(r *bufio.Reader) Parser {
+	prefix, _ := r.Peek(sniffSize)
+	prefix = trimPrefix(prefix)
+	for _, newParser := range registry {
+		if p := newParser(); p.Sniff(prefix) {
+			return p
+		}
+	}
+	return NewRegexParser()
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// trimPrefix removes a byte order mark and leading whitespace.
+func trimPrefix(prefix []byte) []byte {
+	prefix = bytes.TrimPrefix(prefix, []byte("\xef\xbb\xbf"))
+	return bytes.TrimLeft(prefix, " \t\r\n")
+}
@@ -3,24 +3,67 @@ package parsers
 import (
 	"bufio"
 	"io"
+	"strings"
 
 	"github.com/mvdan/xurls"
 )
 
-type RegexParser struct{}
+type RegexParser struct {
+	// Wildcards holds the domains of wildcard entries like *.example.com
+	// found in the input. The domains themselves are returned as targets.
+	Wildcards []string
+	// CIDRs holds the CIDR ranges like 10.0.0.0/24 found in the input, to
+	// be expanded to their addresses with ExpandCIDR.
+	CIDRs []string
+}
 
 func NewRegexParser() *RegexParser {
 	return &RegexParser{}
 }
 
+func (p *RegexParser) Name() string {
+	return "text"
+}
+
+// Sniff accepts any input, as hostnames, IPs and URLs are found anywhere in
+// it.
+func (p *RegexParser) Sniff(prefix []byte) bool {
+	return true
+}
+
 func (p *RegexParser) Parse(r io.Reader) ([]string, error) {
 	var targets []string
 	targetsFilter := make(map[string]struct{})
+	wildcardsFilter := make(map[string]struct{})
+	cidrsFilter := make(map[string]struct{})
 
 	scanner := bufio.NewScanner(r)
 	urls := xurls.Relaxed
 	for scanner.Scan() {
-		for _, target := range urls.FindAllString(scanner.Text(), -1) {
+		line := punycodeLine(scanner.Text())
+		for _, field := range strings.Fields(line) {
+			if cidr, ok := parseCIDR(field); ok {
+				if _, found := cidrsFilter[cidr]; !found {
+					p.CIDRs = append(p.CIDRs, cidr)
+					cidrsFilter[cidr] = struct{}{}
+				}
+				// Keep the address of the range from being matched as a host
+				line = strings.Replace(line, field, "", 1)
+			}
+		}
+		// The URL pattern doesn't allow underscores in hostnames, which are
+		// common in DNS names, so they are matched as letters
+		for _, match := range urls.FindAllStringIndex(strings.Replace(line, "_", "x", -1), -1) {
+			target, ok := SanitizeTarget(line[match[0]:match[1]])
+			if !ok {
+				continue
+			}
+			if strings.HasSuffix(line[:match[0]], "*.") && !strings.Contains(target, "://") {
+				if _, found := wildcardsFilter[target]; !found {
+					p.Wildcards = append(p.Wildcards, target)
+					wildcardsFilter[target] = struct{}{}
+				}
+			}
 			if _, found := targetsFilter[target]; found {
 				continue
 			}
@@ -0,0 +1,151 @@
+package parsers
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+)
+
+// trailingJunk is punctuation that ends up after URLs and hostnames when
+// they are copied out of prose, Markdown or CSV.
+const trailingJunk = ".,;:!?)]}>'\"`"
+
+// SanitizeTarget normalizes a URL or hostname found in the input so that
+// resolution and filenames work: internationalized hostnames are converted
+// to punycode, hostnames are lowercased without a trailing dot, and junk
+// after the URL is removed. Hosts with a port, like example.com:8443, keep
+// their port. ok is false if the target has an invalid hostname and should
+// be skipped.
+func SanitizeTarget(target string) (string, bool) {
+	target = strings.TrimSpace(target)
+	if !strings.Contains(target, "://") {
+		host := trimJunk(target)
+		host = strings.TrimLeft(host, "*.")
+		if hostname, port, err := net.SplitHostPort(host); err == nil && isPortNumber(port) {
+			hostname, ok := sanitizeHostname(hostname)
+			if !ok {
+				return "", false
+			}
+			return net.JoinHostPort(hostname, port), true
+		}
+		return sanitizeHostname(host)
+	}
+
+	u, err := url.Parse(target)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	hostname, ok := sanitizeHostname(u.Hostname())
+	if !ok {
+		return "", false
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if port := u.Port(); port != "" {
+		u.Host = hostname + ":" + port
+	} else {
+		u.Host = hostname
+	}
+	if u.RawQuery == "" && u.Fragment == "" {
+		u.Path = trimJunk(u.Path)
+		u.RawPath = trimJunk(u.RawPath)
+	}
+	return u.String(), true
+}
+
+// trimJunk removes trailing junk from s. Closing brackets are kept if they
+// close an opening bracket in s, like in /wiki/Go_(language).
+func trimJunk(s string) string {
+	for s != "" && strings.ContainsRune(trailingJunk, rune(s[len(s)-1])) {
+		last := s[len(s)-1]
+		if i := strings.IndexByte(")]}>", last); i != -1 {
+			open := string("([{<"[i])
+			if strings.Count(s, open) >= strings.Count(s, string(last)) {
+				break
+			}
+		}
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// isPortNumber reports whether s is a TCP port number.
+func isPortNumber(s string) bool {
+	port, err := strconv.Atoi(s)
+	return err == nil && port > 0 && port <= 65535
+}
+
+// hostnameProfile converts internationalized hostnames to punycode without
+// the STD3 and hyphen rules of idna.Lookup, which reject hostnames that
+// resolve just fine, like my_host.example.com and r3---sn-abc.googlevideo.com.
+var hostnameProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(true),
+	idna.StrictDomainName(false),
+	idna.CheckHyphens(false),
+)
+
+func sanitizeHostname(host string) (string, bool) {
+	host = strings.TrimSuffix(host, ".")
+	if host == "" {
+		return "", false
+	}
+	if strings.HasPrefix(host, "[") || strings.Contains(host, ":") {
+		return host, true
+	}
+	if isASCII(host) {
+		host = strings.ToLower(host)
+	} else {
+		ascii, err := hostnameProfile.ToASCII(host)
+		if err != nil {
+			return "", false
+		}
+		host = ascii
+	}
+	if !isHostname(host) {
+		return "", false
+	}
+	return host, true
+}
+
+// isHostname reports whether host only has characters found in hostnames,
+// which includes underscores, as they are used in many DNS names.
+func isHostname(host string) bool {
+	if strings.HasPrefix(host, ".") || strings.Contains(host, "..") {
+		return false
+	}
+	for _, r := range host {
+		if !('a' <= r && r <= 'z' || '0' <= r && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+	return true
+}
+
+// punycodeLine converts internationalized hostnames and URLs in a line of
+// input to punycode, as they would otherwise only be matched in part by
+// the URL pattern.
+func punycodeLine(line string) string {
+	fields := strings.Fields(line)
+	for _, field := range fields {
+		if isASCII(field) {
+			continue
+		}
+		if sanitized, ok := SanitizeTarget(strings.Trim(field, "\"'<>()[]")); ok {
+			line = strings.Replace(line, field, sanitized, 1)
+		}
+	}
+	return line
+}
+
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSanitizeTarget(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+		ok     bool
+	}{
+		{"Example.COM", "example.com", true},
+		{"example.com.", "example.com", true},
+		{"example.com:8443", "example.com:8443", true},
+		{"*.example.com", "example.com", true},
+		{"my_host.example.com", "my_host.example.com", true},
+		{"http://my_host.example.com/", "http://my_host.example.com/", true},
+		{"r3---sn-abc.googlevideo.com", "r3---sn-abc.googlevideo.com", true},
+		{"https://r3---sn-abc.googlevideo.com/videoplayback", "https://r3---sn-abc.googlevideo.com/videoplayback", true},
+		{"-leading.example.com", "-leading.example.com", true},
+		{"bücher.example", "xn--bcher-kva.example", true},
+		{"http://bücher.example/", "http://xn--bcher-kva.example/", true},
+		{"HTTPS://Example.com/Path", "https://example.com/Path", true},
+		{"http://example.com/page).", "http://example.com/page", true},
+		{"10.0.0.1", "10.0.0.1", true},
+		{"", "", false},
+		{"exa%mple.com", "", false},
+		{"example..com", "", false},
+	}
+	for _, test := range tests {
+		got, ok := SanitizeTarget(test.target)
+		if got != test.want || ok != test.ok {
+			t.Errorf("SanitizeTarget(%q) = %q, %v; want %q, %v", test.target, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestRegexParserKeepsUnderscoreAndHyphenHosts(t *testing.T) {
+	input := "my_host.example.com\nhttp://my_host.example.com/\nr3---sn-abc.googlevideo.com\n"
+	targets, err := NewRegexParser().Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"my_host.example.com", "http://my_host.example.com/", "r3---sn-abc.googlevideo.com"}
+	if !reflect.DeepEqual(targets, want) {
+		t.Errorf("Parse() = %q; want %q", targets, want)
+	}
+}